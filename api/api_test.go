@@ -15,10 +15,26 @@ import (
 )
 
 const (
-	executeEndpoint = "/api/v1/functions/execute"
-	installEndpoint = "/api/v1/functions/install"
-	resultEndpoint  = "/api/v1/functions/requests/result"
-	healthEndpoint  = "/api/v1/health"
+	executeEndpoint        = "/api/v1/functions/execute"
+	batchEndpoint          = "/api/v1/functions/execute/batch"
+	pipelineEndpoint       = "/api/v1/functions/pipelines"
+	pipelineResultEndpoint = "/api/v1/functions/pipelines/result"
+	scheduleEndpoint       = "/api/v1/schedules"
+	pauseEndpoint          = "/api/v1/schedules/pause"
+	installEndpoint        = "/api/v1/functions/install"
+	installStatusEndpoint  = "/api/v1/functions/install/status"
+	inventoryEndpoint      = "/api/v1/functions/inventory"
+	uninstallEndpoint      = "/api/v1/functions/uninstall"
+	resultEndpoint         = "/api/v1/functions/requests/result"
+	statusEndpoint         = "/api/v1/functions/requests/status"
+	eventsEndpoint         = "/api/v1/functions/requests/events"
+	cancelEndpoint         = "/api/v1/functions/requests"
+	healthEndpoint         = "/api/v1/health"
+	usageEndpoint          = "/api/v1/usage"
+	usageExportEndpoint    = "/api/v1/usage/export"
+
+	peersEndpoint          = "/api/v1/peers"
+	peerReputationEndpoint = "/api/v1/peers/reputation"
 )
 
 func setupAPI(t *testing.T) *api.API {
@@ -0,0 +1,237 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/telemetry/b7ssemconv"
+)
+
+const (
+	// Key under which the authenticated client is kept in the request context.
+	clientContextKey = "b7s-client"
+
+	// How far the timestamp of a signed request can be from the current time.
+	maxSignatureSkew = 5 * time.Minute
+
+	// Largest body of a signed request. Body is read before the signature is verified.
+	maxSignedBodySize = 10 << 20
+
+	// Endpoints accessible without authentication.
+	healthPath = "/api/v1/health"
+)
+
+var errUnauthenticated = echo.NewHTTPError(http.StatusUnauthorized, "authentication required")
+
+// Authenticator verifies the identity of REST API clients against the allowlist of clients.
+// Clients authenticate using an API key, or by signing their requests.
+type Authenticator struct {
+	log     zerolog.Logger
+	clients bls.APIClientStore
+	now     func() time.Time
+
+	// Signatures of requests seen while their timestamp is within the allowed window, so signed requests cannot be replayed.
+	signaturesLock sync.Mutex
+	signatures     map[string]time.Time
+	lastPruned     time.Time
+}
+
+// NewAuthenticator creates a new Authenticator, using the allowlist of clients from the given store.
+func NewAuthenticator(log zerolog.Logger, clients bls.APIClientStore) *Authenticator {
+
+	a := Authenticator{
+		log:        log,
+		clients:    clients,
+		now:        time.Now,
+		signatures: make(map[string]time.Time),
+	}
+
+	return &a
+}
+
+// Middleware rejects requests to the REST API that are not made by known clients.
+func (a *Authenticator) Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+
+		path := ctx.Request().URL.Path
+		if !strings.HasPrefix(path, "/api/") || path == healthPath {
+			return next(ctx)
+		}
+
+		client, err := a.authenticate(ctx.Request())
+		if err != nil {
+			return err
+		}
+
+		ctx.Set(clientContextKey, client)
+		trace.SpanFromContext(ctx.Request().Context()).SetAttributes(b7ssemconv.ClientID.String(client.ID))
+
+		return next(ctx)
+	}
+}
+
+func (a *Authenticator) authenticate(req *http.Request) (bls.APIClient, error) {
+
+	if key := req.Header.Get(bls.APIHeaderKey); key != "" {
+		return a.authenticateKey(req, key)
+	}
+
+	if id := req.Header.Get(bls.APIHeaderClientID); id != "" {
+		return a.authenticateSignature(req, id)
+	}
+
+	return bls.APIClient{}, errUnauthenticated
+}
+
+func (a *Authenticator) authenticateKey(req *http.Request, key string) (bls.APIClient, error) {
+
+	client, err := a.clients.RetrieveAPIClientByKey(req.Context(), bls.HashAPIKey(key))
+	if err != nil && !errors.Is(err, bls.ErrNotFound) {
+		return bls.APIClient{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve API client: %w", err))
+	}
+
+	if err != nil || !client.MatchesKey(key) {
+		a.log.Warn().Str("remote", req.RemoteAddr).Msg("rejecting request with unknown API key")
+		return bls.APIClient{}, errUnauthenticated
+	}
+
+	return client, nil
+}
+
+func (a *Authenticator) authenticateSignature(req *http.Request, id string) (bls.APIClient, error) {
+
+	log := a.log.With().Str("client", id).Str("remote", req.RemoteAddr).Logger()
+
+	client, err := a.clients.RetrieveAPIClient(req.Context(), id)
+	if errors.Is(err, bls.ErrNotFound) {
+		log.Warn().Msg("rejecting signed request from unknown client")
+		return bls.APIClient{}, errUnauthenticated
+	}
+	if err != nil {
+		return bls.APIClient{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve API client: %w", err))
+	}
+
+	timestamp := req.Header.Get(bls.APIHeaderTimestamp)
+	sec, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return bls.APIClient{}, echo.NewHTTPError(http.StatusUnauthorized, "invalid request timestamp")
+	}
+
+	// Reject stale requests so signed requests cannot be replayed later.
+	skew := a.now().Sub(time.Unix(sec, 0))
+	if skew > maxSignatureSkew || skew < -maxSignatureSkew {
+		return bls.APIClient{}, echo.NewHTTPError(http.StatusUnauthorized, "request timestamp outside of the allowed window")
+	}
+
+	// Read the body for verification and restore it for the handler.
+	body, err := io.ReadAll(http.MaxBytesReader(nil, req.Body, maxSignedBodySize))
+	var maxErr *http.MaxBytesError
+	if errors.As(err, &maxErr) {
+		return bls.APIClient{}, echo.NewHTTPError(http.StatusRequestEntityTooLarge, fmt.Sprintf("request body larger than %d bytes", maxErr.Limit))
+	}
+	if err != nil {
+		return bls.APIClient{}, echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not read request body: %w", err))
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	signature := req.Header.Get(bls.APIHeaderSignature)
+	err = client.VerifyRequestSignature(req.Method, req.URL.Path, timestamp, body, signature)
+	if err != nil {
+		log.Warn().Err(err).Msg("rejecting request with invalid signature")
+		return bls.APIClient{}, errUnauthenticated
+	}
+
+	// Timestamp window alone does not prevent a signed request from being replayed while the timestamp is still valid.
+	if !a.firstUse(client.ID, signature, time.Unix(sec, 0).Add(maxSignatureSkew)) {
+		log.Warn().Msg("rejecting replayed signed request")
+		return bls.APIClient{}, echo.NewHTTPError(http.StatusUnauthorized, "request signature already used")
+	}
+
+	return client, nil
+}
+
+// firstUse records the request signature until it expires. It returns false if the signature was already used.
+func (a *Authenticator) firstUse(clientID string, signature string, expires time.Time) bool {
+	a.signaturesLock.Lock()
+	defer a.signaturesLock.Unlock()
+
+	now := a.now()
+
+	// Drop signatures of requests that would now be rejected as stale anyway.
+	if now.Sub(a.lastPruned) > maxSignatureSkew {
+		for sig, exp := range a.signatures {
+			if now.After(exp) {
+				delete(a.signatures, sig)
+			}
+		}
+		a.lastPruned = now
+	}
+
+	key := clientID + "/" + strings.ToLower(signature)
+	_, seen := a.signatures[key]
+	if seen {
+		return false
+	}
+
+	a.signatures[key] = expires
+	return true
+}
+
+// authenticatedClient returns the client that made the request, if the request was authenticated.
+func authenticatedClient(ctx echo.Context) (bls.APIClient, bool) {
+	client, ok := ctx.Get(clientContextKey).(bls.APIClient)
+	return client, ok
+}
+
+// clientID returns the identity of the client that made the request. It is empty if the request was not authenticated.
+func clientID(ctx echo.Context) string {
+	client, _ := authenticatedClient(ctx)
+	return client.ID
+}
+
+// isAdmin returns true if the client that made the request can access data of all clients.
+// If the request was not authenticated, authentication is not enabled and all clients are treated the same.
+func isAdmin(ctx echo.Context) bool {
+	client, ok := authenticatedClient(ctx)
+	return !ok || client.Admin
+}
+
+// ownedByClient returns true if the resource submitted by the given client can be accessed by the client that made the request.
+// Resources of other clients are reported as not found, so their existence is not disclosed.
+func ownedByClient(ctx echo.Context, owner string) bool {
+	return owner == clientID(ctx)
+}
+
+// verifyRequestSignature verifies that the execution request was signed by the authenticated client.
+func verifyRequestSignature(ctx echo.Context, req execute.Request) error {
+
+	client, ok := authenticatedClient(ctx)
+	if !ok || client.PublicKey == "" {
+		return errors.New("signature can only be verified for authenticated clients with a public key")
+	}
+
+	key, err := bls.ParseClientKey(client.PublicKey)
+	if err != nil {
+		return fmt.Errorf("could not parse client key: %w", err)
+	}
+
+	err = req.VerifySignature(key)
+	if err != nil {
+		return fmt.Errorf("could not verify signature: %w", err)
+	}
+
+	return nil
+}
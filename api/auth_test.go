@@ -0,0 +1,387 @@
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/api"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestAPI_Authentication(t *testing.T) {
+
+	priv, pub, err := crypto.GenerateEd25519Key(nil)
+	require.NoError(t, err)
+	id, err := peer.IDFromPublicKey(pub)
+	require.NoError(t, err)
+
+	signer := bls.APIClient{
+		ID:        "signing-client",
+		PublicKey: id.String(),
+	}
+
+	store := mocks.BaselineStore(t)
+	store.RetrieveAPIClientFunc = func(_ context.Context, id string) (bls.APIClient, error) {
+		switch id {
+		case mocks.GenericAPIClient.ID:
+			return mocks.GenericAPIClient, nil
+		case signer.ID:
+			return signer, nil
+		default:
+			return bls.APIClient{}, bls.ErrNotFound
+		}
+	}
+	store.RetrieveAPIClientByKeyFunc = func(_ context.Context, keyHash string) (bls.APIClient, error) {
+		if keyHash != mocks.GenericAPIClient.KeyHash {
+			return bls.APIClient{}, bls.ErrNotFound
+		}
+		return mocks.GenericAPIClient, nil
+	}
+
+	auth := api.NewAuthenticator(mocks.NoopLogger, store)
+
+	// Handler reports the client the request was attributed to.
+	var client string
+	node := mocks.BaselineNode(t)
+	node.ExecuteFunctionFunc = func(_ context.Context, req execute.Request, _ string, _ bls.Webhook) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+		client = req.Client
+		return codes.OK, mocks.GenericUUID.String(), mocks.GenericExecutionResultMap, execute.Cluster{}, nil
+	}
+	handler := auth.Middleware(api.New(mocks.NoopLogger, node).ExecuteFunction)
+
+	request := api.ExecutionRequest{
+		FunctionId: "dummy-function-id",
+		Method:     "dummy-function-method",
+	}
+	body, err := json.Marshal(request)
+	require.NoError(t, err)
+
+	sign := func(key crypto.PrivKey, clientID string, timestamp time.Time) func(*http.Request) {
+		return func(req *http.Request) {
+			ts := strconv.FormatInt(timestamp.Unix(), 10)
+			sig, err := bls.SignAPIRequest(key, req.Method, req.URL.Path, ts, body)
+			require.NoError(t, err)
+
+			req.Header.Set(bls.APIHeaderClientID, clientID)
+			req.Header.Set(bls.APIHeaderTimestamp, ts)
+			req.Header.Set(bls.APIHeaderSignature, sig)
+		}
+	}
+
+	requireUnauthorized := func(t *testing.T, err error) {
+		t.Helper()
+
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusUnauthorized, echoErr.Code)
+	}
+
+	t.Run("valid API key", func(t *testing.T) {
+
+		rec, ctx, err := setupRecorder(executeEndpoint, body, func(req *http.Request) {
+			req.Header.Set(bls.APIHeaderKey, mocks.GenericAPIKey)
+		})
+		require.NoError(t, err)
+
+		err = handler(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		require.Equal(t, mocks.GenericAPIClient.ID, client)
+	})
+	t.Run("unknown API key", func(t *testing.T) {
+
+		_, ctx, err := setupRecorder(executeEndpoint, body, func(req *http.Request) {
+			req.Header.Set(bls.APIHeaderKey, "wrong-key")
+		})
+		require.NoError(t, err)
+
+		requireUnauthorized(t, handler(ctx))
+	})
+	t.Run("no credentials", func(t *testing.T) {
+
+		_, ctx, err := setupRecorder(executeEndpoint, body)
+		require.NoError(t, err)
+
+		requireUnauthorized(t, handler(ctx))
+	})
+	t.Run("valid signature", func(t *testing.T) {
+
+		rec, ctx, err := setupRecorder(executeEndpoint, body, sign(priv, signer.ID, time.Now()))
+		require.NoError(t, err)
+
+		err = handler(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		require.Equal(t, signer.ID, client)
+	})
+	t.Run("replayed signed request", func(t *testing.T) {
+
+		replay := sign(priv, signer.ID, time.Now().Add(-time.Minute))
+
+		_, ctx, err := setupRecorder(executeEndpoint, body, replay)
+		require.NoError(t, err)
+		require.NoError(t, handler(ctx))
+
+		_, ctx, err = setupRecorder(executeEndpoint, body, replay)
+		require.NoError(t, err)
+
+		requireUnauthorized(t, handler(ctx))
+	})
+	t.Run("signature made with a different key", func(t *testing.T) {
+
+		other, _, err := crypto.GenerateEd25519Key(nil)
+		require.NoError(t, err)
+
+		_, ctx, err := setupRecorder(executeEndpoint, body, sign(other, signer.ID, time.Now()))
+		require.NoError(t, err)
+
+		requireUnauthorized(t, handler(ctx))
+	})
+	t.Run("stale signed request", func(t *testing.T) {
+
+		_, ctx, err := setupRecorder(executeEndpoint, body, sign(priv, signer.ID, time.Now().Add(-time.Hour)))
+		require.NoError(t, err)
+
+		requireUnauthorized(t, handler(ctx))
+	})
+	t.Run("unknown signing client", func(t *testing.T) {
+
+		_, ctx, err := setupRecorder(executeEndpoint, body, sign(priv, "unknown-client", time.Now()))
+		require.NoError(t, err)
+
+		requireUnauthorized(t, handler(ctx))
+	})
+	t.Run("oversized signed request", func(t *testing.T) {
+
+		large := bytes.Repeat([]byte{' '}, 11<<20)
+
+		_, ctx, err := setupRecorder(executeEndpoint, large, sign(priv, signer.ID, time.Now()))
+		require.NoError(t, err)
+
+		err = handler(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusRequestEntityTooLarge, echoErr.Code)
+	})
+	t.Run("health endpoint does not require authentication", func(t *testing.T) {
+
+		_, ctx, err := setupRecorder(healthEndpoint, nil)
+		require.NoError(t, err)
+
+		var called bool
+		err = auth.Middleware(func(echo.Context) error {
+			called = true
+			return nil
+		})(ctx)
+		require.NoError(t, err)
+		require.True(t, called)
+	})
+	t.Run("store failure", func(t *testing.T) {
+
+		store := mocks.BaselineStore(t)
+		store.RetrieveAPIClientByKeyFunc = func(context.Context, string) (bls.APIClient, error) {
+			return bls.APIClient{}, mocks.GenericError
+		}
+
+		handler := api.NewAuthenticator(mocks.NoopLogger, store).Middleware(api.New(mocks.NoopLogger, node).ExecuteFunction)
+
+		_, ctx, err := setupRecorder(executeEndpoint, body, func(req *http.Request) {
+			req.Header.Set(bls.APIHeaderKey, mocks.GenericAPIKey)
+		})
+		require.NoError(t, err)
+
+		err = handler(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusInternalServerError, echoErr.Code)
+	})
+}
+
+func TestAPI_ExecuteFunction_RequestSignature(t *testing.T) {
+
+	priv, pub, err := crypto.GenerateEd25519Key(nil)
+	require.NoError(t, err)
+	id, err := peer.IDFromPublicKey(pub)
+	require.NoError(t, err)
+
+	client := mocks.GenericAPIClient
+	client.PublicKey = id.String()
+
+	store := mocks.BaselineStore(t)
+	store.RetrieveAPIClientByKeyFunc = func(context.Context, string) (bls.APIClient, error) {
+		return client, nil
+	}
+
+	var executed execute.Request
+	node := mocks.BaselineNode(t)
+	node.ExecuteFunctionFunc = func(_ context.Context, req execute.Request, _ string, _ bls.Webhook) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+		executed = req
+		return codes.OK, mocks.GenericUUID.String(), mocks.GenericExecutionResultMap, execute.Cluster{}, nil
+	}
+
+	authenticated := api.NewAuthenticator(mocks.NoopLogger, store).Middleware(api.New(mocks.NoopLogger, node).ExecuteFunction)
+
+	// Sign the execution request the way the client would.
+	signed := execute.Request{
+		FunctionID: "dummy-function-id",
+		Method:     "dummy-function-method",
+	}
+	require.NoError(t, signed.Sign(priv))
+
+	request := api.ExecutionRequest{
+		FunctionId: signed.FunctionID,
+		Method:     signed.Method,
+		Signature:  signed.Signature,
+	}
+
+	withKey := func(req *http.Request) {
+		req.Header.Set(bls.APIHeaderKey, mocks.GenericAPIKey)
+	}
+
+	t.Run("valid signature", func(t *testing.T) {
+
+		rec, ctx, err := setupRecorder(executeEndpoint, request, withKey)
+		require.NoError(t, err)
+
+		err = authenticated(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		require.Equal(t, signed.Signature, executed.Signature)
+		require.Equal(t, client.ID, executed.Client)
+	})
+	t.Run("tampered request", func(t *testing.T) {
+
+		tampered := request
+		tampered.Method = "other-method"
+
+		_, ctx, err := setupRecorder(executeEndpoint, tampered, withKey)
+		require.NoError(t, err)
+
+		err = authenticated(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusUnauthorized, echoErr.Code)
+	})
+	t.Run("signature from unauthenticated client", func(t *testing.T) {
+
+		_, ctx, err := setupRecorder(executeEndpoint, request)
+		require.NoError(t, err)
+
+		err = api.New(mocks.NoopLogger, node).ExecuteFunction(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusUnauthorized, echoErr.Code, fmt.Sprint(echoErr.Message))
+	})
+}
+
+func TestAPI_ExecutionOwnership(t *testing.T) {
+
+	withKey := func(req *http.Request) {
+		req.Header.Set(bls.APIHeaderKey, mocks.GenericAPIKey)
+	}
+
+	record := mocks.GenericExecutionRecord
+	record.Client = mocks.GenericAPIClient.ID
+
+	other := mocks.GenericExecutionRecord
+	other.Client = "other-client"
+
+	node := func(record bls.ExecutionRecord) *mocks.APINode {
+		node := mocks.BaselineNode(t)
+		node.ExecutionStatusFunc = func(context.Context, string) (bls.ExecutionStatus, error) {
+			status := bls.ExecutionStatus{
+				RequestID: record.RequestID,
+				Phase:     bls.ExecutionPhaseDone,
+				Client:    record.Client,
+				Record:    &record,
+			}
+			return status, nil
+		}
+		node.ExecutionResultFunc = func(context.Context, string) (bls.ExecutionRecord, error) {
+			return record, nil
+		}
+		return node
+	}
+
+	handlers := []struct {
+		name     string
+		endpoint string
+		handler  func(*api.API) echo.HandlerFunc
+	}{
+		{"status", statusEndpoint, func(srv *api.API) echo.HandlerFunc { return srv.ExecutionStatus }},
+		{"result", resultEndpoint, func(srv *api.API) echo.HandlerFunc { return srv.ExecutionResult }},
+		{"events", eventsEndpoint, func(srv *api.API) echo.HandlerFunc { return srv.ExecutionEvents }},
+		{"cancel", cancelEndpoint, func(srv *api.API) echo.HandlerFunc { return srv.CancelExecution }},
+	}
+
+	for _, h := range handlers {
+		t.Run(h.name+" by owner", func(t *testing.T) {
+
+			auth := api.NewAuthenticator(mocks.NoopLogger, mocks.BaselineStore(t))
+			handler := auth.Middleware(h.handler(api.New(mocks.NoopLogger, node(record))))
+
+			rec, ctx, err := setupRecorder(h.endpoint, api.FunctionStatusRequest{Id: record.RequestID}, withKey)
+			require.NoError(t, err)
+
+			err = handler(ctx)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		})
+		t.Run(h.name+" by other client", func(t *testing.T) {
+
+			auth := api.NewAuthenticator(mocks.NoopLogger, mocks.BaselineStore(t))
+			handler := auth.Middleware(h.handler(api.New(mocks.NoopLogger, node(other))))
+
+			rec, ctx, err := setupRecorder(h.endpoint, api.FunctionStatusRequest{Id: other.RequestID}, withKey)
+			require.NoError(t, err)
+
+			err = handler(ctx)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+		})
+	}
+	t.Run("pipeline result by other client", func(t *testing.T) {
+
+		pipelineNode := mocks.BaselineNode(t)
+		pipelineNode.PipelineResultFunc = func(context.Context, string) (bls.PipelineRecord, error) {
+			record := mocks.GenericPipelineRecord
+			record.Client = "other-client"
+			return record, nil
+		}
+
+		auth := api.NewAuthenticator(mocks.NoopLogger, mocks.BaselineStore(t))
+		handler := auth.Middleware(api.New(mocks.NoopLogger, pipelineNode).PipelineResult)
+
+		rec, ctx, err := setupRecorder(pipelineResultEndpoint, api.PipelineResultRequest{Id: mocks.GenericPipelineRecord.PipelineID}, withKey)
+		require.NoError(t, err)
+
+		err = handler(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+	})
+}
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/blessnetwork/b7s/consensus/pbft"
+	"github.com/blessnetwork/b7s/models/execute"
+)
+
+// maxBatchSize is the maximum number of execution requests in a single batch.
+const maxBatchSize = 1000
+
+func (r ExecutionBatchRequest) Valid() error {
+
+	if len(r.Requests) == 0 {
+		return errors.New("batch has no requests")
+	}
+
+	if len(r.Requests) > maxBatchSize {
+		return fmt.Errorf("batch has too many requests (have: %d, max: %d)", len(r.Requests), maxBatchSize)
+	}
+
+	if r.Parallelism < 0 {
+		return fmt.Errorf("parallelism cannot be negative: %d", r.Parallelism)
+	}
+
+	return nil
+}
+
+// ExecuteFunctionBatch implements the REST API endpoint for executing a batch of functions.
+func (a *API) ExecuteFunctionBatch(ctx echo.Context) error {
+
+	// Unpack the API request.
+	var req ExecutionBatchRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = req.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+	}
+
+	requests := make([]execute.Request, 0, len(req.Requests))
+	for i, item := range req.Requests {
+
+		exr := execute.Request{
+			Config:     item.Config,
+			FunctionID: item.FunctionId,
+			Method:     item.Method,
+			Parameters: item.Parameters,
+			Client:     clientID(ctx),
+		}
+
+		err = exr.Valid()
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request (index: %d): %w", i, err))
+		}
+
+		requests = append(requests, exr)
+	}
+
+	// Failed requests are reported in their own records.
+	records := a.Node.ExecuteFunctionBatch(ctx.Request().Context(), requests, req.Topic, uint(req.Parallelism))
+
+	// Transform the node response format to the one returned by the API.
+	res := ExecutionBatchResponse{
+		Results: make([]ExecutionResponse, 0, len(records)),
+	}
+	for _, record := range records {
+		res.Results = append(res.Results, ExecutionResponse{
+			Code:        string(record.Code),
+			RequestId:   record.RequestID,
+			Results:     record.Aggregated,
+			Aggregation: record.Aggregation,
+			Cluster:     record.Cluster,
+			Message:     record.Message,
+			Receipts:    record.Results.Receipts(),
+			Certificate: pbft.NewResultCertificate(record.RequestID, record.Cluster.Peers, record.Results),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,122 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/api"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestAPI_ExecuteFunctionBatch(t *testing.T) {
+
+	batchItem := func(functionID string) api.ExecutionBatchItem {
+		return api.ExecutionBatchItem{
+			FunctionId: functionID,
+			Method:     mocks.GenericExecutionRequest.Method,
+		}
+	}
+
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		const (
+			topic       = "dummy-topic"
+			parallelism = 4
+		)
+
+		node := mocks.BaselineNode(t)
+		node.ExecuteFunctionBatchFunc = func(_ context.Context, reqs []execute.Request, subgroup string, p uint) []bls.ExecutionRecord {
+			require.Equal(t, topic, subgroup)
+			require.Equal(t, uint(parallelism), p)
+
+			records := make([]bls.ExecutionRecord, 0, len(reqs))
+			for i, req := range reqs {
+				records = append(records, bls.ExecutionRecord{
+					RequestID:  fmt.Sprintf("request-%d", i),
+					FunctionID: req.FunctionID,
+					Code:       codes.OK,
+				})
+			}
+
+			return records
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.ExecutionBatchRequest{
+			Requests: []api.ExecutionBatchItem{
+				batchItem("function-1"),
+				batchItem("function-2"),
+				batchItem("function-1"),
+			},
+			Topic:       topic,
+			Parallelism: parallelism,
+		}
+
+		rec, ctx, err := setupRecorder(batchEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunctionBatch(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var res api.ExecutionBatchResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Len(t, res.Results, len(req.Requests))
+		for i, result := range res.Results {
+			require.Equal(t, fmt.Sprintf("request-%d", i), result.RequestId)
+			require.Equal(t, codes.OK.String(), result.Code)
+		}
+	})
+	t.Run("empty batch", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		_, ctx, err := setupRecorder(batchEndpoint, api.ExecutionBatchRequest{})
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunctionBatch(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+	t.Run("invalid request in batch", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		req := api.ExecutionBatchRequest{
+			Requests: []api.ExecutionBatchItem{
+				batchItem("function-1"),
+				{FunctionId: "function-2"},
+			},
+		}
+
+		_, ctx, err := setupRecorder(batchEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunctionBatch(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+}
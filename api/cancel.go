@@ -0,0 +1,65 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/blessnetwork/b7s/models/bls"
+)
+
+func (r FunctionCancelRequest) Valid() error {
+
+	if r.Id == "" {
+		return errors.New("request ID is required")
+	}
+
+	return nil
+}
+
+// CancelExecution implements the REST API endpoint for cancelling a function execution in progress.
+func (a *API) CancelExecution(ctx echo.Context) error {
+
+	// Get the request ID.
+	var request FunctionCancelRequest
+	err := ctx.Bind(&request)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = request.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, errors.New("missing request ID"))
+	}
+
+	// Lookup the execution to verify it was submitted by this client.
+	status, err := a.Node.ExecutionStatus(ctx.Request().Context(), request.Id)
+	if err != nil {
+		if errors.Is(err, bls.ErrNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve execution status: %w", err))
+	}
+
+	if !ownedByClient(ctx, status.Client) {
+		return ctx.NoContent(http.StatusNotFound)
+	}
+
+	err = a.Node.CancelExecution(ctx.Request().Context(), request.Id)
+	if err != nil {
+		if errors.Is(err, bls.ErrNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not cancel execution: %w", err))
+	}
+
+	res := FunctionCancelResponse{
+		RequestId: request.Id,
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
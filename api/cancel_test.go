@@ -0,0 +1,116 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/api"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestAPI_CancelExecution(t *testing.T) {
+
+	deleteMethod := func(req *http.Request) {
+		req.Method = http.MethodDelete
+	}
+
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		var cancelled string
+
+		node := mocks.BaselineNode(t)
+		node.CancelExecutionFunc = func(_ context.Context, id string) error {
+			cancelled = id
+			return nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.FunctionCancelRequest{
+			Id: "dummy-request-id",
+		}
+
+		rec, ctx, err := setupRecorder(cancelEndpoint, req, deleteMethod)
+		require.NoError(t, err)
+
+		err = srv.CancelExecution(ctx)
+		require.NoError(t, err)
+
+		var res api.FunctionCancelResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		require.Equal(t, req.Id, res.RequestId)
+		require.Equal(t, req.Id, cancelled)
+	})
+	t.Run("execution not in progress", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.CancelExecutionFunc = func(context.Context, string) error {
+			return bls.ErrNotFound
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.FunctionCancelRequest{
+			Id: "dummy-request-id",
+		}
+
+		rec, ctx, err := setupRecorder(cancelEndpoint, req, deleteMethod)
+		require.NoError(t, err)
+
+		err = srv.CancelExecution(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+	})
+	t.Run("node error", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.CancelExecutionFunc = func(context.Context, string) error {
+			return mocks.GenericError
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.FunctionCancelRequest{
+			Id: "dummy-request-id",
+		}
+
+		_, ctx, err := setupRecorder(cancelEndpoint, req, deleteMethod)
+		require.NoError(t, err)
+
+		err = srv.CancelExecution(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusInternalServerError, echoErr.Code)
+	})
+	t.Run("missing request ID", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		_, ctx, err := setupRecorder(cancelEndpoint, api.FunctionCancelRequest{}, deleteMethod)
+		require.NoError(t, err)
+
+		err = srv.CancelExecution(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+}
@@ -0,0 +1,88 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/blessnetwork/b7s/models/bls"
+)
+
+func (r FunctionEventsRequest) Valid() error {
+
+	if r.Id == "" {
+		return errors.New("request ID is required")
+	}
+
+	return nil
+}
+
+// ExecutionEvents implements the REST API endpoint for streaming progress events of a function execution.
+// Events are sent as server-sent events (SSE).
+func (a *API) ExecutionEvents(ctx echo.Context) error {
+
+	// Get the request ID.
+	var request FunctionEventsRequest
+	err := ctx.Bind(&request)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = request.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, errors.New("missing request ID"))
+	}
+
+	// Lookup the execution to verify it was submitted by this client.
+	status, err := a.Node.ExecutionStatus(ctx.Request().Context(), request.Id)
+	if err != nil {
+		if errors.Is(err, bls.ErrNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve execution status: %w", err))
+	}
+
+	if !ownedByClient(ctx, status.Client) {
+		return ctx.NoContent(http.StatusNotFound)
+	}
+
+	events, err := a.Node.ExecutionEvents(ctx.Request().Context(), request.Id)
+	if err != nil {
+		if errors.Is(err, bls.ErrNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve execution events: %w", err))
+	}
+
+	res := ctx.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	res.WriteHeader(http.StatusOK)
+	res.Flush()
+
+	// Stream events until the execution is done or the client goes away.
+	for event := range events {
+
+		payload, err := json.Marshal(event)
+		if err != nil {
+			a.Log.Error().Err(err).Str("request", request.Id).Msg("could not encode execution event")
+			continue
+		}
+
+		_, err = fmt.Fprintf(res, "event: %s\ndata: %s\n\n", event.Type, payload)
+		if err != nil {
+			a.Log.Debug().Err(err).Str("request", request.Id).Msg("could not write execution event")
+			return nil
+		}
+
+		res.Flush()
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package api_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/api"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestAPI_ExecutionEvents(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		req := api.FunctionEventsRequest{
+			Id: mocks.GenericString,
+		}
+
+		rec, ctx, err := setupRecorder(eventsEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecutionEvents(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		require.Equal(t, "text/event-stream", rec.Result().Header.Get(echo.HeaderContentType))
+
+		// Parse the event stream.
+		var (
+			types  []string
+			events []bls.ExecutionEvent
+		)
+		scanner := bufio.NewScanner(rec.Body)
+		for scanner.Scan() {
+			line := scanner.Text()
+
+			switch {
+			case strings.HasPrefix(line, "event: "):
+				types = append(types, strings.TrimPrefix(line, "event: "))
+
+			case strings.HasPrefix(line, "data: "):
+				var event bls.ExecutionEvent
+				require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event))
+				events = append(events, event)
+			}
+		}
+
+		require.Len(t, events, len(mocks.GenericExecutionEvents))
+		for i, expected := range mocks.GenericExecutionEvents {
+			require.Equal(t, expected.Type.String(), types[i])
+			require.Equal(t, expected.Type, events[i].Type)
+			require.Equal(t, expected.RequestID, events[i].RequestID)
+			require.Equal(t, expected.Peer, events[i].Peer)
+		}
+	})
+	t.Run("request not found", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecutionEventsFunc = func(context.Context, string) (<-chan bls.ExecutionEvent, error) {
+			return nil, bls.ErrNotFound
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.FunctionEventsRequest{
+			Id: "dummy-request-id",
+		}
+
+		rec, ctx, err := setupRecorder(eventsEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecutionEvents(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+	})
+	t.Run("node error", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecutionEventsFunc = func(context.Context, string) (<-chan bls.ExecutionEvent, error) {
+			return nil, mocks.GenericError
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.FunctionEventsRequest{
+			Id: "dummy-request-id",
+		}
+
+		_, ctx, err := setupRecorder(eventsEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecutionEvents(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusInternalServerError, echoErr.Code)
+	})
+	t.Run("missing request ID", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		_, ctx, err := setupRecorder(eventsEndpoint, api.FunctionEventsRequest{})
+		require.NoError(t, err)
+
+		err = srv.ExecutionEvents(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+}
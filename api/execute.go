@@ -4,10 +4,13 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/blessnetwork/b7s/consensus/pbft"
 	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
 	"github.com/blessnetwork/b7s/models/execute"
 	"github.com/blessnetwork/b7s/node/aggregate"
 )
@@ -27,6 +30,7 @@ func (a *API) ExecuteFunction(ctx echo.Context) error {
 		FunctionID: req.FunctionId,
 		Method:     req.Method,
 		Parameters: req.Parameters,
+		Signature:  req.Signature,
 	}
 
 	err = exr.Valid()
@@ -34,18 +38,53 @@ func (a *API) ExecuteFunction(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
 	}
 
+	// If the request is signed, verify the signature before attributing the request to the client.
+	if exr.Signature != "" {
+		err = verifyRequestSignature(ctx, exr)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, fmt.Errorf("invalid request signature: %w", err))
+		}
+	}
+
+	exr.Client = clientID(ctx)
+
+	err = req.Callback.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid callback: %w", err))
+	}
+
+	// In async mode, return the request ID right away. Client can poll for the execution status.
+	if req.Async {
+		id, err := a.Node.ExecuteFunctionAsync(ctx.Request().Context(), exr, req.Topic, req.Callback)
+
+		// Let the client know when it can try again.
+		var limitErr *bls.LimitError
+		if errors.As(err, &limitErr) {
+			ctx.Response().Header().Set(echo.HeaderRetryAfter, strconv.FormatInt(limitErr.RetryAfterSeconds(), 10))
+			return ctx.JSON(http.StatusTooManyRequests, ExecutionResponse{Code: string(codes.TooManyRequests), Message: limitErr.Error()})
+		}
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not start execution: %w", err))
+		}
+
+		return ctx.JSON(http.StatusAccepted, ExecutionResponse{RequestId: id})
+	}
+
 	// Get the execution result.
-	code, id, results, cluster, err := a.Node.ExecuteFunction(ctx.Request().Context(), exr, req.Topic)
+	code, id, results, cluster, err := a.Node.ExecuteFunction(ctx.Request().Context(), exr, req.Topic, req.Callback)
 	if err != nil {
 		a.Log.Warn().Str("function", req.FunctionId).Err(err).Msg("node failed to execute function")
 	}
 
 	// Transform the node response format to the one returned by the API.
 	res := ExecutionResponse{
-		Code:      string(code),
-		RequestId: id,
-		Results:   aggregate.Aggregate(results),
-		Cluster:   cluster,
+		Code:        string(code),
+		RequestId:   id,
+		Results:     aggregate.Aggregate(results),
+		Aggregation: aggregate.Apply(exr.Config.ResultAggregation, results),
+		Cluster:     cluster,
+		Receipts:    results.Receipts(),
+		Certificate: pbft.NewResultCertificate(id, cluster.Peers, results),
 	}
 
 	// Communicate the reason for failure in these cases.
@@ -53,6 +92,14 @@ func (a *API) ExecuteFunction(ctx echo.Context) error {
 		res.Message = err.Error()
 	}
 
+	// Let the client know when it can try again.
+	var limitErr *bls.LimitError
+	if errors.As(err, &limitErr) {
+		res.Message = limitErr.Error()
+		ctx.Response().Header().Set(echo.HeaderRetryAfter, strconv.FormatInt(limitErr.RetryAfterSeconds(), 10))
+		return ctx.JSON(http.StatusTooManyRequests, res)
+	}
+
 	// Send the response.
 	return ctx.JSON(http.StatusOK, res)
 }
@@ -3,14 +3,17 @@ package api_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/require"
 
 	"github.com/blessnetwork/b7s/api"
+	"github.com/blessnetwork/b7s/models/bls"
 	"github.com/blessnetwork/b7s/models/codes"
 	"github.com/blessnetwork/b7s/models/execute"
 	"github.com/blessnetwork/b7s/testing/mocks"
@@ -28,13 +31,20 @@ func TestAPI_Execute(t *testing.T) {
 	peerIDs := []peer.ID{
 		mocks.GenericPeerID,
 	}
+	receipt := execute.Receipt{
+		RequestID:  mocks.GenericUUID.String(),
+		FunctionID: mocks.GenericExecutionRequest.FunctionID,
+		Method:     mocks.GenericExecutionRequest.Method,
+		Worker:     mocks.GenericPeerID,
+		Signature:  "dummy-signature",
+	}
 	expectedCode := codes.OK
 
 	node := mocks.BaselineNode(t)
-	node.ExecuteFunctionFunc = func(context.Context, execute.Request, string) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+	node.ExecuteFunctionFunc = func(context.Context, execute.Request, string, bls.Webhook) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
 
 		res := execute.ResultMap{
-			mocks.GenericPeerID: execute.NodeResult{Result: executionResult},
+			mocks.GenericPeerID: execute.NodeResult{Result: executionResult, Receipt: &receipt},
 		}
 
 		cluster := execute.Cluster{
@@ -67,11 +77,65 @@ func TestAPI_Execute(t *testing.T) {
 	require.Len(t, res.Results, 1)
 	require.Equal(t, executionResult.Result, res.Results[0].Result)
 	require.Equal(t, float64(100), res.Results[0].Frequency)
+
+	require.Equal(t, []execute.Receipt{receipt}, res.Receipts)
+	// Results were not produced by a PBFT cluster.
+	require.Nil(t, res.Certificate)
 	require.Equal(t, peerIDs, res.Results[0].Peers)
 
 	require.Equal(t, mocks.GenericUUID.String(), res.RequestId)
 }
 
+func TestAPI_Execute_Aggregation(t *testing.T) {
+
+	result := func(code codes.Code, stdout string) execute.NodeResult {
+		return execute.NodeResult{
+			Result: execute.Result{
+				Code:   code,
+				Result: execute.RuntimeOutput{Stdout: stdout},
+			},
+		}
+	}
+
+	node := mocks.BaselineNode(t)
+	node.ExecuteFunctionFunc = func(context.Context, execute.Request, string, bls.Webhook) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+
+		res := execute.ResultMap{
+			mocks.GenericPeerIDs[0]: result(codes.OK, "10"),
+			mocks.GenericPeerIDs[1]: result(codes.OK, "20"),
+			mocks.GenericPeerIDs[2]: result(codes.Error, ""),
+		}
+
+		return codes.OK, mocks.GenericUUID.String(), res, execute.Cluster{}, nil
+	}
+
+	srv := api.New(mocks.NoopLogger, node)
+
+	req := mocks.GenericExecutionRequest
+	req.Config.ResultAggregation = execute.ResultAggregation{
+		Enable: true,
+		Type:   execute.AggregationMean,
+	}
+
+	rec, ctx, err := setupRecorder(executeEndpoint, req)
+	require.NoError(t, err)
+
+	err = srv.ExecuteFunction(ctx)
+	require.NoError(t, err)
+
+	var res api.ExecutionResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+	require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+	require.NotNil(t, res.Aggregation)
+	require.Equal(t, execute.AggregationMean, res.Aggregation.Type)
+	require.Equal(t, "15", res.Aggregation.Value)
+	require.Len(t, res.Aggregation.Peers, 3)
+	for _, value := range res.Aggregation.Peers {
+		require.Equal(t, value.Peer != mocks.GenericPeerIDs[2], value.Included)
+	}
+}
+
 func TestAPI_Execute_HandlesErrors(t *testing.T) {
 
 	executionResult := execute.Result{
@@ -85,7 +149,7 @@ func TestAPI_Execute_HandlesErrors(t *testing.T) {
 	expectedCode := codes.Error
 
 	node := mocks.BaselineNode(t)
-	node.ExecuteFunctionFunc = func(context.Context, execute.Request, string) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+	node.ExecuteFunctionFunc = func(context.Context, execute.Request, string, bls.Webhook) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
 
 		res := execute.ResultMap{
 			mocks.GenericPeerID: execute.NodeResult{Result: executionResult},
@@ -118,6 +182,105 @@ func TestAPI_Execute_HandlesErrors(t *testing.T) {
 	require.Equal(t, mocks.GenericPeerID, res.Results[0].Peers[0])
 }
 
+func TestAPI_Execute_OverLimit(t *testing.T) {
+
+	limitErr := &bls.LimitError{
+		Limit:      bls.LimitClientRate,
+		RetryAfter: 1500 * time.Millisecond,
+	}
+
+	node := mocks.BaselineNode(t)
+	node.ExecuteFunctionFunc = func(context.Context, execute.Request, string, bls.Webhook) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+		return codes.TooManyRequests, mocks.GenericUUID.String(), nil, execute.Cluster{}, fmt.Errorf("could not execute: %w", limitErr)
+	}
+
+	srv := api.New(mocks.NoopLogger, node)
+
+	rec, ctx, err := setupRecorder(executeEndpoint, mocks.GenericExecutionRequest)
+	require.NoError(t, err)
+
+	err = srv.ExecuteFunction(ctx)
+	require.NoError(t, err)
+
+	var res api.ExecutionResponse
+	err = json.Unmarshal(rec.Body.Bytes(), &res)
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusTooManyRequests, rec.Result().StatusCode)
+	require.Equal(t, "2", rec.Header().Get(echo.HeaderRetryAfter))
+	require.Equal(t, codes.TooManyRequests.String(), res.Code)
+	require.Equal(t, limitErr.Error(), res.Message)
+}
+
+func TestAPI_Execute_Async(t *testing.T) {
+
+	const requestID = "dummy-request-id"
+
+	node := mocks.BaselineNode(t)
+	node.ExecuteFunctionFunc = func(context.Context, execute.Request, string, bls.Webhook) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+		require.FailNow(t, "synchronous execution should not be used")
+		return codes.Error, "", nil, execute.Cluster{}, nil
+	}
+	node.ExecuteFunctionAsyncFunc = func(_ context.Context, req execute.Request, _ string, _ bls.Webhook) (string, error) {
+		require.Equal(t, mocks.GenericExecutionRequest.FunctionID, req.FunctionID)
+		return requestID, nil
+	}
+
+	srv := api.New(mocks.NoopLogger, node)
+
+	req := api.ExecutionRequest{
+		FunctionId: mocks.GenericExecutionRequest.FunctionID,
+		Method:     mocks.GenericExecutionRequest.Method,
+		Parameters: mocks.GenericExecutionRequest.Parameters,
+		Async:      true,
+	}
+
+	rec, ctx, err := setupRecorder(executeEndpoint, req)
+	require.NoError(t, err)
+
+	err = srv.ExecuteFunction(ctx)
+	require.NoError(t, err)
+
+	var res api.ExecutionResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+	require.Equal(t, http.StatusAccepted, rec.Result().StatusCode)
+	require.Equal(t, requestID, res.RequestId)
+	require.Empty(t, res.Results)
+}
+
+func TestAPI_Execute_AsyncOverLimit(t *testing.T) {
+
+	limitErr := &bls.LimitError{Limit: bls.LimitAsyncExecutions, RetryAfter: time.Second}
+
+	node := mocks.BaselineNode(t)
+	node.ExecuteFunctionAsyncFunc = func(context.Context, execute.Request, string, bls.Webhook) (string, error) {
+		return "", limitErr
+	}
+
+	srv := api.New(mocks.NoopLogger, node)
+
+	req := api.ExecutionRequest{
+		FunctionId: mocks.GenericExecutionRequest.FunctionID,
+		Method:     mocks.GenericExecutionRequest.Method,
+		Async:      true,
+	}
+
+	rec, ctx, err := setupRecorder(executeEndpoint, req)
+	require.NoError(t, err)
+
+	err = srv.ExecuteFunction(ctx)
+	require.NoError(t, err)
+
+	var res api.ExecutionResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+	require.Equal(t, http.StatusTooManyRequests, rec.Result().StatusCode)
+	require.Equal(t, "1", rec.Header().Get(echo.HeaderRetryAfter))
+	require.Equal(t, codes.TooManyRequests.String(), res.Code)
+	require.Equal(t, limitErr.Error(), res.Message)
+}
+
 func TestAPI_Execute_HandlesMalformedRequests(t *testing.T) {
 
 	api := setupAPI(t)
@@ -143,6 +306,13 @@ func TestAPI_Execute_HandlesMalformedRequests(t *testing.T) {
 			"method" : "wasm",
 			"parameters" : [ {"name":"generic-param-name","value":"generic-param-value"} ]
 		}`
+
+		invalidCallback = `
+		{
+			"function_id" : "generic-function-id",
+			"method" : "wasm",
+			"callback" : { "url": "ftp://example.com/webhook" }
+		}`
 	)
 
 	tests := []struct {
@@ -164,6 +334,11 @@ func TestAPI_Execute_HandlesMalformedRequests(t *testing.T) {
 			name:    "valid JSON with no MIME type",
 			payload: []byte(validJSON),
 		},
+		{
+			name:        "invalid callback URL",
+			payload:     []byte(invalidCallback),
+			contentType: echo.MIMEApplicationJSON,
+		},
 	}
 
 	for _, test := range tests {
@@ -1,12 +1,22 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/labstack/echo/v4"
+
+	"github.com/blessnetwork/b7s/models/bls"
+)
+
+const (
+	// How long do we wait for workers to confirm the installation, if the request does not specify it.
+	defaultInstallWaitTimeout = 30 * time.Second
+	maxInstallWaitTimeout     = 10 * time.Minute
 )
 
 func (r FunctionInstallRequest) Valid() error {
@@ -15,6 +25,27 @@ func (r FunctionInstallRequest) Valid() error {
 		return errors.New("function CID is required")
 	}
 
+	if r.Wait < 0 {
+		return errors.New("number of workers to wait for cannot be negative")
+	}
+
+	if r.Timeout < 0 {
+		return errors.New("timeout cannot be negative")
+	}
+
+	if time.Duration(r.Timeout)*time.Second > maxInstallWaitTimeout {
+		return fmt.Errorf("timeout cannot be longer than %v", maxInstallWaitTimeout)
+	}
+
+	return nil
+}
+
+func (r FunctionInstallStatusRequest) Valid() error {
+
+	if r.Id == "" {
+		return errors.New("install ID is required")
+	}
+
 	return nil
 }
 
@@ -32,13 +63,64 @@ func (a *API) InstallFunction(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
 	}
 
-	err = a.Node.PublishFunctionInstall(ctx.Request().Context(), req.Uri, req.Cid, req.Topic)
+	id, err := a.Node.PublishFunctionInstall(ctx.Request().Context(), req.Uri, req.Cid, req.Topic)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("function installation failed: %w", err))
 	}
 
-	return ctx.JSON(http.StatusOK, map[string]interface{}{
-		"code": strconv.Itoa(http.StatusOK),
-	})
+	res := FunctionInstallResponse{
+		Code:      strconv.Itoa(http.StatusOK),
+		InstallId: id,
+	}
+
+	if req.Wait == 0 {
+		return ctx.JSON(http.StatusOK, res)
+	}
+
+	// Wait until enough workers confirm the installation.
+	timeout := defaultInstallWaitTimeout
+	if req.Timeout > 0 {
+		timeout = time.Duration(req.Timeout) * time.Second
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx.Request().Context(), timeout)
+	defer cancel()
+
+	record, err := a.Node.WaitFunctionInstall(waitCtx, id, uint(req.Wait))
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		res.Code = strconv.Itoa(http.StatusRequestTimeout)
+	case err != nil:
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not wait for function installation: %w", err))
+	}
+
+	res.Install = &record
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// FunctionInstallStatus implements the REST API endpoint for retrieving the confirmations workers sent for a function installation.
+func (a *API) FunctionInstallStatus(ctx echo.Context) error {
+
+	var req FunctionInstallStatusRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = req.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+	}
+
+	record, err := a.Node.FunctionInstallStatus(ctx.Request().Context(), req.Id)
+	if err != nil {
+		if errors.Is(err, bls.ErrNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve install status: %w", err))
+	}
 
+	return ctx.JSON(http.StatusOK, record)
 }
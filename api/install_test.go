@@ -2,6 +2,8 @@ package api_test
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"testing"
 
@@ -9,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/blessnetwork/b7s/api"
+	"github.com/blessnetwork/b7s/models/bls"
 	"github.com/blessnetwork/b7s/testing/mocks"
 )
 
@@ -30,6 +33,75 @@ func TestAPI_FunctionInstall(t *testing.T) {
 		require.NoError(t, err)
 
 		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var res api.FunctionInstallResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, "200", res.Code)
+		require.Equal(t, mocks.GenericUUID.String(), res.InstallId)
+		require.Nil(t, res.Install)
+	})
+	t.Run("waiting for workers", func(t *testing.T) {
+		t.Parallel()
+
+		req := api.FunctionInstallRequest{
+			Cid:  "dummy-cid",
+			Wait: 3,
+		}
+
+		var workers uint
+		node := mocks.BaselineNode(t)
+		node.WaitFunctionInstallFunc = func(ctx context.Context, id string, n uint) (bls.FunctionInstallRecord, error) {
+			require.Equal(t, mocks.GenericUUID.String(), id)
+			workers = n
+			return mocks.GenericFunctionInstallRecord, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		rec, ctx, err := setupRecorder(installEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.InstallFunction(ctx)
+		require.NoError(t, err)
+
+		var res api.FunctionInstallResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, uint(3), workers)
+		require.Equal(t, "200", res.Code)
+		require.NotNil(t, res.Install)
+		require.Equal(t, mocks.GenericFunctionInstallRecord, *res.Install)
+	})
+	t.Run("workers do not confirm in time", func(t *testing.T) {
+		t.Parallel()
+
+		req := api.FunctionInstallRequest{
+			Cid:     "dummy-cid",
+			Wait:    3,
+			Timeout: 1,
+		}
+
+		node := mocks.BaselineNode(t)
+		node.WaitFunctionInstallFunc = func(ctx context.Context, id string, n uint) (bls.FunctionInstallRecord, error) {
+			<-ctx.Done()
+			return mocks.GenericFunctionInstallRecord, fmt.Errorf("could not wait for function install: %w", ctx.Err())
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		rec, ctx, err := setupRecorder(installEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.InstallFunction(ctx)
+		require.NoError(t, err)
+
+		var res api.FunctionInstallResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, "408", res.Code)
+		require.Equal(t, mocks.GenericUUID.String(), res.InstallId)
+		require.NotNil(t, res.Install)
 	})
 }
 
@@ -55,12 +127,34 @@ func TestAPI_FunctionInstall_HandlesErrors(t *testing.T) {
 
 		require.Equal(t, http.StatusBadRequest, echoErr.Code)
 	})
+	t.Run("invalid wait options", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		for _, req := range []api.FunctionInstallRequest{
+			{Cid: "dummy-cid", Wait: -1},
+			{Cid: "dummy-cid", Wait: 1, Timeout: -1},
+			{Cid: "dummy-cid", Wait: 1, Timeout: 24 * 60 * 60},
+		} {
+			_, ctx, err := setupRecorder(installEndpoint, req)
+			require.NoError(t, err)
+
+			err = srv.InstallFunction(ctx)
+			require.Error(t, err)
+
+			echoErr, ok := err.(*echo.HTTPError)
+			require.True(t, ok)
+
+			require.Equal(t, http.StatusBadRequest, echoErr.Code)
+		}
+	})
 	t.Run("node fails to install function", func(t *testing.T) {
 		t.Parallel()
 
 		node := mocks.BaselineNode(t)
-		node.PublishFunctionInstallFunc = func(context.Context, string, string, string) error {
-			return mocks.GenericError
+		node.PublishFunctionInstallFunc = func(context.Context, string, string, string) (string, error) {
+			return "", mocks.GenericError
 		}
 
 		srv := api.New(mocks.NoopLogger, node)
@@ -151,3 +245,57 @@ func TestAPI_InstallFunction_HandlesMalformedRequests(t *testing.T) {
 		})
 	}
 }
+
+func TestAPI_FunctionInstallStatus(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		rec, ctx, err := setupRecorder(installStatusEndpoint, api.FunctionInstallStatusRequest{Id: mocks.GenericUUID.String()})
+		require.NoError(t, err)
+
+		err = srv.FunctionInstallStatus(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var res api.FunctionInstallRecord
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, mocks.GenericFunctionInstallRecord, res)
+	})
+	t.Run("missing install ID", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		_, ctx, err := setupRecorder(installStatusEndpoint, api.FunctionInstallStatusRequest{})
+		require.NoError(t, err)
+
+		err = srv.FunctionInstallStatus(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+	t.Run("install not found", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.FunctionInstallStatusFunc = func(context.Context, string) (bls.FunctionInstallRecord, error) {
+			return bls.FunctionInstallRecord{}, bls.ErrNotFound
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		rec, ctx, err := setupRecorder(installStatusEndpoint, api.FunctionInstallStatusRequest{Id: mocks.GenericUUID.String()})
+		require.NoError(t, err)
+
+		err = srv.FunctionInstallStatus(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+	})
+}
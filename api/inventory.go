@@ -0,0 +1,32 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/blessnetwork/b7s/models/bls"
+)
+
+// FunctionInventory implements the REST API endpoint for listing functions installed on worker nodes.
+func (a *API) FunctionInventory(ctx echo.Context) error {
+
+	var req FunctionInventoryRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	workers, err := a.Node.FunctionInventory(ctx.Request().Context(), req.Topic)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve function inventory: %w", err))
+	}
+
+	res := FunctionInventoryReport{
+		Functions: bls.AggregateFunctionInventory(workers),
+		Workers:   workers,
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
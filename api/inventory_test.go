@@ -0,0 +1,81 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/api"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestAPI_FunctionInventory(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		rec, ctx, err := setupRecorder(inventoryEndpoint, api.FunctionInventoryRequest{})
+		require.NoError(t, err)
+
+		err = srv.FunctionInventory(ctx)
+		require.NoError(t, err)
+
+		var res api.FunctionInventoryReport
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		require.Len(t, res.Workers, len(mocks.GenericWorkerFunctions))
+
+		require.Len(t, res.Functions, 2)
+		require.Equal(t, uint(2), res.Functions[0].Workers)
+		require.Equal(t, []string{"1.0.0", "1.1.0"}, res.Functions[0].Versions)
+	})
+	t.Run("topic is passed to the node", func(t *testing.T) {
+		t.Parallel()
+
+		const topic = "dummy-topic"
+
+		var subgroup string
+		node := mocks.BaselineNode(t)
+		node.FunctionInventoryFunc = func(_ context.Context, s string) ([]bls.WorkerFunctions, error) {
+			subgroup = s
+			return nil, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		_, ctx, err := setupRecorder(inventoryEndpoint, api.FunctionInventoryRequest{Topic: topic})
+		require.NoError(t, err)
+
+		err = srv.FunctionInventory(ctx)
+		require.NoError(t, err)
+		require.Equal(t, topic, subgroup)
+	})
+	t.Run("node fails to retrieve inventory", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.FunctionInventoryFunc = func(context.Context, string) ([]bls.WorkerFunctions, error) {
+			return nil, mocks.GenericError
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		_, ctx, err := setupRecorder(inventoryEndpoint, api.FunctionInventoryRequest{})
+		require.NoError(t, err)
+
+		err = srv.FunctionInventory(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusInternalServerError, echoErr.Code)
+	})
+}
@@ -4,27 +4,113 @@
 package api
 
 import (
+	"time"
+
+	"github.com/blessnetwork/b7s/consensus/pbft"
+	"github.com/blessnetwork/b7s/models/bls"
 	"github.com/blessnetwork/b7s/models/execute"
 	"github.com/blessnetwork/b7s/node/aggregate"
 )
 
+// Defines values for FunctionStatusResponsePhase.
+const (
+	ClusterFormed FunctionStatusResponsePhase = "cluster_formed"
+	Done          FunctionStatusResponsePhase = "done"
+	Executing     FunctionStatusResponsePhase = "executing"
+	RollCall      FunctionStatusResponsePhase = "roll_call"
+)
+
+// Defines values for UsageRequestGroupBy.
+const (
+	UsageGroupByClient   UsageRequestGroupBy = "client"
+	UsageGroupByDay      UsageRequestGroupBy = "day"
+	UsageGroupByFunction UsageRequestGroupBy = "function"
+	UsageGroupByWorker   UsageRequestGroupBy = "worker"
+)
+
+// Defines values for UsageExportRequestFormat.
+const (
+	UsageExportCSV  UsageExportRequestFormat = "csv"
+	UsageExportJSON UsageExportRequestFormat = "json"
+)
+
 // AggregatedResult Result of an Execution Request
 type AggregatedResult = aggregate.Result
 
 // AggregatedResults List of unique results of the Execution Request
 type AggregatedResults = aggregate.Results
 
+// Aggregation Outcome of the aggregation strategy requested for the Execution Request
+type Aggregation = aggregate.Aggregation
+
+// AggregationPeerValue Value a single node contributed to the aggregation
+type AggregationPeerValue = aggregate.PeerValue
+
 // AttributeAttestors Require specific attestors as vouchers
 type AttributeAttestors = execute.AttributeAttestors
 
+// AttributePredicate Condition the value of a Node attribute should satisfy. Nodes that do not have the attribute never satisfy it.
+type AttributePredicate = execute.AttributePredicate
+
+// CompletionPolicy Policy determining when the head node stops waiting for execution results. Peers that did not respond by then are reported as late.
+type CompletionPolicy = execute.CompletionPolicy
+
+// ExecutionBatchItem Execution Request in a batch
+type ExecutionBatchItem struct {
+	// Config Configuration options for the Execution Request
+	Config ExecutionConfig `json:"config,omitempty"`
+
+	// FunctionId CID of the function
+	FunctionId string `json:"function_id"`
+
+	// Method Name of the WASM file to execute
+	Method string `json:"method"`
+
+	// Parameters CLI arguments for the Bless Function
+	Parameters []ExecutionParameter `json:"parameters,omitempty"`
+}
+
+// ExecutionBatchRequest Batch of Execution Requests
+type ExecutionBatchRequest struct {
+	// Parallelism Maximum number of requests executed at the same time. Cannot exceed the limit set on the head node
+	Parallelism int `json:"parallelism,omitempty"`
+
+	// Requests Execution Requests in the batch
+	Requests []ExecutionBatchItem `json:"requests"`
+
+	// Topic In the scenario where workers form subgroups, you can target a specific subgroup by specifying its identifier
+	Topic string `json:"topic,omitempty"`
+}
+
+// ExecutionBatchResponse Results of the Execution Requests in the batch
+type ExecutionBatchResponse struct {
+	// Results Result of each Execution Request, in the order of the requests in the batch
+	Results []ExecutionResponse `json:"results,omitempty"`
+}
+
+// ExecutionCallback Webhook the execution result is delivered to once the execution is done
+type ExecutionCallback = bls.Webhook
+
 // ExecutionConfig Configuration options for the Execution Request
 type ExecutionConfig = execute.Config
 
+// ExecutionEvent Progress event of an Execution Request
+type ExecutionEvent = bls.ExecutionEvent
+
 // ExecutionParameter defines model for ExecutionParameter.
 type ExecutionParameter = execute.Parameter
 
+// ExecutionReceipt Statement signed by a worker node that it executed the request and which output it produced. Signature covers the canonical encoding of the receipt
+type ExecutionReceipt = execute.Receipt
+
 // ExecutionRequest defines model for ExecutionRequest.
 type ExecutionRequest struct {
+	// Async Return the request ID immediately and run the execution in the background
+	Async bool `json:"async,omitempty"`
+
+	// Callback Webhook the execution result is delivered to once the execution is done
+	Callback ExecutionCallback `json:"callback,omitempty"`
+
 	// Config Configuration options for the Execution Request
 	Config ExecutionConfig `json:"config,omitempty"`
 
@@ -37,12 +123,21 @@ type ExecutionRequest struct {
 	// Parameters CLI arguments for the Bless Function
 	Parameters []ExecutionParameter `json:"parameters,omitempty"`
 
+	// Signature Hex-encoded signature of the Execution Request, made using the key of the authenticated client. If set, the signature is verified before the request is executed
+	Signature string `json:"signature,omitempty"`
+
 	// Topic In the scenario where workers form subgroups, you can target a specific subgroup by specifying its identifier
 	Topic string `json:"topic,omitempty"`
 }
 
 // ExecutionResponse defines model for ExecutionResponse.
 type ExecutionResponse struct {
+	// Aggregation Outcome of the aggregation strategy requested for the Execution Request
+	Aggregation *Aggregation `json:"aggregation,omitempty"`
+
+	// Certificate Proof that enough replicas of a PBFT cluster produced the same result. Only set for requests executed using PBFT
+	Certificate *ResultCertificate `json:"certificate,omitempty"`
+
 	// Cluster Information about the cluster of nodes that executed this request
 	Cluster NodeCluster `json:"cluster,omitempty"`
 
@@ -52,6 +147,9 @@ type ExecutionResponse struct {
 	// Message If the Execution Request failed, this message might have more info about the error
 	Message string `json:"message,omitempty"`
 
+	// Receipts Execution receipts signed by the worker nodes, ordered by worker node peer ID
+	Receipts []ExecutionReceipt `json:"receipts,omitempty"`
+
 	// RequestId ID of the Execution Request
 	RequestId string `json:"request_id,omitempty"`
 
@@ -67,16 +165,76 @@ type FunctionInstallRequest struct {
 	// Cid CID of the function
 	Cid string `json:"cid"`
 
+	// Timeout How long to wait for worker nodes to confirm the installation, in seconds
+	Timeout int `json:"timeout,omitempty"`
+
 	// Topic In a scenario where workers form subgroups, you can target a specific subgroup by specifying its identifier
 	Topic string `json:"topic,omitempty"`
 	Uri   string `json:"uri,omitempty"`
+
+	// Wait Number of worker nodes that should confirm the installation before the response is sent. By default the response is sent immediately
+	Wait int `json:"wait,omitempty"`
 }
 
+// FunctionInstallRecord Function installation, along with the confirmations of worker nodes
+type FunctionInstallRecord = bls.FunctionInstallRecord
+
 // FunctionInstallResponse defines model for FunctionInstallResponse.
 type FunctionInstallResponse struct {
 	Code string `json:"code,omitempty"`
+
+	// Install Function installation, along with the confirmations of worker nodes
+	Install *FunctionInstallRecord `json:"install,omitempty"`
+
+	// InstallId ID of the installation, used to retrieve its status
+	InstallId string `json:"install_id,omitempty"`
+}
+
+// FunctionInstallStatusRequest defines model for FunctionInstallStatusRequest.
+type FunctionInstallStatusRequest struct {
+	// Id ID of the installation
+	Id string `json:"id"`
 }
 
+// FunctionInventory Worker nodes that have the function installed
+type FunctionInventory = bls.FunctionInventory
+
+// FunctionInventoryReport Functions installed on worker nodes
+type FunctionInventoryReport struct {
+	// Functions Installed functions, most widely installed functions first
+	Functions []FunctionInventory `json:"functions,omitempty"`
+
+	// Workers Functions installed on each worker node
+	Workers []WorkerFunctions `json:"workers,omitempty"`
+}
+
+// FunctionInventoryRequest defines model for FunctionInventoryRequest.
+type FunctionInventoryRequest struct {
+	// Topic In a scenario where workers form subgroups, you can target a specific subgroup by specifying its identifier
+	Topic string `json:"topic,omitempty"`
+}
+
+// FunctionUninstallRequest defines model for FunctionUninstallRequest.
+type FunctionUninstallRequest struct {
+	// Cid CID of the function
+	Cid string `json:"cid"`
+
+	// Topic In a scenario where workers form subgroups, you can target a specific subgroup by specifying its identifier
+	Topic string `json:"topic,omitempty"`
+}
+
+// FunctionUninstallResponse defines model for FunctionUninstallResponse.
+type FunctionUninstallResponse struct {
+	// Cid CID of the function
+	Cid string `json:"cid,omitempty"`
+
+	// Workers Confirmations of worker nodes
+	Workers []FunctionUninstallResult `json:"workers,omitempty"`
+}
+
+// FunctionUninstallResult Outcome of removing the function from a worker node
+type FunctionUninstallResult = bls.FunctionUninstallResult
+
 // FunctionResultRequest Get the result of an Execution Request, identified by the request ID
 type FunctionResultRequest struct {
 	// Id ID of the Execution Request
@@ -86,11 +244,71 @@ type FunctionResultRequest struct {
 // FunctionResultResponse defines model for FunctionResultResponse.
 type FunctionResultResponse = ExecutionResponse
 
+// FunctionEventsRequest Stream progress events of an Execution Request, identified by the request ID
+type FunctionEventsRequest struct {
+	// Id ID of the Execution Request
+	Id string `json:"id"`
+}
+
+// FunctionStatusRequest Get the status of an Execution Request, identified by the request ID
+type FunctionStatusRequest struct {
+	// Id ID of the Execution Request
+	Id string `json:"id"`
+}
+
+// FunctionStatusResponse Status of an Execution Request
+type FunctionStatusResponse struct {
+	// Aggregation Outcome of the aggregation strategy requested for the Execution Request
+	Aggregation *Aggregation `json:"aggregation,omitempty"`
+
+	// Certificate Proof that enough replicas of a PBFT cluster produced the same result. Only set for requests executed using PBFT
+	Certificate *ResultCertificate `json:"certificate,omitempty"`
+
+	// Cluster Information about the cluster of nodes that executed this request
+	Cluster NodeCluster `json:"cluster,omitempty"`
+
+	// Code Status of the execution, set once the execution is done
+	Code string `json:"code,omitempty"`
+
+	// Message If the Execution Request failed, this message might have more info about the error
+	Message string `json:"message,omitempty"`
+
+	// Phase Phase of the execution
+	Phase FunctionStatusResponsePhase `json:"phase,omitempty"`
+
+	// Receipts Execution receipts signed by the worker nodes, ordered by worker node peer ID
+	Receipts []ExecutionReceipt `json:"receipts,omitempty"`
+
+	// RequestId ID of the Execution Request
+	RequestId string `json:"request_id,omitempty"`
+
+	// Results List of unique results of the Execution Request
+	Results AggregatedResults `json:"results,omitempty"`
+}
+
+// FunctionStatusResponsePhase Phase of the execution
+type FunctionStatusResponsePhase string
+
+// FunctionCancelRequest Cancel an Execution Request, identified by the request ID
+type FunctionCancelRequest struct {
+	// Id ID of the Execution Request
+	Id string `json:"id"`
+}
+
+// FunctionCancelResponse Cancelled Execution Request
+type FunctionCancelResponse struct {
+	// RequestId ID of the Execution Request
+	RequestId string `json:"request_id,omitempty"`
+}
+
 // HealthStatus Node status
 type HealthStatus struct {
 	Code string `json:"code,omitempty"`
 }
 
+// InstalledFunction Function installed on a worker node
+type InstalledFunction = bls.InstalledFunction
+
 // NamedValue A key-value pair
 type NamedValue = execute.EnvVar
 
@@ -100,17 +318,275 @@ type NodeAttributes = execute.Attributes
 // NodeCluster Information about the cluster of nodes that executed this request
 type NodeCluster = execute.Cluster
 
+// NodeLoad How busy a worker node is
+type NodeLoad = bls.NodeLoad
+
+// PeerInfo Node status, as last reported in its health ping
+type PeerInfo = bls.PeerInfo
+
+// PeerList Nodes known to the head node
+type PeerList struct {
+	Peers []PeerInfo `json:"peers,omitempty"`
+}
+
+// PeerReputation Reputation of a worker node. Counters decay over time so recent behavior weighs more.
+type PeerReputation = bls.PeerReputation
+
+// PeerReputationList Reputation of worker nodes
+type PeerReputationList struct {
+	Peers []PeerReputation `json:"peers,omitempty"`
+}
+
+// PipelineInput Maps the standard output of the most frequent result of an earlier step to the input of a step
+type PipelineInput = execute.PipelineInput
+
+// PipelineRequest Pipeline of Execution Requests
+type PipelineRequest struct {
+	// Steps Steps of the pipeline. Steps and their inputs must form a directed acyclic graph
+	Steps []PipelineStep `json:"steps"`
+
+	// Topic In the scenario where workers form subgroups, you can target a specific subgroup by specifying its identifier
+	Topic string `json:"topic,omitempty"`
+}
+
+// PipelineResponse Outcome of the pipeline execution
+type PipelineResponse struct {
+	// Code Status of the pipeline execution. Successful only if all steps were successful
+	Code string `json:"code,omitempty"`
+
+	// PipelineId ID of the pipeline execution
+	PipelineId string `json:"pipeline_id,omitempty"`
+
+	// Skipped Steps that were not executed because a step they depend on failed
+	Skipped []string `json:"skipped,omitempty"`
+
+	// Steps Results of the executed steps, mapped by step ID
+	Steps map[string]ExecutionResponse `json:"steps,omitempty"`
+}
+
+// PipelineResultRequest Get the result of a pipeline execution, identified by the pipeline ID
+type PipelineResultRequest struct {
+	// Id ID of the pipeline execution
+	Id string `json:"id"`
+}
+
+// PipelineStep Execution Request in a pipeline
+type PipelineStep struct {
+	// Config Configuration options for the Execution Request
+	Config ExecutionConfig `json:"config,omitempty"`
+
+	// FunctionId CID of the function
+	FunctionId string `json:"function_id"`
+
+	// Id ID of the step, unique within the pipeline
+	Id string `json:"id"`
+
+	// Inputs Outputs of earlier steps used by this step
+	Inputs []PipelineInput `json:"inputs,omitempty"`
+
+	// Method Name of the WASM file to execute
+	Method string `json:"method"`
+
+	// Parameters CLI arguments for the Bless Function
+	Parameters []ExecutionParameter `json:"parameters,omitempty"`
+}
+
 // ResultAggregation defines model for ResultAggregation.
 type ResultAggregation = execute.ResultAggregation
 
+// ResultCertificate Proof that enough replicas of a PBFT cluster produced the same result. Only set for requests executed using PBFT
+type ResultCertificate = pbft.ResultCertificate
+
+// RetryPolicy Policy for replacing workers that did not respond to a work order or responded with an error. Only supported for executions without consensus.
+type RetryPolicy = execute.RetryPolicy
+
 // RuntimeConfig Configuration options for the Bless Runtime
 type RuntimeConfig = execute.BLSRuntimeConfig
 
+// Schedule Recurring execution
+type Schedule = bls.Schedule
+
+// ScheduleCreateRequest Recurring execution to create
+type ScheduleCreateRequest struct {
+	// Callback Webhook the execution result is delivered to once the execution is done
+	Callback ExecutionCallback `json:"callback,omitempty"`
+
+	// Config Configuration options for the Execution Request
+	Config ExecutionConfig `json:"config,omitempty"`
+
+	// Cron Cron expression with five fields (minute, hour, day of month, month, day of week), or a descriptor like `@hourly`. Evaluated in UTC
+	Cron string `json:"cron"`
+
+	// FunctionId CID of the function
+	FunctionId string `json:"function_id"`
+
+	// Method Name of the WASM file to execute
+	Method string `json:"method"`
+
+	// Parameters CLI arguments for the Bless Function
+	Parameters []ExecutionParameter `json:"parameters,omitempty"`
+
+	// Topic In the scenario where workers form subgroups, you can target a specific subgroup by specifying its identifier
+	Topic string `json:"topic,omitempty"`
+}
+
+// ScheduleDeleteRequest Delete a schedule, identified by the schedule ID
+type ScheduleDeleteRequest struct {
+	// Id ID of the schedule
+	Id string `json:"id"`
+}
+
+// ScheduleDeleteResponse Deleted schedule
+type ScheduleDeleteResponse struct {
+	// Id ID of the schedule
+	Id string `json:"id,omitempty"`
+}
+
+// ScheduleList Recurring executions
+type ScheduleList struct {
+	Schedules []Schedule `json:"schedules,omitempty"`
+}
+
+// SchedulePauseRequest Pause or resume a schedule, identified by the schedule ID
+type SchedulePauseRequest struct {
+	// Id ID of the schedule
+	Id string `json:"id"`
+
+	// Paused Pause the schedule if true, resume it if false
+	Paused bool `json:"paused,omitempty"`
+}
+
+// WorkerFunctions Functions installed on a worker node
+type WorkerFunctions = bls.WorkerFunctions
+
+// WorkerInstallStatus Confirmation a worker node sent for a function installation
+type WorkerInstallStatus = bls.WorkerInstallStatus
+
+// UsageRequest Query for resource usage totals
+type UsageRequest struct {
+	// Client Only include usage of the client with this ID
+	Client string `json:"client,omitempty"`
+
+	// From Start of the time range, inclusive. If not set, the range starts with the oldest entry
+	From time.Time `json:"from,omitempty"`
+
+	// FunctionId Only include usage of the function with this CID
+	FunctionId string `json:"function_id,omitempty"`
+
+	// GroupBy Report usage totals per client, function, worker node or day (UTC), besides the overall total
+	GroupBy UsageRequestGroupBy `json:"group_by,omitempty"`
+
+	// To End of the time range, exclusive. If not set, the range ends now
+	To time.Time `json:"to,omitempty"`
+
+	// Worker Only include usage on the worker node with this peer ID
+	Worker string `json:"worker,omitempty"`
+}
+
+// UsageRequestGroupBy Report usage totals per client, function, worker node or day (UTC), besides the overall total
+type UsageRequestGroupBy string
+
+// UsageExportRequest Query for usage ledger entries
+type UsageExportRequest struct {
+	// Client Only include usage of the client with this ID
+	Client string `json:"client,omitempty"`
+
+	// Format Format of the export. Defaults to JSON
+	Format UsageExportRequestFormat `json:"format,omitempty"`
+
+	// From Start of the time range, inclusive. If not set, the range starts with the oldest entry
+	From time.Time `json:"from,omitempty"`
+
+	// FunctionId Only include usage of the function with this CID
+	FunctionId string `json:"function_id,omitempty"`
+
+	// To End of the time range, exclusive. If not set, the range ends now
+	To time.Time `json:"to,omitempty"`
+
+	// Worker Only include usage on the worker node with this peer ID
+	Worker string `json:"worker,omitempty"`
+}
+
+// UsageExportRequestFormat Format of the export. Defaults to JSON
+type UsageExportRequestFormat string
+
+// UsageResponse Resource usage totals over a time range
+type UsageResponse struct {
+	// From Start of the time range
+	From time.Time `json:"from,omitempty"`
+
+	// Groups Usage totals per group, if grouping was requested
+	Groups []UsageGroup `json:"groups,omitempty"`
+
+	// To End of the time range
+	To time.Time `json:"to,omitempty"`
+
+	// Total Resource usage accumulated over a number of executions. Durations are in nanoseconds
+	Total UsageTotals `json:"total,omitempty"`
+}
+
+// UsageGroup Resource usage totals of a group
+type UsageGroup struct {
+	// Key Client ID, function CID, worker node peer ID or day (YYYY-MM-DD), depending on the grouping
+	Key string `json:"key,omitempty"`
+
+	// Usage Resource usage accumulated over a number of executions. Durations are in nanoseconds
+	Usage UsageTotals `json:"usage,omitempty"`
+}
+
+// UsageTotals Resource usage accumulated over a number of executions. Durations are in nanoseconds
+type UsageTotals = bls.UsageTotals
+
+// UsageEntry Resource usage of an execution on a single worker node, signed by that node. Durations are in nanoseconds
+type UsageEntry = execute.UsageEntry
+
 // ExecuteFunctionJSONRequestBody defines body for ExecuteFunction for application/json ContentType.
 type ExecuteFunctionJSONRequestBody = ExecutionRequest
 
+// ExecuteFunctionBatchJSONRequestBody defines body for ExecuteFunctionBatch for application/json ContentType.
+type ExecuteFunctionBatchJSONRequestBody = ExecutionBatchRequest
+
+// ExecutePipelineJSONRequestBody defines body for ExecutePipeline for application/json ContentType.
+type ExecutePipelineJSONRequestBody = PipelineRequest
+
+// PipelineResultJSONRequestBody defines body for PipelineResult for application/json ContentType.
+type PipelineResultJSONRequestBody = PipelineResultRequest
+
 // InstallFunctionJSONRequestBody defines body for InstallFunction for application/json ContentType.
 type InstallFunctionJSONRequestBody = FunctionInstallRequest
 
+// FunctionInstallStatusJSONRequestBody defines body for FunctionInstallStatus for application/json ContentType.
+type FunctionInstallStatusJSONRequestBody = FunctionInstallStatusRequest
+
+// UninstallFunctionJSONRequestBody defines body for UninstallFunction for application/json ContentType.
+type UninstallFunctionJSONRequestBody = FunctionUninstallRequest
+
+// FunctionInventoryJSONRequestBody defines body for FunctionInventory for application/json ContentType.
+type FunctionInventoryJSONRequestBody = FunctionInventoryRequest
+
 // ExecutionResultJSONRequestBody defines body for ExecutionResult for application/json ContentType.
 type ExecutionResultJSONRequestBody = FunctionResultRequest
+
+// ExecutionEventsJSONRequestBody defines body for ExecutionEvents for application/json ContentType.
+type ExecutionEventsJSONRequestBody = FunctionEventsRequest
+
+// ExecutionStatusJSONRequestBody defines body for ExecutionStatus for application/json ContentType.
+type ExecutionStatusJSONRequestBody = FunctionStatusRequest
+
+// CancelExecutionJSONRequestBody defines body for CancelExecution for application/json ContentType.
+type CancelExecutionJSONRequestBody = FunctionCancelRequest
+
+// DeleteScheduleJSONRequestBody defines body for DeleteSchedule for application/json ContentType.
+type DeleteScheduleJSONRequestBody = ScheduleDeleteRequest
+
+// CreateScheduleJSONRequestBody defines body for CreateSchedule for application/json ContentType.
+type CreateScheduleJSONRequestBody = ScheduleCreateRequest
+
+// PauseScheduleJSONRequestBody defines body for PauseSchedule for application/json ContentType.
+type PauseScheduleJSONRequestBody = SchedulePauseRequest
+
+// UsageTotalsJSONRequestBody defines body for UsageTotals for application/json ContentType.
+type UsageTotalsJSONRequestBody = UsageRequest
+
+// ExportUsageJSONRequestBody defines body for ExportUsage for application/json ContentType.
+type ExportUsageJSONRequestBody = UsageExportRequest
@@ -3,12 +3,31 @@ package api
 import (
 	"context"
 
+	"github.com/blessnetwork/b7s/models/bls"
 	"github.com/blessnetwork/b7s/models/codes"
 	"github.com/blessnetwork/b7s/models/execute"
 )
 
 type Node interface {
-	ExecuteFunction(ctx context.Context, req execute.Request, subgroup string) (code codes.Code, requestID string, results execute.ResultMap, peers execute.Cluster, err error)
-	ExecutionResult(id string) (execute.ResultMap, bool)
-	PublishFunctionInstall(ctx context.Context, uri string, cid string, subgroup string) error
+	ExecuteFunction(ctx context.Context, req execute.Request, subgroup string, webhook bls.Webhook) (code codes.Code, requestID string, results execute.ResultMap, peers execute.Cluster, err error)
+	ExecuteFunctionAsync(ctx context.Context, req execute.Request, subgroup string, webhook bls.Webhook) (requestID string, err error)
+	ExecuteFunctionBatch(ctx context.Context, reqs []execute.Request, subgroup string, parallelism uint) []bls.ExecutionRecord
+	ExecutePipeline(ctx context.Context, pipeline execute.Pipeline, subgroup string) (bls.PipelineRecord, error)
+	PipelineResult(ctx context.Context, id string) (bls.PipelineRecord, error)
+	ExecutionResult(ctx context.Context, id string) (bls.ExecutionRecord, error)
+	ExecutionStatus(ctx context.Context, id string) (bls.ExecutionStatus, error)
+	ExecutionEvents(ctx context.Context, id string) (<-chan bls.ExecutionEvent, error)
+	CancelExecution(ctx context.Context, id string) error
+	PublishFunctionInstall(ctx context.Context, uri string, cid string, subgroup string) (string, error)
+	FunctionInstallStatus(ctx context.Context, id string) (bls.FunctionInstallRecord, error)
+	WaitFunctionInstall(ctx context.Context, id string, workers uint) (bls.FunctionInstallRecord, error)
+	PublishFunctionUninstall(ctx context.Context, cid string, subgroup string) ([]bls.FunctionUninstallResult, error)
+	FunctionInventory(ctx context.Context, subgroup string) ([]bls.WorkerFunctions, error)
+	Peers(ctx context.Context) ([]bls.PeerInfo, error)
+	PeerReputations(ctx context.Context) ([]bls.PeerReputation, error)
+	CreateSchedule(ctx context.Context, cron string, req execute.Request, subgroup string, webhook bls.Webhook) (bls.Schedule, error)
+	Schedules(ctx context.Context) ([]bls.Schedule, error)
+	PauseSchedule(ctx context.Context, id string, paused bool) (bls.Schedule, error)
+	DeleteSchedule(ctx context.Context, id string) error
+	UsageEntries(ctx context.Context, filter bls.UsageFilter) ([]execute.UsageEntry, error)
 }
@@ -0,0 +1,23 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ListPeers implements the REST API endpoint for listing nodes known to the head node.
+func (a *API) ListPeers(ctx echo.Context) error {
+
+	peers, err := a.Node.Peers(ctx.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve peers: %w", err))
+	}
+
+	res := PeerList{
+		Peers: peers,
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,63 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/api"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestAPI_ListPeers(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		rec, ctx, err := setupRecorder(peersEndpoint, nil)
+		require.NoError(t, err)
+
+		err = srv.ListPeers(ctx)
+		require.NoError(t, err)
+
+		var res api.PeerList
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		require.Len(t, res.Peers, 1)
+
+		info := mocks.GenericPeerInfo
+		require.Equal(t, info.ID, res.Peers[0].ID)
+		require.Equal(t, info.Role, res.Peers[0].Role)
+		require.Equal(t, info.Functions, res.Peers[0].Functions)
+		require.Equal(t, info.Load, res.Peers[0].Load)
+		require.True(t, info.LastSeen.Equal(res.Peers[0].LastSeen))
+	})
+	t.Run("node fails to retrieve peers", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.PeersFunc = func(context.Context) ([]bls.PeerInfo, error) {
+			return nil, mocks.GenericError
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		_, ctx, err := setupRecorder(peersEndpoint, nil)
+		require.NoError(t, err)
+
+		err = srv.ListPeers(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusInternalServerError, echoErr.Code)
+	})
+}
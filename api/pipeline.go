@@ -0,0 +1,138 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/blessnetwork/b7s/consensus/pbft"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/execute"
+)
+
+// maxPipelineSteps is the maximum number of steps in a single pipeline.
+const maxPipelineSteps = 100
+
+func (r PipelineRequest) Valid() error {
+
+	if len(r.Steps) == 0 {
+		return errors.New("pipeline has no steps")
+	}
+
+	if len(r.Steps) > maxPipelineSteps {
+		return fmt.Errorf("pipeline has too many steps (have: %d, max: %d)", len(r.Steps), maxPipelineSteps)
+	}
+
+	return nil
+}
+
+// ExecutePipeline implements the REST API endpoint for executing a pipeline of functions.
+func (a *API) ExecutePipeline(ctx echo.Context) error {
+
+	// Unpack the API request.
+	var req PipelineRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = req.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+	}
+
+	pipeline := execute.Pipeline{
+		Steps: make([]execute.PipelineStep, 0, len(req.Steps)),
+	}
+	for _, step := range req.Steps {
+		pipeline.Steps = append(pipeline.Steps, execute.PipelineStep{
+			ID: step.Id,
+			Request: execute.Request{
+				Config:     step.Config,
+				FunctionID: step.FunctionId,
+				Method:     step.Method,
+				Parameters: step.Parameters,
+				Client:     clientID(ctx),
+			},
+			Inputs: step.Inputs,
+		})
+	}
+
+	err = pipeline.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid pipeline: %w", err))
+	}
+
+	record, err := a.Node.ExecutePipeline(ctx.Request().Context(), pipeline, req.Topic)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not execute pipeline: %w", err))
+	}
+
+	return ctx.JSON(http.StatusOK, pipelineResponse(record))
+}
+
+func (r PipelineResultRequest) Valid() error {
+
+	if r.Id == "" {
+		return errors.New("pipeline ID is required")
+	}
+
+	return nil
+}
+
+// PipelineResult implements the REST API endpoint for retrieving the result of a pipeline execution.
+func (a *API) PipelineResult(ctx echo.Context) error {
+
+	var req PipelineResultRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = req.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+	}
+
+	record, err := a.Node.PipelineResult(ctx.Request().Context(), req.Id)
+	if err != nil {
+		if errors.Is(err, bls.ErrNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve pipeline result: %w", err))
+	}
+
+	if !ownedByClient(ctx, record.Client) {
+		return ctx.NoContent(http.StatusNotFound)
+	}
+
+	return ctx.JSON(http.StatusOK, pipelineResponse(record))
+}
+
+// pipelineResponse transforms the node pipeline record to the format returned by the API.
+func pipelineResponse(record bls.PipelineRecord) PipelineResponse {
+
+	res := PipelineResponse{
+		Code:       string(record.Code),
+		PipelineId: record.PipelineID,
+		Skipped:    record.Skipped,
+		Steps:      make(map[string]ExecutionResponse, len(record.Steps)),
+	}
+	for id, step := range record.Steps {
+		res.Steps[id] = ExecutionResponse{
+			Code:        string(step.Code),
+			RequestId:   step.RequestID,
+			Results:     step.Aggregated,
+			Aggregation: step.Aggregation,
+			Cluster:     step.Cluster,
+			Message:     step.Message,
+			Receipts:    step.Results.Receipts(),
+			Certificate: pbft.NewResultCertificate(step.RequestID, step.Cluster.Peers, step.Results),
+		}
+	}
+
+	return res
+}
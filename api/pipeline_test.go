@@ -0,0 +1,240 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/api"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestAPI_ExecutePipeline(t *testing.T) {
+
+	step := func(id string, inputs ...execute.PipelineInput) api.PipelineStep {
+		return api.PipelineStep{
+			Id:         id,
+			FunctionId: mocks.GenericExecutionRequest.FunctionID,
+			Method:     mocks.GenericExecutionRequest.Method,
+			Inputs:     inputs,
+		}
+	}
+
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		const topic = "dummy-topic"
+
+		input := execute.PipelineInput{
+			Step:   "fetch",
+			Target: execute.InputParameter,
+			Name:   "data",
+		}
+
+		node := mocks.BaselineNode(t)
+		node.ExecutePipelineFunc = func(_ context.Context, pipeline execute.Pipeline, subgroup string) (bls.PipelineRecord, error) {
+			require.Equal(t, topic, subgroup)
+			require.Len(t, pipeline.Steps, 2)
+			require.Equal(t, "fetch", pipeline.Steps[0].ID)
+			require.Equal(t, []execute.PipelineInput{input}, pipeline.Steps[1].Inputs)
+
+			record := bls.PipelineRecord{
+				PipelineID: mocks.GenericUUID.String(),
+				Code:       codes.Error,
+				Steps: map[string]bls.ExecutionRecord{
+					"fetch": mocks.GenericExecutionRecord,
+				},
+				Skipped: []string{"transform"},
+			}
+
+			return record, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.PipelineRequest{
+			Steps: []api.PipelineStep{
+				step("fetch"),
+				step("transform", input),
+			},
+			Topic: topic,
+		}
+
+		rec, ctx, err := setupRecorder(pipelineEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecutePipeline(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var res api.PipelineResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, mocks.GenericUUID.String(), res.PipelineId)
+		require.Equal(t, codes.Error.String(), res.Code)
+		require.Equal(t, []string{"transform"}, res.Skipped)
+		require.Len(t, res.Steps, 1)
+		require.Equal(t, mocks.GenericExecutionRecord.RequestID, res.Steps["fetch"].RequestId)
+	})
+	t.Run("empty pipeline", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		_, ctx, err := setupRecorder(pipelineEndpoint, api.PipelineRequest{})
+		require.NoError(t, err)
+
+		err = srv.ExecutePipeline(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+	t.Run("pipeline with a cycle", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		req := api.PipelineRequest{
+			Steps: []api.PipelineStep{
+				step("first", execute.PipelineInput{Step: "second", Target: execute.InputStdin}),
+				step("second", execute.PipelineInput{Step: "first", Target: execute.InputStdin}),
+			},
+		}
+
+		_, ctx, err := setupRecorder(pipelineEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecutePipeline(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+	t.Run("node error", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecutePipelineFunc = func(context.Context, execute.Pipeline, string) (bls.PipelineRecord, error) {
+			return bls.PipelineRecord{}, mocks.GenericError
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.PipelineRequest{
+			Steps: []api.PipelineStep{
+				step("fetch"),
+			},
+		}
+
+		_, ctx, err := setupRecorder(pipelineEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecutePipeline(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusInternalServerError, echoErr.Code)
+	})
+}
+
+func TestAPI_PipelineResult(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		req := api.PipelineResultRequest{
+			Id: mocks.GenericPipelineRecord.PipelineID,
+		}
+
+		rec, ctx, err := setupRecorder(pipelineResultEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.PipelineResult(ctx)
+		require.NoError(t, err)
+
+		var res api.PipelineResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		record := mocks.GenericPipelineRecord
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		require.Equal(t, record.PipelineID, res.PipelineId)
+		require.Equal(t, record.Code.String(), res.Code)
+		require.Len(t, res.Steps, len(record.Steps))
+
+		for id, step := range record.Steps {
+			require.Equal(t, step.RequestID, res.Steps[id].RequestId)
+		}
+	})
+	t.Run("missing pipeline ID", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		_, ctx, err := setupRecorder(pipelineResultEndpoint, api.PipelineResultRequest{})
+		require.NoError(t, err)
+
+		err = srv.PipelineResult(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+	t.Run("pipeline not found", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.PipelineResultFunc = func(context.Context, string) (bls.PipelineRecord, error) {
+			return bls.PipelineRecord{}, bls.ErrNotFound
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		rec, ctx, err := setupRecorder(pipelineResultEndpoint, api.PipelineResultRequest{Id: "dummy-pipeline-id"})
+		require.NoError(t, err)
+
+		err = srv.PipelineResult(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+	})
+	t.Run("node error", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.PipelineResultFunc = func(context.Context, string) (bls.PipelineRecord, error) {
+			return bls.PipelineRecord{}, mocks.GenericError
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		_, ctx, err := setupRecorder(pipelineResultEndpoint, api.PipelineResultRequest{Id: "dummy-pipeline-id"})
+		require.NoError(t, err)
+
+		err = srv.PipelineResult(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusInternalServerError, echoErr.Code)
+	})
+}
@@ -0,0 +1,23 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// PeerReputation implements the REST API endpoint for retrieving reputation of worker nodes.
+func (a *API) PeerReputation(ctx echo.Context) error {
+
+	peers, err := a.Node.PeerReputations(ctx.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve peer reputation: %w", err))
+	}
+
+	res := PeerReputationList{
+		Peers: peers,
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
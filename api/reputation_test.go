@@ -0,0 +1,61 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/api"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestAPI_PeerReputation(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		rec, ctx, err := setupRecorder(peerReputationEndpoint, nil)
+		require.NoError(t, err)
+
+		err = srv.PeerReputation(ctx)
+		require.NoError(t, err)
+
+		var res api.PeerReputationList
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		require.Len(t, res.Peers, 1)
+
+		reputation := mocks.GenericPeerReputation
+		require.Equal(t, reputation.Peer, res.Peers[0].Peer)
+		require.Equal(t, reputation.Score, res.Peers[0].Score)
+		require.Equal(t, reputation.Timeouts, res.Peers[0].Timeouts)
+	})
+	t.Run("node fails to retrieve reputation", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.PeerReputationsFunc = func(context.Context) ([]bls.PeerReputation, error) {
+			return nil, mocks.GenericError
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		_, ctx, err := setupRecorder(peerReputationEndpoint, nil)
+		require.NoError(t, err)
+
+		err = srv.PeerReputation(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusInternalServerError, echoErr.Code)
+	})
+}
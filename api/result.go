@@ -6,6 +6,9 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+
+	"github.com/blessnetwork/b7s/consensus/pbft"
+	"github.com/blessnetwork/b7s/models/bls"
 )
 
 func (r FunctionResultRequest) Valid() error {
@@ -33,11 +36,30 @@ func (a *API) ExecutionResult(ctx echo.Context) error {
 	}
 
 	// Lookup execution result.
-	result, ok := a.Node.ExecutionResult(request.Id)
-	if !ok {
+	record, err := a.Node.ExecutionResult(ctx.Request().Context(), request.Id)
+	if err != nil {
+		if errors.Is(err, bls.ErrNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve execution result: %w", err))
+	}
+
+	if !ownedByClient(ctx, record.Client) {
 		return ctx.NoContent(http.StatusNotFound)
 	}
 
+	res := FunctionResultResponse{
+		Code:        string(record.Code),
+		RequestId:   record.RequestID,
+		Results:     record.Aggregated,
+		Aggregation: record.Aggregation,
+		Cluster:     record.Cluster,
+		Message:     record.Message,
+		Receipts:    record.Results.Receipts(),
+		Certificate: pbft.NewResultCertificate(record.RequestID, record.Cluster.Peers, record.Results),
+	}
+
 	// Send the response back.
-	return ctx.JSON(http.StatusOK, result)
+	return ctx.JSON(http.StatusOK, res)
 }
@@ -1,6 +1,7 @@
 package api_test
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"testing"
@@ -9,7 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/blessnetwork/b7s/api"
-	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/models/bls"
 	"github.com/blessnetwork/b7s/testing/mocks"
 )
 
@@ -29,17 +30,25 @@ func TestAPI_ExecutionResult(t *testing.T) {
 		err = srv.ExecutionResult(ctx)
 		require.NoError(t, err)
 
-		var res execute.ResultMap
+		var res api.FunctionResultResponse
 		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
 
+		record := mocks.GenericExecutionRecord
+
 		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
-		require.Equal(t, mocks.GenericExecutionResultMap, res)
+		require.Equal(t, record.Code.String(), res.Code)
+		require.Equal(t, record.RequestID, res.RequestId)
+		require.Equal(t, record.Cluster, res.Cluster)
+
+		require.Len(t, res.Results, 1)
+		require.Equal(t, record.Aggregated[0].Result, res.Results[0].Result)
+		require.Equal(t, record.Aggregated[0].Peers, res.Results[0].Peers)
 	})
 	t.Run("response not found", func(t *testing.T) {
 
 		node := mocks.BaselineNode(t)
-		node.ExecutionResultFunc = func(id string) (execute.ResultMap, bool) {
-			return execute.ResultMap{}, false
+		node.ExecutionResultFunc = func(context.Context, string) (bls.ExecutionRecord, error) {
+			return bls.ExecutionRecord{}, bls.ErrNotFound
 		}
 
 		srv := api.New(mocks.NoopLogger, node)
@@ -56,6 +65,30 @@ func TestAPI_ExecutionResult(t *testing.T) {
 
 		require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
 	})
+	t.Run("node error", func(t *testing.T) {
+
+		node := mocks.BaselineNode(t)
+		node.ExecutionResultFunc = func(context.Context, string) (bls.ExecutionRecord, error) {
+			return bls.ExecutionRecord{}, mocks.GenericError
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.FunctionResultRequest{
+			Id: "dummy-request-id",
+		}
+
+		_, ctx, err := setupRecorder(resultEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecutionResult(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusInternalServerError, echoErr.Code)
+	})
 }
 
 func TestAPI_ExecutionResult_HandlesErrors(t *testing.T) {
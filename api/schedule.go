@@ -0,0 +1,158 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/node/head/cron"
+)
+
+func (r ScheduleCreateRequest) Valid() error {
+
+	spec, err := cron.Parse(r.Cron)
+	if err != nil {
+		return fmt.Errorf("invalid cron expression: %w", err)
+	}
+
+	if spec.Next(time.Now()).IsZero() {
+		return fmt.Errorf("cron expression never fires: %v", r.Cron)
+	}
+
+	return nil
+}
+
+func (r SchedulePauseRequest) Valid() error {
+
+	if r.Id == "" {
+		return errors.New("schedule ID is required")
+	}
+
+	return nil
+}
+
+func (r ScheduleDeleteRequest) Valid() error {
+
+	if r.Id == "" {
+		return errors.New("schedule ID is required")
+	}
+
+	return nil
+}
+
+// CreateSchedule implements the REST API endpoint for creating a recurring execution.
+func (a *API) CreateSchedule(ctx echo.Context) error {
+
+	// Unpack the API request.
+	var req ScheduleCreateRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = req.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+	}
+
+	exr := execute.Request{
+		Config:     req.Config,
+		FunctionID: req.FunctionId,
+		Method:     req.Method,
+		Parameters: req.Parameters,
+		Client:     clientID(ctx),
+	}
+
+	err = exr.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+	}
+
+	err = req.Callback.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid callback: %w", err))
+	}
+
+	schedule, err := a.Node.CreateSchedule(ctx.Request().Context(), req.Cron, exr, req.Topic, req.Callback)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not create schedule: %w", err))
+	}
+
+	return ctx.JSON(http.StatusOK, schedule)
+}
+
+// ListSchedules implements the REST API endpoint for listing recurring executions.
+func (a *API) ListSchedules(ctx echo.Context) error {
+
+	schedules, err := a.Node.Schedules(ctx.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve schedules: %w", err))
+	}
+
+	res := ScheduleList{
+		Schedules: schedules,
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// PauseSchedule implements the REST API endpoint for pausing and resuming a recurring execution.
+func (a *API) PauseSchedule(ctx echo.Context) error {
+
+	var req SchedulePauseRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = req.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, errors.New("missing schedule ID"))
+	}
+
+	schedule, err := a.Node.PauseSchedule(ctx.Request().Context(), req.Id, req.Paused)
+	if err != nil {
+		if errors.Is(err, bls.ErrNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not update schedule: %w", err))
+	}
+
+	return ctx.JSON(http.StatusOK, schedule)
+}
+
+// DeleteSchedule implements the REST API endpoint for deleting a recurring execution.
+func (a *API) DeleteSchedule(ctx echo.Context) error {
+
+	var req ScheduleDeleteRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = req.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, errors.New("missing schedule ID"))
+	}
+
+	err = a.Node.DeleteSchedule(ctx.Request().Context(), req.Id)
+	if err != nil {
+		if errors.Is(err, bls.ErrNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not delete schedule: %w", err))
+	}
+
+	res := ScheduleDeleteResponse{
+		Id: req.Id,
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,234 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/api"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestAPI_CreateSchedule(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		const (
+			cron  = "@hourly"
+			topic = "dummy-topic"
+		)
+
+		node := mocks.BaselineNode(t)
+		node.CreateScheduleFunc = func(_ context.Context, expr string, req execute.Request, subgroup string, _ bls.Webhook) (bls.Schedule, error) {
+			require.Equal(t, cron, expr)
+			require.Equal(t, topic, subgroup)
+			require.Equal(t, mocks.GenericExecutionRequest.FunctionID, req.FunctionID)
+
+			return mocks.GenericSchedule, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.ScheduleCreateRequest{
+			Cron:       cron,
+			FunctionId: mocks.GenericExecutionRequest.FunctionID,
+			Method:     mocks.GenericExecutionRequest.Method,
+			Topic:      topic,
+		}
+
+		rec, ctx, err := setupRecorder(scheduleEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.CreateSchedule(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var res api.Schedule
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, mocks.GenericSchedule, res)
+	})
+	t.Run("invalid cron expression", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		req := api.ScheduleCreateRequest{
+			Cron:       "every five minutes",
+			FunctionId: mocks.GenericExecutionRequest.FunctionID,
+			Method:     mocks.GenericExecutionRequest.Method,
+		}
+
+		_, ctx, err := setupRecorder(scheduleEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.CreateSchedule(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+	t.Run("invalid execution request", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		req := api.ScheduleCreateRequest{
+			Cron:       "@daily",
+			FunctionId: mocks.GenericExecutionRequest.FunctionID,
+		}
+
+		_, ctx, err := setupRecorder(scheduleEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.CreateSchedule(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+}
+
+func TestAPI_ListSchedules(t *testing.T) {
+
+	srv := setupAPI(t)
+
+	rec, ctx, err := setupRecorder(scheduleEndpoint, nil, func(req *http.Request) {
+		req.Method = http.MethodGet
+	})
+	require.NoError(t, err)
+
+	err = srv.ListSchedules(ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+	var res api.ScheduleList
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, []bls.Schedule{mocks.GenericSchedule}, res.Schedules)
+}
+
+func TestAPI_PauseSchedule(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		req := api.SchedulePauseRequest{
+			Id:     mocks.GenericSchedule.ID,
+			Paused: true,
+		}
+
+		rec, ctx, err := setupRecorder(pauseEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.PauseSchedule(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var res api.Schedule
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.True(t, res.Paused)
+	})
+	t.Run("schedule not found", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.PauseScheduleFunc = func(context.Context, string, bool) (bls.Schedule, error) {
+			return bls.Schedule{}, bls.ErrNotFound
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		rec, ctx, err := setupRecorder(pauseEndpoint, api.SchedulePauseRequest{Id: "dummy-schedule-id"})
+		require.NoError(t, err)
+
+		err = srv.PauseSchedule(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+	})
+	t.Run("missing schedule ID", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		_, ctx, err := setupRecorder(pauseEndpoint, api.SchedulePauseRequest{})
+		require.NoError(t, err)
+
+		err = srv.PauseSchedule(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+}
+
+func TestAPI_DeleteSchedule(t *testing.T) {
+
+	deleteMethod := func(req *http.Request) {
+		req.Method = http.MethodDelete
+	}
+
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		var deleted string
+
+		node := mocks.BaselineNode(t)
+		node.DeleteScheduleFunc = func(_ context.Context, id string) error {
+			deleted = id
+			return nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.ScheduleDeleteRequest{
+			Id: "dummy-schedule-id",
+		}
+
+		rec, ctx, err := setupRecorder(scheduleEndpoint, req, deleteMethod)
+		require.NoError(t, err)
+
+		err = srv.DeleteSchedule(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var res api.ScheduleDeleteResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, req.Id, res.Id)
+		require.Equal(t, req.Id, deleted)
+	})
+	t.Run("schedule not found", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.DeleteScheduleFunc = func(context.Context, string) error {
+			return bls.ErrNotFound
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		rec, ctx, err := setupRecorder(scheduleEndpoint, api.ScheduleDeleteRequest{Id: "dummy-schedule-id"}, deleteMethod)
+		require.NoError(t, err)
+
+		err = srv.DeleteSchedule(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+	})
+}
@@ -21,15 +21,66 @@ type ServerInterface interface {
 	// Execute a Bless Function
 	// (POST /api/v1/functions/execute)
 	ExecuteFunction(ctx echo.Context) error
+	// Execute a batch of Bless Functions
+	// (POST /api/v1/functions/execute/batch)
+	ExecuteFunctionBatch(ctx echo.Context) error
+	// Execute a pipeline of Bless Functions
+	// (POST /api/v1/functions/pipelines)
+	ExecutePipeline(ctx echo.Context) error
+	// Get the result of a pipeline execution
+	// (POST /api/v1/functions/pipelines/result)
+	PipelineResult(ctx echo.Context) error
 	// Install a Bless Function
 	// (POST /api/v1/functions/install)
 	InstallFunction(ctx echo.Context) error
+	// Get the status of a function installation
+	// (POST /api/v1/functions/install/status)
+	FunctionInstallStatus(ctx echo.Context) error
+	// Uninstall a Bless Function
+	// (POST /api/v1/functions/uninstall)
+	UninstallFunction(ctx echo.Context) error
+	// Get installed function inventory
+	// (POST /api/v1/functions/inventory)
+	FunctionInventory(ctx echo.Context) error
 	// Get the result of an Execution Request
 	// (POST /api/v1/functions/requests/result)
 	ExecutionResult(ctx echo.Context) error
+	// Stream progress events of an Execution Request
+	// (POST /api/v1/functions/requests/events)
+	ExecutionEvents(ctx echo.Context) error
+	// Get the status of an Execution Request
+	// (POST /api/v1/functions/requests/status)
+	ExecutionStatus(ctx echo.Context) error
+	// Cancel an Execution Request
+	// (DELETE /api/v1/functions/requests)
+	CancelExecution(ctx echo.Context) error
 	// Check Node health
 	// (GET /api/v1/health)
 	Health(ctx echo.Context) error
+	// List known nodes
+	// (GET /api/v1/peers)
+	ListPeers(ctx echo.Context) error
+	// Get reputation of worker nodes
+	// (GET /api/v1/peers/reputation)
+	PeerReputation(ctx echo.Context) error
+	// Delete a schedule
+	// (DELETE /api/v1/schedules)
+	DeleteSchedule(ctx echo.Context) error
+	// List schedules
+	// (GET /api/v1/schedules)
+	ListSchedules(ctx echo.Context) error
+	// Create a schedule
+	// (POST /api/v1/schedules)
+	CreateSchedule(ctx echo.Context) error
+	// Pause or resume a schedule
+	// (POST /api/v1/schedules/pause)
+	PauseSchedule(ctx echo.Context) error
+	// Get resource usage totals
+	// (POST /api/v1/usage)
+	UsageTotals(ctx echo.Context) error
+	// Export usage ledger entries
+	// (POST /api/v1/usage/export)
+	ExportUsage(ctx echo.Context) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -46,6 +97,33 @@ func (w *ServerInterfaceWrapper) ExecuteFunction(ctx echo.Context) error {
 	return err
 }
 
+// ExecuteFunctionBatch converts echo context to params.
+func (w *ServerInterfaceWrapper) ExecuteFunctionBatch(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExecuteFunctionBatch(ctx)
+	return err
+}
+
+// ExecutePipeline converts echo context to params.
+func (w *ServerInterfaceWrapper) ExecutePipeline(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExecutePipeline(ctx)
+	return err
+}
+
+// PipelineResult converts echo context to params.
+func (w *ServerInterfaceWrapper) PipelineResult(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PipelineResult(ctx)
+	return err
+}
+
 // InstallFunction converts echo context to params.
 func (w *ServerInterfaceWrapper) InstallFunction(ctx echo.Context) error {
 	var err error
@@ -55,6 +133,33 @@ func (w *ServerInterfaceWrapper) InstallFunction(ctx echo.Context) error {
 	return err
 }
 
+// FunctionInstallStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) FunctionInstallStatus(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.FunctionInstallStatus(ctx)
+	return err
+}
+
+// UninstallFunction converts echo context to params.
+func (w *ServerInterfaceWrapper) UninstallFunction(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UninstallFunction(ctx)
+	return err
+}
+
+// FunctionInventory converts echo context to params.
+func (w *ServerInterfaceWrapper) FunctionInventory(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.FunctionInventory(ctx)
+	return err
+}
+
 // ExecutionResult converts echo context to params.
 func (w *ServerInterfaceWrapper) ExecutionResult(ctx echo.Context) error {
 	var err error
@@ -64,6 +169,33 @@ func (w *ServerInterfaceWrapper) ExecutionResult(ctx echo.Context) error {
 	return err
 }
 
+// ExecutionEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) ExecutionEvents(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExecutionEvents(ctx)
+	return err
+}
+
+// ExecutionStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) ExecutionStatus(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExecutionStatus(ctx)
+	return err
+}
+
+// CancelExecution converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelExecution(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CancelExecution(ctx)
+	return err
+}
+
 // Health converts echo context to params.
 func (w *ServerInterfaceWrapper) Health(ctx echo.Context) error {
 	var err error
@@ -73,6 +205,78 @@ func (w *ServerInterfaceWrapper) Health(ctx echo.Context) error {
 	return err
 }
 
+// ListPeers converts echo context to params.
+func (w *ServerInterfaceWrapper) ListPeers(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListPeers(ctx)
+	return err
+}
+
+// PeerReputation converts echo context to params.
+func (w *ServerInterfaceWrapper) PeerReputation(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PeerReputation(ctx)
+	return err
+}
+
+// DeleteSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteSchedule(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteSchedule(ctx)
+	return err
+}
+
+// ListSchedules converts echo context to params.
+func (w *ServerInterfaceWrapper) ListSchedules(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListSchedules(ctx)
+	return err
+}
+
+// CreateSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateSchedule(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateSchedule(ctx)
+	return err
+}
+
+// PauseSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) PauseSchedule(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PauseSchedule(ctx)
+	return err
+}
+
+// UsageTotals converts echo context to params.
+func (w *ServerInterfaceWrapper) UsageTotals(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UsageTotals(ctx)
+	return err
+}
+
+// ExportUsage converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportUsage(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportUsage(ctx)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -102,56 +306,203 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	}
 
 	router.POST(baseURL+"/api/v1/functions/execute", wrapper.ExecuteFunction)
+	router.POST(baseURL+"/api/v1/functions/execute/batch", wrapper.ExecuteFunctionBatch)
+	router.POST(baseURL+"/api/v1/functions/pipelines", wrapper.ExecutePipeline)
+	router.POST(baseURL+"/api/v1/functions/pipelines/result", wrapper.PipelineResult)
 	router.POST(baseURL+"/api/v1/functions/install", wrapper.InstallFunction)
+	router.POST(baseURL+"/api/v1/functions/install/status", wrapper.FunctionInstallStatus)
+	router.POST(baseURL+"/api/v1/functions/uninstall", wrapper.UninstallFunction)
+	router.POST(baseURL+"/api/v1/functions/inventory", wrapper.FunctionInventory)
 	router.POST(baseURL+"/api/v1/functions/requests/result", wrapper.ExecutionResult)
+	router.POST(baseURL+"/api/v1/functions/requests/events", wrapper.ExecutionEvents)
+	router.POST(baseURL+"/api/v1/functions/requests/status", wrapper.ExecutionStatus)
+	router.DELETE(baseURL+"/api/v1/functions/requests", wrapper.CancelExecution)
 	router.GET(baseURL+"/api/v1/health", wrapper.Health)
+	router.GET(baseURL+"/api/v1/peers", wrapper.ListPeers)
+	router.GET(baseURL+"/api/v1/peers/reputation", wrapper.PeerReputation)
+	router.DELETE(baseURL+"/api/v1/schedules", wrapper.DeleteSchedule)
+	router.GET(baseURL+"/api/v1/schedules", wrapper.ListSchedules)
+	router.POST(baseURL+"/api/v1/schedules", wrapper.CreateSchedule)
+	router.POST(baseURL+"/api/v1/schedules/pause", wrapper.PauseSchedule)
+	router.POST(baseURL+"/api/v1/usage", wrapper.UsageTotals)
+	router.POST(baseURL+"/api/v1/usage/export", wrapper.ExportUsage)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xaW2/bOhL+K4R2H2U7cW44fkvTdBtsT5ttFi12DwKDkkYSa4pUScqJG/i/L3jRxZbs",
-	"2I5T9yzOU1OaIj/OfHPhDJ+8kGc5Z8CU9EZPngxTyLD58zJJBCRYQfQZZEGVHotAhoLkinDmjTw7jniM",
-	"MEPXjxAW+gf0Gb4XIJXne7ngOQhFwCwYC/0DC2ftld6VP+nFVEokEnZtnHGWIEwpYjwCiVSKFQKzFURI",
-	"pYBEtRs84iyn4I2O+ufnvqdmOXgjjxVZAMLzvcdewntuMKYcq/PT5mhPTkje4wYRpr2cE6ZAeCMlCpj7",
-	"Xg4gZBv4BxLkwxzdvJUWOaCPNc6Eq+ZhmhD/8I6Hb0/+yfnXz/nJ5ZfJxXcVDi+n54/ke3L5Ax//lxcT",
-	"+S/8n/BuGE4//nY6eX93xbHn7/JZ4N37HlGQGfxOAlIJwhJvXskJC4FnWwhEVKT4u4DYG3l/G9RUGjge",
-	"DSpWOA7N6w158A1CtaQYXJKu/7mUWQ2IZDkXZsscq9QbeQlRaRH0Q54NAgpSMlAPXEwGwYUcaL4MquX0",
-	"QTc92TLxO9UuDe8LRr4X4PRbUaDLFCr5r5NWy+TWqKdDWPIg0lJKkKBQcKkUSMW7rESLgQhAMoeQxCRE",
-	"uJyLsERTXoSptq5lhwE4TDtN7nZ4i24BRGl3eiLKMIuw4mJWrd4U++Esb18GxxmMebyRPGrxPqQgAD1Y",
-	"N6lVgBWigDV7Gfw/OaRn/IoLGf0Otu5sMxmPgMqBW3obm6kcxBVnMUnaOrXjhcDGi9h1JIq5WOlfFi0H",
-	"l8d81uXocHVZz577XsiZBCYLOcY04YKoNGsD/JqSMEXVVFRNRTLlBY1QABpuBpFDTaQL2/r7Bu+8PIg1",
-	"/kUWbC5KYNPxFHc5nWs2JYKzDJhCUywI1gqsZPhGaxO9K1joEG3koD/iDKIvmBbwAkO2GcmYx2OT07SR",
-	"fzQTtCE3kh4nV8e27jNUUj2uwOmtk8X859lMR2RESs24NrTb+sc2HTv9rZcqlcvRYIBz0nej2p48f8+p",
-	"yLgMXwbpej3aeHnZ+EAvUzBFMnj2WzvNme7c96SKCGuL6k7pgCQidMPyQq0mXi2pTb/Y1VZUKkCmnEYd",
-	"euXCuhqbwbR5J0DmnEXogagU4TI9V9z4ABLBso0jWYQhSBkXtJuY7bz8OfQkA150XEPe8wdE9TXBQdUH",
-	"qHEoPAFvZ3PYMKw4MhwylNxigTMwPz0tRYOpcVitULuFDFz2EOk8wa52v5lgalSHlE0ZJluSCav4u9El",
-	"prb52FnjmHQY09XN29KQ4i47D3A8C4Dg4en0NPyBpyr/Nh2G/OTb2Sk/xWc/VFR8D/PZjDAQ3xIWPl7I",
-	"oRwO5QXgF1h/BirlHWh1TCvhfr28+x3FhIK27FLiTegpUMp7D1zQqP+AZfYCPHlJjY4wc/XhBmGRFDp4",
-	"yw1c5x8Vyb1eL6SkF1OcHHtzvx43/y4O1VOH7alDb36/YVbQYYO7BzPFcxK2JXLDjAhkCAwLwsvEnosJ",
-	"CCOhDMkiSAQvcumjGS9QiLXzEwkohOubVzkJBTM3OCMsQURJRCJgisTEGGut8p1VvOQ3miZTsXGdG9nc",
-	"unVoktBh3rSQziM+lwNfuakmAY6gM5yrorrid+eyw6OjFxmolDjp2PpmRVkBxZhQiHwbet3nKCNJqlCK",
-	"p4AyLgARFnOEA14oi1wIczPeFaWrt3U6vtrvdV1RGg7wPA6C8Ax6x9Hxee8U8G+94Ozsond2HJ/icxyc",
-	"nZ+FL4JYlW22qbbI9dWpLejYWS29DFWBKeKFygvVJpKPKJkAqnLAT2aeXw9ca8X56PqRKHTFI0Cgwn6/",
-	"XTR5JGrcTWHzqf6pi8W7CluqCIRYk/4a3HvesTMPXBLd/rbcMAl0FwO7+yHynTIy3jCpMKWrs54/T9Ky",
-	"OhriP1Us9L1CkMV78b7iqtbmC8JoizQrg6nzKfsJd/OXI7aOtsHyRY78A5RrEK3rUvm1qiPNg0ZLCd28",
-	"bXnXXzbqLZFiP5woJfxXfvVXfnXo/Oo9YKpSy5KOS6zOaqT90f9VHVejetzODtEEZj1z80Q5JqJ1Coaz",
-	"pVOYkd21WFWF6hXt0J5ckIO3Vbnomk2/4IPUipb6IG39VL/ZsmjDSbEEWfrZ8qP2DF0NGdD01PffWkgt",
-	"+5aIKMQg1K5GzOqdzPp1UwdhAa5tavsrwUwHt0bfs9JpjKmESvgB5xQw24IluNnVXWvt7c5aSTIrAUo/",
-	"xaZS87xgW+Kc+0+bIt68gXO/ddNQHoqZV3WgXU6Edbpr24R1DHJxeamD1Hg2Y96kdLcOM9zVzHBN5joA",
-	"3QqSaYrq9asCXZkPNGPRjm3lnauKK9/pWPxL73QIs9KokR/6xcBOn4X7e2iwacejEthPN4h2866VmALD",
-	"AW22PLZ3fIvl6W2bwk+vXGpoieAQelhohG75hsHW8t0SLScUQVAkY51Zv0iHkSBTEHIsOFdjK4unF7w0",
-	"UGK21NreXzckLoA20G3ftqc8SWyE2P1+lHHR8TjzdzOOKMmI6n7NsTNoUbBx2XP/5Zq6bz7cLVL8p9uY",
-	"RgqPCgTD9C0PO+LaO8IipMO+uYraDODuASdWHIWg7gHGaDCQdrhPuAZQ2tbicv/WmiUSvbm4Q+8BRzYT",
-	"uwMxBYECLCFC3DaiPuXALm9v0En/qCqoGUvva70QZexDL2NW+Kxv03p6r/mhvh2AkHbro/5p/zeNjOfA",
-	"cE68kXfSP+qfaN+AVWrOPsA5GUyPB2WBshap1gPvqgJdu/cyuN091A7HQL6J6omN312G9IZHM9crVsDM",
-	"FjjPqTvu4Ju0AchGgy3ew9r0y+h4Y8j11aG+ipvajBGPvta+AlBX/elAele98Wi4g7nvnVogy6nqFFMS",
-	"Nay4TEHnvnfW/YWlPpKWgLa0omHIItPZ53phKZzIZuNReibjb5OI2NLnahK52ujzJHITX5lEK+r8HQpa",
-	"A/znUWlVhXk1XtykB8LhhPEHClEC0ZL215xvY+27bfQfZeOumwWbFZRXOJa6Mfi6nFgsis8XizI/S9NL",
-	"deOVPs5q2UhTgBIEplrDz/iPfXiNjVX5DItSU5TUGBLoYMxVCuHERkA3c5kc78vhV9PJQt20QxMGHZEO",
-	"4GxJUF0nKGXiBu7Nom6wFX6nIGYqJSxxycminUpvqwRnIaWRo4HNtPplqhXxUA7cfzQ9bMm0obu5v7z8",
-	"FxAkdlUMex6EWYTwFBOKA0KJ0jdnt5A78Px+/r8AAAD//848wzE3NQAA",
+	"H4sIAAAAAAAC/+09a3PbRpJ/Bae7D8kWSdmybG9cm62zZWft2yT2WXa8u3c+aQgMSUQgwGAASdwt//fr",
+	"7nkCGIDgS3QS3145IjCYR09Pv6f7X0dhNl9kKU8LcfTkX0cinPE5oz+fTqc5n7KCR2+5KJMCn0VchHm8",
+	"KOIsPXpyJJ8H2SRgafDiloclvgje8l9KLoqjwdEizxY8L2JOHU5yfJGGy2ZP3+lX2Fkxi0WQy77ZPEun",
+	"AUuSIM3gE3jHioDTUDyCXzzIzWj8ls0XCT96cm/06NHgqFgu4O+jtJyPeQ6vb4fTbKgeTpKMFY9O3adD",
+	"cRUvhhnNiCXDRRanBXz3pMhL/gmWwnkumhP/Ph4vThbBq+dCzpwHP9p5TrPCXYw7xf85un/y/MFfs+zD",
+	"28WDpz9dPf6lCE+eXj+6jX+ZPv0nu/+PrLwS/83+Hp6fhNc/fnN69fL8LGPQwwafjY8+Do7igs9p/goC",
+	"osjjdHr0ycCJ5TlbrgGQ3CDFf+R8Ah38+7FFpWOFR8cGKxQOfbIDZuOfeVjUNoZppBu91TCzE4qh+5yG",
+	"XLBiBq2ncTErxyMY93iccCFSXtxk+dXx+LE4Rnw5Nt3hQvuurI743m0XhPdlGgP2qf01KOA7Cgb+XdBq",
+	"HLmO7fEASxwSWgSYOpxelwUMxzVgmG0bAALCSNOlPsBwnCdZ3gq/Kilh0A+fA+yaQ77heQgv2JRGlVTj",
+	"ZpYJHlyzBDoL6NvgBoAxCGDAG57zoBRITbIA9wQoy6AyW3hFn7rn99Gj0aPHu6YxPM+zvLmiVxNF6CSS",
+	"hVmZRLCwIhi7cxxIUjOHjcW1zxj8ncHS4nSSBWyclQX1IscY1MjA1nTwJwlbgHicRvF1HJVMkex1cR/6",
+	"ewNjUIdbkCf5WX2aT30IiJvv7u3RnP2c5XGx3AJMEmFax/eh1NHpycYD9qaq7mndGbGoUgG7e34sCVgg",
+	"YG0JJ/wAdE5hrWPJz7M6mWic/JYz8mG2dA6Jy4ur50Xt9abbGqdhUkbQhWcCHEaU9OtaLhPpjHAIDZzI",
+	"vnRGzW+cZQln6ZrHs0NKcQFTwb0NpZEdHw+JH/wWTmZIDCHP5i3beucnx6L1IZhsoc4I/AH8MPORYOSV",
+	"MRB8seBhPInDgOm2AWDedVYCoc1F80CxcObFmDcnbwJctBZusWEwZ2nEoM+l6d2l74cTb3cl1QJTusgm",
+	"veBhwXszQxniRuoiuAUg+8O5RREx5b8lqX/FYVF62ciDrRufmTmcmUQcq643OjNvch7FIZ64xraeZSCt",
+	"kDRg6TZureQcTPcQiBkxEQE8SUyWI1fJizLiLTN2zSU1Nx+l/BqwRX0TxMWocfZSNvdM6kfmSMy6twq9",
+	"my7KizkH8W55MR1vQYazRXP0l9lNbR0SLLEgrsUAmE/+Nw2CPwSX3357OQgu/+3by2BoGhGrg7YAk0EA",
+	"xwHEQOB3l/T6Un33Z/ruT5XvAOZSig6APMJeISdlKXamO6GeET08b6r9x6ntec4KoHsCTyLCVDYUMP7N",
+	"LAZ6FkJPIBZMk2wcLPBE56kIkviKB5e8HP5B9yj4HPayNl94yNICCC28EohDyOpjiUo5S6coe+uZASxG",
+	"0xEu/f7oZHQv+BP8M7p3iau4/D96pIcC5sBvm/OXXHBaJgzWfbsAhkhD2hH+F8UEDjBEMvPtt/Dj3/Cf",
+	"P+M/f8J/YmwgF3KE6jsMgyTCYhU13QtHr+KTg0mweVUx5NG2U2jXTVgY8gVKFeOlRJGFpguiSqNx4+FJ",
+	"KYZIw4f390NIFa+IcESiA3QcP65JYS1tOwCFPcsQZCTzZ0nss+vJ50HE4bN5nALIkFnKIzLjLJIqALCI",
+	"BXBRBpQYGqAVgBsLgNJ6R8R6NcmNpTwP70CLpA0tsFfAKHiGS4ddBpknQbmtQXRBH/DZDX6U1AdohFa0",
+	"9Q6p/oPLSZyL4gIwB4Qg/YvOJ0z74goQClcbV9HpxGwowm5atRJspMN+IABmBLUK0NS8NXXGVxcsSZCW",
+	"4N/UxlhSiZpqg4sFdxHPOZoKvor4hEFvX6vOihn0PsuSyPQGQIyTgKdZOZ0pM0vOizIHmhRxJItAmjUk",
+	"cbaKDeJwpjPVuYGrM1FsR8+DS9qwy0CUcICFmJSm38rn7kZ09mOn1+zRIaEafEiQ9HzhbzVX85cdtkpM",
+	"G2/3pKBoitA4iwegB8Zu9wyX/QpIZhN7G7Y9ZGEsGOMXnqOaTuJpbxvzmWwOM5mUaYhPLmKPqn5m9WDd",
+	"rsKExmyyHPOYnZxen4b/ZNfF4ufrkzB78PPD0+yUPfxnEZW/hIvlMk55/vM0DW8fixNxciIe821U4jkv",
+	"ZlnULQ1+eHr+A2BzwvFE6Q1ypz7jSZINYSuTaHTDxHwbQx/LYeTCa+07+/4VENtpiRZYYY7ZMxLOvrMg",
+	"7WX3M7v3Rg+4K67qIoGBbxeDXQu9tWm6ARx6izvWQPWm8o1AThKexMJzVH5gt/G8nGu5mDiT7Md6wJi0",
+	"6gpEEqTdo+CMpcgb+W3IlYcsiedADAVHdbTKeV3cuX9vC06lJ9bjvItAicj6yK+HJZa0bGEbzhZx6LGz",
+	"y3mJkKcsjzOt1gNdRMkDsHwOHGM8zbNyAexzmZWkPRRwEAC0zNpddCOUG+TDJbLoGJdOvGcSE3Dtsd2G",
+	"ObgYb7Zhh1iOIpbgbc7fdp9XY5+rmJ+3udasU5kMTo1+B7rfLI/kqXC8wFsil1ntPgwkfeF+BhRhzMIr",
+	"j+THx7Msu6qJbMo2CloV8O0YtDtpYs7SkNdaYhNpkaruheAhiG4e752aayAbWOs1aejo9H/5w9Oz4fnL",
+	"pycPH+mdWLBlkrFoAB+lhd6Ovw2fPT4fYmsiPnwbD1SZJ82pvn/7fStY3rw+f0cTHwU/lILUhiy5Jh7K",
+	"gkU5TtBWGkWoUg9ArFUmBldBQWCh1RPF5+xGvlUiU5X9FsVCPDk+Vk9IvrqRm7arI46rX6EkjhMx+mBG",
+	"3U4KhL42kgDPjOTWMLjB8zKXLjjZj1jH8asV35WHGk10T21rmFxoRORV3zaEafoaCEMqSgFKwRTdg7O5",
+	"Tzcjo5JuGpim2oI45sRIjLM7FhZlK6i0GE+KLQ4JT68vrplPdnuRXsd5lqLoFkCLmOHWbynDoYwabeux",
+	"lWLORTa5kH7jDg3diQVScFUCkX8NVsbZQsRBz9qF4AkP/aEO5647magkHECh5Qc1ZRnOVFA4Ak3fmCtI",
+	"gc9AOw+lzqnVUNIjhxhEgUY7lkYZCvXkXRginSV3JhAljr9hAimpftBtWUgHakUrrX23eRBAPo/JACm8",
+	"ARj6ZfNge71Fhm6yRTxyaOfRYMfRShesGqzShdNSDnH95dRNkS9XfwmNLOHI0VIi7fydX8lmVocVRRR7",
+	"8QxdcHkUvEphj9sProVu3y82xQZrHvHY/3JJ6KVs0Dy32oSHpnPgxoppk4c8FXHE6zTSsdlUDvboXkcw",
+	"zqr5S5uX3xWS4IFVk62ZytiV46pfl6D0NusQOhzSmPPi2h9nlWdTFJkCju97B4GucQJrZy/ETibGkbf6",
+	"7J45HxADj7j3OBWl0WLM7g5IX7YGRJSCaaGVk3Vy795WFh8K1WoN+GqayiYsThqRXvN4OlP+x30FfLX6",
+	"w60lDZvJ8Qgdco7MSDT8O3cbZtIetCsnL9zZi7bp7yF0NuQwmU5riW4TiHiaWh+EK00MpAosXzov5F68",
+	"er6B+ktjbrUyQlSv2dXiio9KOObXR5PxOHzIh/ej+4+Gp5x9Mxw/fPh4+PD+5JQ9YuOHjx6GW+BE3hLM",
+	"/qKuORrRTIGeDsLm+r1j8FgnBJg0F+RQogAANaf9LrbmYU2gUMNgsMSjCPoZkvCxBXf3OqHewdP6sFpk",
+	"RTH2AsXYC5gzQvBISc8aovA7TEATh0dSGYIHiL8XhM8XQnanRDY6CNfUhowXH/fkSEGtucbzDqU8W0s4",
+	"Dldhn8bNvhNjguytp8/ZzuqQ0oimUV5GTiHhDsVkFbJIcmfsvz5CLl0ZEgKME8VkaAigj8qQR6PgHLoE",
+	"OSHHaNJrzSpClmYpORJB68oitPEaa6ScZOMGzPpuqQbPiVGIv5gx4Ynae8lvhzQXWNz5y6dDNMthS91v",
+	"+4wdIyH2L3wjt3motAoRyAYSzhjtqgHt60xCeY/rkAP4ht6cSTW6EhovutdgmtUQZBDMQRP38Hav5tvO",
+	"BD7omAoX3UEHLw2Sa3zswRrQxOqXS4EjZWUeYhS1ul5RAXmDOX6SZN0nP6pASt1H59J7qkpvzSIPSZ2M",
+	"U7Cm9ohlGvogShETLiECoMRz4IkxbFCyJLqUl2njkCovR3iFrqa0cn9hwhLBt4ghDx0HRD+/u/5Amkm/",
+	"uOx/vS57GwZnZI2j4TBM4uEkYdP7sFH2Of23+sg2PWk2hUcf7z4oYHMi3c5lPE5JIuQlXmyhFld8aUJ4",
+	"SwxOK8gOEQUAH/gxCkDHF7yQF0DsyKDWg3iBDmJ0FUxQnXdpQ9zBU7+4v/cX8OF6wT8bc5bUoPq4wc5U",
+	"03WNYL87W9cXw8zBDTObGEg+7eaIe81CT8MCLxlIbcJnJ6bLAsbF8praDeyDF4iSA4As6JNniAO8CEfN",
+	"oGQO7y/8h5M+DSkMoVXgXhvYogAEzTu8SzTvHY/odbLUQLe7IfuqDdLv9lqri3euPGjp64ylIU9awwrl",
+	"a6+TZWAZq6FHVp1o4NpnSwNq7DzeinnXwdoWxybfA6vp4bz67Knop+3hRaZP0YqG50XO2RxNY473T7S5",
+	"/75gZgekX6WiAM0ZmDuICx1mtVi2Y5LfMPJIk7+clBRUoNGmQ/EWAClXEGkG1G9jeOzcHHeSXuMhS+MJ",
+	"nh1v+N5TGX5Xn0agv/La3pRzwe8SceZkMA5tkhTvJ2akQPUzhrWoS+/w8ephpAux0alSq1oC5Dr2s5fg",
+	"+IG+UfglhfuG7NjHH+LH1J24RXznoMV2Fv56LEKrQ0mKzN5NqoSGyZgX3PvGeaKQZwHAT6PK3bIH24Ts",
+	"t1sB2K/KBoABwXE1nGyLvnBvuiIPq1vmBDK17V3VjCOFELTjCLICPcO7kXTRztvAtQTvJo6xxsjCHXOy",
+	"NjOJ1mx2ZU5QEF5FB/30y35/0Z+nDUxcJ8YAxiD2EDYLSVw9gshECSLhyYPhHyePoiH7I3s0idhD/nB8",
+	"ekgBr8IWWonuWtz+7ha/DykMJdgs99xe/tA47CbLw6QmnMkgh18r20qYwEALideeWf+Q0bUJzKtGt8xA",
+	"1l96XHc6v1F1cTuNRmmJ6Fp3p3Ye0qVSQIgOMV43sRPR9/HaRLxdTa5V2Ozia90A3E/Ua1Xm1OfyjsNw",
+	"GhN4y/WY/p2t7WinAqaBKXySl+7ENBoEczx5NyAzJUtnFNNAXm3vqxY0QbsHnGqBCl3mq7r511BlTKeH",
+	"uJnnQYgWpvlbkal3ACxpT281I/2FF7XEbl/MRxtC2Mrc691xHbRncw4WmLzMvbTjUumaz8S9NKvn1hAu",
+	"/bsvjN/xy+6vufsawm1m7fNO0H5O1zL278ceqOQHHReif2d3OmbMhzNv8LE3CqAe2e2L4FYf0ER0pLYF",
+	"q/v2i3v+i3t+PWr3Po1/Q0bi346UuAdborPVrdbETb1YO1BvduKf8a11Rbr/fcvr9al0pfLP+Ty71pGO",
+	"xjxAyZpZTcfzm4G9XJtCXIYB8N8grrkB0aNGgyKjPL13ig1i6WjDXEdG0cSXD/TXNn2HTEcqk3dIG26p",
+	"V+vq2g/tt2bkGXMuH1N2Ge35vguh4bn9pXE7k9uw8wudbbH4zbubL7/5C8+j8xd59v1J+svPi+jkL+WL",
+	"h9HN/Jcf48fly789/i5b3jyL/hq+Of3laI+Xtdow947NRS85S4qZcrI2LWwyv6VyEuzTKbIFDTCWJxMI",
+	"vjIYQRp1Vpz2/ZPpVYZrczGmQkrwK10JYKfW6dX5nHew5nKBU40uWNFnvRVfpF287GPX61cmbk8KXpUh",
+	"eVdQ6EEemkh9x4TBSYrTjHfBCwJDmeF5weK8NTO4pQ0qR/DW+Zltj7ViE9tJf2p6a90ofZFe/8QOcp20",
+	"lhyquT/mnXSGOAoxiD2SqEu/P+rovixVeFWP7iJYIDVYrkAhJuWYz4TlSztSNfm8oLzGsn6DzBOEN1tT",
+	"twDDzq5+Mbe8RKea10zxj6KFzajdnmpfVNOBVwqn1RLt964d1EyHvYUrz6QRB/LxekJXoVZjRwMn8PpT",
+	"zyH7J9f6uHYJBnGo43Vm7Xp1PVfyHOQH1qSlzEm17F7OVe1YOKX+qsct8dZ2aGb5cKs21FOI2ygdmyZO",
+	"JvReEtuUGBlXvcfbFfYId1m4ZM58yaIaaVre5PEcaQ3pRfoWoDbB/hoytcg9pPuvWEfAzPzQNWgGB8aA",
+	"vvmkDMAOQhS+z1jkD44cl6KeryFuqkwMJLlrfmE18q6YBr/eroMuqnfN14+fDNmChVgkrkfeZmcmemi8",
+	"1C0a6Zu3mtCivGi5uH/25n31zj7OYIAlEliwqNRLRJMIu2ZxgmkhA/jOyQSxdmK1Sc65rlNzNfYAil45",
+	"4zlbQzGvV8+2AYi9DmoCVzrLtjmFKI325InWwdmNgrc6OxD6HHTeRviRLEeb12/rodaYU7SrKGzMxIAs",
+	"udN2MQi05miyIsH2oFF5RuaPYCHJfVeyVhZJ+Y8lb6pBE3X61xC25KY0JUaJJyANV3Wjte0fHUE5Z4r1",
+	"TPxRLQ4h2XnUVrcv6LDmOWV5EZynHUYIQhgHQWw9P6KA0qprsjvt1BKRKEazyqVMRwlV2SzxJTzJEl4H",
+	"uHKFonX5yOQ3+bilN0i03C9x+AWGhJdjbDHWt0p2jnLwn5TSFHiM9LzQxnGajhagE6yETKRvQvTAHhRM",
+	"l+1qIRsrpX2tSrKWUDYpbkBh3qdpyZDMO7Yo4bhYedpPqkVwlWY3qS6i6hZ5qBWd0LJ2L93aLPYAzikc",
+	"+61NZexJn6PfybAlR3ocBWdY7gedpxEP2TLAvF0yeFlkOpZ5zEFVj6kIdDydyYLJzbvZUSxMvWnRXghB",
+	"6ZWybWRv6DWrCa8vSIGAVOa8JfBZhjO4oqVUaqWrS2bWTesxI2vP4VfhNhrYEBXhJedSTqvAyibg3gI6",
+	"Isxy3omg1EInbvrq3iC4/1FWCRwEM0A+TlUTx7yoKeL3Rt9sMSudWOaiHYM0+mo8idNrlsROOpxtoCKT",
+	"NfdGXJvcOVl6Mt1smtm57/B1oxCcnjit6WVrz6GXu8bma9+zj6Ynd3OI7gF4nB3dz+2qZL8z3n59Xucs",
+	"/RAcL17wJE45pWr3mRYWQkfOyqwZWSVrBl0XmJAiS1mMneBqzvIkxnq0BV9oGYFyPUrWiY83qE9rUo5R",
+	"PVZPaYmtMobwRRfHoZXcUC0FnbNTaLeuU4iPy0pAG8vnFGLlPbvKYGwHXzAhLwnqGoyUwx/rEZr9MiDX",
+	"K1AtDSCxtYWqkSKmoCWlpOiqDwDY2NQHc99XjuoiCws46eLo3XU1XlM32olzUCGXAmXffLOVo3AAg6We",
+	"QGsYu27Qs9gbQkH4wp/gsTlPqstRIB9j+kd4HucqL2swxwpGFA7IgF+BHEumkXAZYhWjac4Wvate6dnj",
+	"QF+KqfmRdqtKahZ/2oL0nZg6d/MrsddrRNC1dgPYZEudoo0SVXlU2mmNoP3kvKWwxpaRbXoqKwKbvQu/",
+	"s8hmfLvw2jwIOjKdMYJI1lVUHskxaJXAbBTrxGXgXf4FT8kwKKP692GjMVSkzZ66dqW7zrp+Zr00Lua5",
+	"RFjRacRlU7T8Zgfk005O1pqXzDyo5rtmZFqtfc/okKi804tGFfbQt4yvXv3vupJvN4LgscEygzGAjQQ1",
+	"ZRJwQGcXUOQsFcgst8qVsfCqwK9tSkNXN1CX+OkgoM1Zim5rSRRSYtsiluFLKeQdl0KmhLi7To/brJDW",
+	"uKkBwt84cetVrG/97wK+gZNhV849RkBdWZHvTqsY+sulYIRDbhnrHP6IAU11VUCqKzEfxyn5VakuKWaS",
+	"hgYUjKa1SUDusEClj9R8+GJuqtUMKGyJIWIHMtPmIJA5PkmF4CaH6Fcq39DXVkOFxvVO5VPV5GcBOAPc",
+	"MYnqzWQAK/FVeq/Nm/91/vpHpRbLu5eKrV6ivnZp1Vs1AOU4Si8H+BcjdVk+QZsCPqGgMDhhOegDKhHr",
+	"INA7AMIsVmJLrXORRpfzWT020ALMsXRhR/aOF7AJquNRLELQ4vXdF/r6EkZmMlxAAze4N7r/tUZJWZyR",
+	"toEMvaKQcBN69co/4N+DAYnqRUCVG+Wg8zi9MA4JZ3QTOkcvUQqNYQsdxZ/w58ikYh0c2Z0lgoAwpz/o",
+	"Py5k8Kl2Y1TMBE3nxp6ytDZozQEMAs37y76qeLTtGLuYZuV0hqZdUM2ZvIodvHn23TsT66jry9gYIHmY",
+	"R8Fr1NF0Bbpm1XeZZx778qQolcO1ltwQtgaJmpg6tJ5Yum6dZbelVHRXK2pd0ata1W+KTnHu9FZX0y/L",
+	"oy0L1ld/Uhf2VhQyr19ENlAnHKlggKbkzkpUa9/N5EaplfreXMf8xhNRidHZQIHgZaPCmcGdRtDVqmOK",
+	"1YpHzeOx8Rk1FZSPqQ7yJzp8tqqqp84oBerK07JIWIjnQxubvAG/xGRvjBcooC+127TiM9WnsVw4pXqd",
+	"iD5sjFHMZs5ND/Kc3V5gaP3ce3m9GS84yfCq4rBc2NAy4SxOlrnSV1QdcnyyRbSc0/XFuIy8Nu/mTD0T",
+	"kvoDC/NMCBlPqFY+Cv7B84w4LDkxcnUzM0jieVy5y/5g32VVXWQ6BCOp1Ppds0i61C1UF81gBT4upxex",
+	"CujbWOqO8hjDbi6AnRUXEhb/2qIYuaqavBftbFLyxJnd+pifZNOpjG3Y/ALv3JtAUAW4En77C75vflzL",
+	"9EKXlf7sqhY/+/68iuIHOGPneF2q9Eb1QV85aUoddvacr/baCzUE+ezVF7sOZAQy6gl8OssxpdrtAgPa",
+	"cVcj1GhALyC2Z4MKfFWD7Bn8w/FD0D/of/uzdulduEN7vroU3EcyTSp5rowtU6UW13KRs4jt5lSmnTEg",
+	"Nn04zcvZtN3eUua3fefioE6An+08nScjf73HsENChAY8pmki6Y02xhG+tgkrzdvcBk18MDCgOC6DDIcz",
+	"cR+gYt3vy3i6qU20xRt+rr3YbYcr3ipZDR/PsmyTeo09wsHOLb7faSCYHveM+Gqrj8/DzCmVPn3VPKMH",
+	"KW3Zj4eT0jkBsVvaLkXwFTB0QI5BAHJcPggiRjmX51lazAb6P+rhDedXXw8o5D7Qo8APqk11+Z/4fbK8",
+	"HAUv0PDI1O2d9+/O9iAOfCnj+Xt0N/2Gw4Do8O7eeabJ23Oe8A7yJl9TYjjZ3hetYPSQtaMV7l4832mM",
+	"Qh2IbXFW8n3ULrt9hlDaFiZtodMNdukJVNRid+/AaSMkHEDk0mOTztAeq0nBWtLKW85/SydqhRZVXQ9e",
+	"4YOPBhoMMd3qo7Qx7oypzRa3mHd3xN/jtfUX2oC4qh492uyNGEgJypQf3bkkMKh4ZFihros9V/ZW6Y6P",
+	"MWo6zWzRpZocSVWcu/BAtpADUG1oR/L3+Z+2VgJ3643btGY2mXpVNexm6l1vebV2f58xR7hZIXIq4qNc",
+	"ZjLfQV8fX0t2hCYatRQgtb42de23zcfqvxNXm09PW6pzAA5gRZWj3+JYraT1v0ueS9+bBGDCoynPCRVi",
+	"3v/skJctTsOkjGpboc6SutUAarMbdbq+hqJwpZHikJ7b7cc1A1mQER1Unw0DS5xwCoyewETY4tp//xvb",
+	"Da9ZjtcvBH7gAFN15Tw5O/8Ju+mfWyObewPBc7MEuuyqrhgSXAUol1R5nkxpuvo8tZCWNWFvjmRJRJYv",
+	"hXg7tfp10rp2NLA5HA0inG2FCYUn6cULjN9uwo/froAfBzYBz292Das2SuMDU5NYWkjZCII7l0gJyf+C",
+	"2ttK2ltkBYgiMlSG9L0G9bjiHkHgTBKIV88HFknO8JcnuzvKgGg1+erv8H/DH34YPn/+9UBF7qNcrKBI",
+	"o2+Xrt6wmy7JmYDzjpZ9tDWQe5Do3Afwz49Gf6FuW1M3wuCLsVduRoZTPXKw+WoP7Rmqnh99bt6/O4MD",
+	"M+Yiltn0OKVWwEAP6sphkAqLLEhswpQBgHq5Dtsk+vFseaa7dB86hi/38Qc9lPvwuRz2C4v4/FhEu/2m",
+	"hUtgRg/m7EGz2to6ZGTX2yKNms3h39ePHTWkOF/NdFRqJJWOrK891+Gz29hxeyL8rsElicddckvVzyps",
+	"Y2FYzsuE3CYK5XyZ/da1ICzKC7EULTE8NLUA3sO+Yxa+luSAJusfzzs7wgbd3aybURHvG2CEjwqErxhd",
+	"6qX+gA6iS0MVMW6OrTIEYpCkL0ugXIK+XsfZVSA/sOzTnVZL1kAq7YyZYZIsvOqEFTYLqFkbtHo4TV38",
+	"umO/ab1gYt8Cjd25/Csp8npRo2b+9c2J0m+lZEV9bw6CGpX6z90FbmoJWakk+YSczN76AhvUe2la92SQ",
+	"de0O+onPnLeLsimHRi5d1cxbvQLvO6iU1M6erJ82kW6N+3e7loGgXtdbCfJu5V9zsx3OeXglQFafxwJm",
+	"EM48yYx7n4kqUu4mt+kn4mtwAOAsPM98ORa/i1PKoCOL7Mlk5Oc3bCpDYss8Qcd+USyeHB8L+XgUZ7J+",
+	"vC9h6juUouH/nz0+D15i+j3KoHrOc5QaxkzYnKGvFzx9+uZV8GB0z/i4CeiUPDYuCMGwG+rhLeqq2Hzo",
+	"fnjkZEY8ujc6HX2DM4Pjl7JFDI+gyegBpXkpZrT2Y3h+fH3/2NByYxBG4GbtoXDoLWuEGOA5pym/iqy1",
+	"2nmvxNdnWbRU0XGFMimwBV0zwXbHZENVLsc5W+P6jTR00B73nrL1StmadqRxEHgw08UeJqrzLDRn6uTk",
+	"sM4GaIXk7k4n4lZPlKFpIPPyBWWWgQOSlykFFqt7YhgOhYpKSolTTyXU6lEfMoccr3dMX5x8c7fLU9ZB",
+	"4FtuXmcS5TF+BCVTGaKPQm2K/nGehjpqn6wecaKlzV9KEOoo0KrIsmDO0mXAxDINZ3mWZmWlVhgqAQp0",
+	"gHuYjlNF9NDlk+HTibc6ghW2leagbp7ezOJwVgkfnLNlMOZmq/Bep4FZQ2RFqDz0b5UkkDAckSmZJvIT",
+	"Je+bY6mA7iNVsCnZaKx8SCUqWknN8Zg4xUqCY25IV0cUMs2EcupiNN/I5Fsy0VB0k7JuTlOPzSUtidkm",
+	"cbfgBSozIhAz3DczhG2B7e2lwk7q94zJlGN7JYE0SC86SCBHjKrB8jAUUc27/bhSAxMZOwpeoDqpkR7r",
+	"8eGhxUS3tha03BwU0FZRpLHTeY0u7eB4dEC690FRslb7EVGi0mqerHraM0/W3b+qFmj1bGzHxO8ODxvT",
+	"bUfEV27pNMsZMcsyubgjMvS6JJmJK5V0l6kLX9W6w6TynN77o4ybSISOPzGXxXVgpBL4VZRDpYabzkha",
+	"xcEO2K6LecdWV/AjoE6rFFZKobor7aMpVnF1Ut0WowfcAcZWa9V/qoYv3Tk6YnDLSmSUOxTYwoeryJ4r",
+	"ft07baUpsnfEx4mW7zamihpLhC1E34oLa6DoNYBP3bX0Y+dTcVXFRSk52bz0lKmNSqsbtXYUfKjcFc8q",
+	"V8VVkiaGFypzdHGmUXZD4p1OgA89dGC0nvK+sVmN00GBzSozJFVaYKLkktoSODmIdOBZBGn97QfBnerA",
+	"pP2Bp+hRMXiGcoHcIHquPGXbonUTWkHs7HJvbNYpv0QfBdwklhtSbARbzPxpR1WIvUzlhcH0OgbUZgqu",
+	"pvuiXBSIBNUctQObKke0JfcVo+CpTn9I54bLSsuqhJpyLhLXw7LOQ+WNRGVSPXw0kPdVPTzQpmPA9LmZ",
+	"SnyswTCgvZUrqARvAOPFo6mTOraI6m9strV9HMp66thO+XzhpJE9pIjeyFfqmfWbanpDI59X0kRuLaU3",
+	"kyjuWFDvBvn6B/jYpo/plpkqqSjxku8m+SirGL2oJMPcO0K7GTfvWFhaC0EVpHcnI9V73q2Y1J2jdB2k",
+	"1Omi5HyQDHucPCwNOdo0PJffiZTHlZp5Vj6yRXAreg+aTLTug7S66hQeBa6FkdZJ1TxVILex0Ej16Jtv",
+	"Gige0nRfONDYpyQlgXNghUBPoo/VVoIn2QGONy3BBsvpcmmScxYtgdGnfCus78C/TVD9mF/r2jx+8nte",
+	"wMTntgakbK9ujDSPANZ7pckPSZGVrfH6LH1FB0SDYob5kPE2CQkdpPbGuSgwfzsNSUFfGay2FicRCwlF",
+	"v3wCv+Rge0Z1OchWqF7w20LCfyhoyRvYBGkaXheJBKIb46F2Y5+ovhVqr4dqG2H7BsKGf+gW1NurJKFR",
+	"76CSRH0S/XxjOxYoGl3vTaLYFeL1tQw6Nh/P0JhQr40eDtzvVeyqqDXNNXq2oO+dGA8/C6uhnkQf9N25",
+	"zXBfBLQfEq2Dv2W60qnyls+z66aHUyYVds2JVhSmOhrS+1oRhukTU31R2RtlimeZ1k+KyQxTPEorhrI3",
+	"qklWbwNUyguTK/iG5ZHwWyyVRV5NB1aE8YwbWS8NyO7Ie/Rej9dhMTGbAtCzW3qIc+fMtiPsoOIeqRUq",
+	"k9umw7gql4PXOJcbnzGzgA0cRrKgL47rzRF6hmFhMm5Jtazjlnm8t316SSMoPuDZmh9VQV05k2VdOfGs",
+	"QMNEPagAxNSY88ID8zCoPVcBNCpgzV9TW5pinaLJcNR/tBiDyjXWXxHK4Ok2XHmo8fkbLnPG7s9Io4vU",
+	"euD+VypQKyv1VWFOULpyXluQS/A2IX6cVyrDeoGPvCRvrRY4sEF5M6CJRKipJCwaJdAqba0duS3qVbO+",
+	"1asl7hWwtcqIHhBXayNKiNbWTBkOw4yygEjEJJXVw4bzrkKLHftTSSDSZoMyqW3yZk4Sx2IkjKrdkpXR",
+	"oD2bTKgcWmOP5AycRGb74GT+hD53LBi2JMTxadYaehI424uDpsPdSIGNxEcOxln0+oj3rFqpbu5PdtMk",
+	"iuemwzvYm7aTaybRVsd7G3ASPISzTD8s/cKxTMHXdlLfuZPUjiBREYnpRlBhotlrie+A+qBcrfPl43G+",
+	"QqE6Tl09FjgfWpnTSFbECHQqP+TjKoSaR3iRO8HM1VLGjptaokwMeEe0oJq78EC0oPP066zFdyF0GjRa",
+	"caR9jOSYchy162/N9E5eXO2bV3aE+dVFMI+pnuvNDBP/ySxLnc5lanJHuFVJd/U5opauX/2ZMZb2RGCr",
+	"0dFkdOg2f9FV1nqmBePuUmStkiuncZW5Ut+IrgVLwafPFf1RICPetadCi0fRPE6V2WIqZwqaBnIanUWp",
+	"ZgKo3GHcBypXclbcMQpXb5x7BWlfooy1kPlB2+VvEKYzLIChttO56417zCrJw2IZUsNSuYFbm9baEoBo",
+	"1JfI0ET7Y5kRqStUibJK6CxgMRdr4zwoxLJ0WB6cnf+kQ0swSQdx+UohHRfvZb0lHYLiSB5rHwWbtKpu",
+	"XMbFvVcHZW+HoZppaw9Hon8iAZlyrFFXCB+gxw+zXlV6rt/8axyo957kYJViRzKs+Vd9wNQZaMmD1jhi",
+	"n8yzxmGCEZZouJ2qa4nNKKU1rjZWLjOKJ8fy4uRI35yMoIdj9QOXT/Xinzj2v0+Devc/8TyeLOXpUzYo",
+	"tGSzaxYnbBwnsiSc6kgZzZq9vErl7VUK1aRlVmykLXqQ6VdaHprd+pKc1q0Hnu4s0/d1Wc8R6CYdoBu5",
+	"tpZWzVCiupe7/unjp/8HR1yExHQHAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
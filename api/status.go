@@ -0,0 +1,70 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/blessnetwork/b7s/consensus/pbft"
+	"github.com/blessnetwork/b7s/models/bls"
+)
+
+func (r FunctionStatusRequest) Valid() error {
+
+	if r.Id == "" {
+		return errors.New("request ID is required")
+	}
+
+	return nil
+}
+
+// ExecutionStatus implements the REST API endpoint for retrieving the status of a function execution.
+func (a *API) ExecutionStatus(ctx echo.Context) error {
+
+	// Get the request ID.
+	var request FunctionStatusRequest
+	err := ctx.Bind(&request)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = request.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, errors.New("missing request ID"))
+	}
+
+	// Lookup execution status.
+	status, err := a.Node.ExecutionStatus(ctx.Request().Context(), request.Id)
+	if err != nil {
+		if errors.Is(err, bls.ErrNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve execution status: %w", err))
+	}
+
+	if !ownedByClient(ctx, status.Client) {
+		return ctx.NoContent(http.StatusNotFound)
+	}
+
+	res := FunctionStatusResponse{
+		RequestId: status.RequestID,
+		Phase:     FunctionStatusResponsePhase(status.Phase),
+	}
+
+	// Once the execution is done, include the result.
+	if status.Record != nil {
+		res.Code = string(status.Record.Code)
+		res.Results = status.Record.Aggregated
+		res.Aggregation = status.Record.Aggregation
+		res.Cluster = status.Record.Cluster
+		res.Message = status.Record.Message
+		res.Receipts = status.Record.Results.Receipts()
+		res.Certificate = pbft.NewResultCertificate(status.Record.RequestID, status.Record.Cluster.Peers, status.Record.Results)
+	}
+
+	// Send the response back.
+	return ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,186 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/api"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestAPI_ExecutionStatus(t *testing.T) {
+	t.Run("execution done", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		req := api.FunctionStatusRequest{
+			Id: mocks.GenericString,
+		}
+
+		rec, ctx, err := setupRecorder(statusEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecutionStatus(ctx)
+		require.NoError(t, err)
+
+		var res api.FunctionStatusResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		record := mocks.GenericExecutionRecord
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		require.Equal(t, api.Done, res.Phase)
+		require.Equal(t, record.Code.String(), res.Code)
+		require.Equal(t, record.RequestID, res.RequestId)
+		require.Equal(t, record.Cluster, res.Cluster)
+
+		require.Len(t, res.Results, 1)
+		require.Equal(t, record.Aggregated[0].Result, res.Results[0].Result)
+	})
+	t.Run("execution done with receipts", func(t *testing.T) {
+		t.Parallel()
+
+		receipt := execute.Receipt{
+			RequestID:  mocks.GenericUUID.String(),
+			FunctionID: mocks.GenericExecutionRequest.FunctionID,
+			Method:     mocks.GenericExecutionRequest.Method,
+			Worker:     mocks.GenericPeerID,
+			Signature:  "dummy-signature",
+		}
+
+		record := mocks.GenericExecutionRecord
+		record.Results = execute.ResultMap{
+			mocks.GenericPeerID: {Result: mocks.GenericExecutionResult, Receipt: &receipt},
+		}
+
+		node := mocks.BaselineNode(t)
+		node.ExecutionStatusFunc = func(context.Context, string) (bls.ExecutionStatus, error) {
+			status := bls.ExecutionStatus{
+				RequestID: record.RequestID,
+				Phase:     bls.ExecutionPhaseDone,
+				Record:    &record,
+			}
+			return status, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		rec, ctx, err := setupRecorder(statusEndpoint, api.FunctionStatusRequest{Id: record.RequestID})
+		require.NoError(t, err)
+
+		err = srv.ExecutionStatus(ctx)
+		require.NoError(t, err)
+
+		var res api.FunctionStatusResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		require.Equal(t, []execute.Receipt{receipt}, res.Receipts)
+		// Results were not produced by a PBFT cluster.
+		require.Nil(t, res.Certificate)
+	})
+	t.Run("execution in progress", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecutionStatusFunc = func(_ context.Context, id string) (bls.ExecutionStatus, error) {
+			status := bls.ExecutionStatus{
+				RequestID: id,
+				Phase:     bls.ExecutionPhaseExecuting,
+			}
+			return status, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.FunctionStatusRequest{
+			Id: "dummy-request-id",
+		}
+
+		rec, ctx, err := setupRecorder(statusEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecutionStatus(ctx)
+		require.NoError(t, err)
+
+		var res api.FunctionStatusResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		require.Equal(t, req.Id, res.RequestId)
+		require.Equal(t, api.Executing, res.Phase)
+		require.Empty(t, res.Code)
+		require.Empty(t, res.Results)
+	})
+	t.Run("request not found", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecutionStatusFunc = func(context.Context, string) (bls.ExecutionStatus, error) {
+			return bls.ExecutionStatus{}, bls.ErrNotFound
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.FunctionStatusRequest{
+			Id: "dummy-request-id",
+		}
+
+		rec, ctx, err := setupRecorder(statusEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecutionStatus(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+	})
+	t.Run("node error", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecutionStatusFunc = func(context.Context, string) (bls.ExecutionStatus, error) {
+			return bls.ExecutionStatus{}, mocks.GenericError
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.FunctionStatusRequest{
+			Id: "dummy-request-id",
+		}
+
+		_, ctx, err := setupRecorder(statusEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecutionStatus(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusInternalServerError, echoErr.Code)
+	})
+	t.Run("missing request ID", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		_, ctx, err := setupRecorder(statusEndpoint, api.FunctionStatusRequest{})
+		require.NoError(t, err)
+
+		err = srv.ExecutionStatus(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+}
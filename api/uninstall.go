@@ -0,0 +1,45 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (r FunctionUninstallRequest) Valid() error {
+
+	if r.Cid == "" {
+		return errors.New("function CID is required")
+	}
+
+	return nil
+}
+
+// UninstallFunction implements the REST API endpoint for removing a function from worker nodes.
+func (a *API) UninstallFunction(ctx echo.Context) error {
+
+	var req FunctionUninstallRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = req.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+	}
+
+	workers, err := a.Node.PublishFunctionUninstall(ctx.Request().Context(), req.Cid, req.Topic)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("function uninstall failed: %w", err))
+	}
+
+	res := FunctionUninstallResponse{
+		Cid:     req.Cid,
+		Workers: workers,
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
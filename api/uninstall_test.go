@@ -0,0 +1,94 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/api"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestAPI_FunctionUninstall(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		req := api.FunctionUninstallRequest{
+			Cid:   "dummy-cid",
+			Topic: "dummy-topic",
+		}
+
+		var (
+			cid      string
+			subgroup string
+		)
+		node := mocks.BaselineNode(t)
+		node.PublishFunctionUninstallFunc = func(_ context.Context, c string, s string) ([]bls.FunctionUninstallResult, error) {
+			cid, subgroup = c, s
+			return []bls.FunctionUninstallResult{mocks.GenericFunctionUninstallResult}, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		rec, ctx, err := setupRecorder(uninstallEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.UninstallFunction(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		require.Equal(t, req.Cid, cid)
+		require.Equal(t, req.Topic, subgroup)
+
+		var res api.FunctionUninstallResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, req.Cid, res.Cid)
+		require.Equal(t, []bls.FunctionUninstallResult{mocks.GenericFunctionUninstallResult}, res.Workers)
+	})
+}
+
+func TestAPI_FunctionUninstall_HandlesErrors(t *testing.T) {
+	t.Run("missing CID", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		_, ctx, err := setupRecorder(uninstallEndpoint, api.FunctionUninstallRequest{})
+		require.NoError(t, err)
+
+		err = srv.UninstallFunction(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+	t.Run("node uninstall failure", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.PublishFunctionUninstallFunc = func(context.Context, string, string) ([]bls.FunctionUninstallResult, error) {
+			return nil, mocks.GenericError
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		_, ctx, err := setupRecorder(uninstallEndpoint, api.FunctionUninstallRequest{Cid: "dummy-cid"})
+		require.NoError(t, err)
+
+		err = srv.UninstallFunction(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusInternalServerError, echoErr.Code)
+	})
+}
@@ -0,0 +1,253 @@
+package api
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/execute"
+)
+
+func (r UsageRequest) Valid() error {
+
+	switch r.GroupBy {
+	case "", UsageGroupByClient, UsageGroupByFunction, UsageGroupByWorker, UsageGroupByDay:
+	default:
+		return fmt.Errorf("unsupported grouping: %v", r.GroupBy)
+	}
+
+	return nil
+}
+
+func (r UsageExportRequest) Valid() error {
+
+	switch r.Format {
+	case "", UsageExportJSON, UsageExportCSV:
+	default:
+		return fmt.Errorf("unsupported format: %v", r.Format)
+	}
+
+	return nil
+}
+
+// UsageTotals implements the REST API endpoint for reporting resource usage totals from the usage ledger.
+func (a *API) UsageTotals(ctx echo.Context) error {
+
+	var req UsageRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = req.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+	}
+
+	filter, err := usageFilter(req.From, req.To, req.Client, req.FunctionId, req.Worker)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+	}
+
+	filter, err = usageScope(ctx, filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusForbidden, err)
+	}
+
+	entries, err := a.Node.UsageEntries(ctx.Request().Context(), filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve usage: %w", err))
+	}
+
+	res := UsageResponse{
+		From: filter.From,
+		To:   filter.To,
+	}
+
+	// If the range was not set, report the range covered by the ledger.
+	if res.From.IsZero() && len(entries) > 0 {
+		res.From = entries[0].Timestamp
+	}
+	if res.To.IsZero() {
+		res.To = time.Now().UTC()
+	}
+
+	groups := make(map[string]*bls.UsageTotals)
+	for _, entry := range entries {
+
+		res.Total.Add(entry.Usage)
+
+		if req.GroupBy == "" {
+			continue
+		}
+
+		key := usageGroupKey(entry, req.GroupBy)
+		totals, ok := groups[key]
+		if !ok {
+			totals = &bls.UsageTotals{}
+			groups[key] = totals
+		}
+
+		totals.Add(entry.Usage)
+	}
+
+	for key, totals := range groups {
+		res.Groups = append(res.Groups, UsageGroup{Key: key, Usage: *totals})
+	}
+
+	slices.SortFunc(res.Groups, func(a, b UsageGroup) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// ExportUsage implements the REST API endpoint for exporting entries from the usage ledger.
+func (a *API) ExportUsage(ctx echo.Context) error {
+
+	var req UsageExportRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = req.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+	}
+
+	filter, err := usageFilter(req.From, req.To, req.Client, req.FunctionId, req.Worker)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+	}
+
+	filter, err = usageScope(ctx, filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusForbidden, err)
+	}
+
+	entries, err := a.Node.UsageEntries(ctx.Request().Context(), filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve usage: %w", err))
+	}
+
+	if req.Format != UsageExportCSV {
+		return ctx.JSON(http.StatusOK, entries)
+	}
+
+	ctx.Response().Header().Set(echo.HeaderContentType, "text/csv")
+	ctx.Response().WriteHeader(http.StatusOK)
+
+	w := csv.NewWriter(ctx.Response())
+	err = w.Write(usageCSVHeader)
+	if err != nil {
+		return fmt.Errorf("could not write CSV header: %w", err)
+	}
+
+	for _, entry := range entries {
+		err = w.Write(usageCSVRecord(entry))
+		if err != nil {
+			return fmt.Errorf("could not write CSV record: %w", err)
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+func usageFilter(from time.Time, to time.Time, client string, function string, worker string) (bls.UsageFilter, error) {
+
+	filter := bls.UsageFilter{
+		From:       from,
+		To:         to,
+		Client:     client,
+		FunctionID: function,
+	}
+
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		return bls.UsageFilter{}, errors.New("start of the time range must be before its end")
+	}
+
+	if worker != "" {
+		id, err := peer.Decode(worker)
+		if err != nil {
+			return bls.UsageFilter{}, fmt.Errorf("invalid worker peer ID: %w", err)
+		}
+
+		filter.Worker = id
+	}
+
+	return filter, nil
+}
+
+// usageScope restricts the filter to the usage of the client that made the request, unless the client is an admin.
+func usageScope(ctx echo.Context, filter bls.UsageFilter) (bls.UsageFilter, error) {
+
+	if isAdmin(ctx) {
+		return filter, nil
+	}
+
+	client := clientID(ctx)
+	if filter.Client != "" && filter.Client != client {
+		return bls.UsageFilter{}, errors.New("usage of other clients is only available to admin clients")
+	}
+
+	filter.Client = client
+
+	return filter, nil
+}
+
+func usageGroupKey(entry execute.UsageEntry, groupBy UsageRequestGroupBy) string {
+
+	switch groupBy {
+	case UsageGroupByClient:
+		return entry.Client
+	case UsageGroupByFunction:
+		return entry.FunctionID
+	case UsageGroupByWorker:
+		return entry.Worker.String()
+	case UsageGroupByDay:
+		return entry.Timestamp.UTC().Format(time.DateOnly)
+	default:
+		return ""
+	}
+}
+
+var usageCSVHeader = []string{
+	"request_id",
+	"function_id",
+	"client",
+	"worker",
+	"timestamp",
+	"wall_clock_time_ns",
+	"cpu_user_time_ns",
+	"cpu_sys_time_ns",
+	"memory_max_kb",
+	"signature",
+}
+
+// usageCSVRecord returns the CSV record for the usage entry. Values are exactly the ones the worker signed,
+// so the signature can be verified from the export.
+func usageCSVRecord(entry execute.UsageEntry) []string {
+	return []string{
+		entry.RequestID,
+		entry.FunctionID,
+		entry.Client,
+		entry.Worker.String(),
+		entry.Timestamp.UTC().Format(time.RFC3339Nano),
+		strconv.FormatInt(entry.Usage.WallClockTime.Nanoseconds(), 10),
+		strconv.FormatInt(entry.Usage.CPUUserTime.Nanoseconds(), 10),
+		strconv.FormatInt(entry.Usage.CPUSysTime.Nanoseconds(), 10),
+		strconv.FormatInt(entry.Usage.MemoryMaxKB, 10),
+		entry.Signature,
+	}
+}
@@ -0,0 +1,348 @@
+package api_test
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/api"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestAPI_UsageTotals(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		first := mocks.GenericUsageEntry
+		first.Client = "client-b"
+
+		second := mocks.GenericUsageEntry
+		second.Client = "client-a"
+		second.Timestamp = first.Timestamp.Add(24 * time.Hour)
+
+		third := mocks.GenericUsageEntry
+		third.Client = "client-b"
+		third.Timestamp = first.Timestamp.Add(48 * time.Hour)
+
+		req := api.UsageRequest{
+			To:         first.Timestamp.Add(72 * time.Hour),
+			FunctionId: first.FunctionID,
+			Worker:     mocks.GenericPeerID.String(),
+			GroupBy:    api.UsageGroupByClient,
+		}
+
+		var filter bls.UsageFilter
+		node := mocks.BaselineNode(t)
+		node.UsageEntriesFunc = func(_ context.Context, f bls.UsageFilter) ([]execute.UsageEntry, error) {
+			filter = f
+			return []execute.UsageEntry{first, second, third}, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		rec, ctx, err := setupRecorder(usageEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.UsageTotals(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		expectedFilter := bls.UsageFilter{
+			To:         req.To,
+			FunctionID: req.FunctionId,
+			Worker:     mocks.GenericPeerID,
+		}
+		require.Equal(t, expectedFilter, filter)
+
+		var res api.UsageResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		// Range starts with the oldest entry if not set.
+		require.True(t, first.Timestamp.Equal(res.From))
+		require.True(t, req.To.Equal(res.To))
+
+		usage := mocks.GenericUsageEntry.Usage
+		require.Equal(t, uint(3), res.Total.Executions)
+		require.Equal(t, 3*usage.WallClockTime, res.Total.WallClockTime)
+		require.Equal(t, 3*usage.CPUUserTime, res.Total.CPUUserTime)
+		require.Equal(t, 3*usage.CPUSysTime, res.Total.CPUSysTime)
+		require.Equal(t, 3*usage.MemoryMaxKB, res.Total.MemoryMaxKB)
+
+		require.Len(t, res.Groups, 2)
+		require.Equal(t, "client-a", res.Groups[0].Key)
+		require.Equal(t, uint(1), res.Groups[0].Usage.Executions)
+		require.Equal(t, "client-b", res.Groups[1].Key)
+		require.Equal(t, uint(2), res.Groups[1].Usage.Executions)
+	})
+	t.Run("grouping by day", func(t *testing.T) {
+		t.Parallel()
+
+		next := mocks.GenericUsageEntry
+		next.Timestamp = next.Timestamp.Add(36 * time.Hour)
+
+		node := mocks.BaselineNode(t)
+		node.UsageEntriesFunc = func(context.Context, bls.UsageFilter) ([]execute.UsageEntry, error) {
+			return []execute.UsageEntry{mocks.GenericUsageEntry, next}, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		rec, ctx, err := setupRecorder(usageEndpoint, api.UsageRequest{GroupBy: api.UsageGroupByDay})
+		require.NoError(t, err)
+
+		err = srv.UsageTotals(ctx)
+		require.NoError(t, err)
+
+		var res api.UsageResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Len(t, res.Groups, 2)
+		require.Equal(t, "2024-01-01", res.Groups[0].Key)
+		require.Equal(t, "2024-01-02", res.Groups[1].Key)
+	})
+}
+
+func TestAPI_UsageTotals_HandlesErrors(t *testing.T) {
+
+	start := mocks.GenericUsageEntry.Timestamp
+
+	tests := []struct {
+		name string
+		req  api.UsageRequest
+	}{
+		{
+			name: "invalid grouping",
+			req:  api.UsageRequest{GroupBy: "dummy-grouping"},
+		},
+		{
+			name: "invalid time range",
+			req:  api.UsageRequest{From: start, To: start.Add(-time.Hour)},
+		},
+		{
+			name: "invalid worker peer ID",
+			req:  api.UsageRequest{Worker: "dummy-worker"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := setupAPI(t)
+
+			_, ctx, err := setupRecorder(usageEndpoint, test.req)
+			require.NoError(t, err)
+
+			err = srv.UsageTotals(ctx)
+			require.Error(t, err)
+
+			echoErr, ok := err.(*echo.HTTPError)
+			require.True(t, ok)
+
+			require.Equal(t, http.StatusBadRequest, echoErr.Code)
+		})
+	}
+
+	t.Run("node failure", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.UsageEntriesFunc = func(context.Context, bls.UsageFilter) ([]execute.UsageEntry, error) {
+			return nil, mocks.GenericError
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		_, ctx, err := setupRecorder(usageEndpoint, api.UsageRequest{})
+		require.NoError(t, err)
+
+		err = srv.UsageTotals(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusInternalServerError, echoErr.Code)
+	})
+}
+
+func TestAPI_ExportUsage(t *testing.T) {
+	t.Run("JSON export", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		rec, ctx, err := setupRecorder(usageExportEndpoint, api.UsageExportRequest{})
+		require.NoError(t, err)
+
+		err = srv.ExportUsage(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var entries []api.UsageEntry
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &entries))
+		require.Equal(t, []api.UsageEntry{mocks.GenericUsageEntry}, entries)
+	})
+	t.Run("CSV export", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		rec, ctx, err := setupRecorder(usageExportEndpoint, api.UsageExportRequest{Format: api.UsageExportCSV})
+		require.NoError(t, err)
+
+		err = srv.ExportUsage(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		require.Equal(t, "text/csv", rec.Header().Get(echo.HeaderContentType))
+
+		records, err := csv.NewReader(rec.Body).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+
+		entry := mocks.GenericUsageEntry
+		require.Equal(t, "request_id", records[0][0])
+		require.Equal(t, []string{
+			entry.RequestID,
+			entry.FunctionID,
+			entry.Client,
+			entry.Worker.String(),
+			"2024-01-01T00:00:00Z",
+			"1000000000",
+			"300000000",
+			"100000000",
+			"2048",
+			"",
+		}, records[1])
+	})
+	t.Run("invalid format", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		_, ctx, err := setupRecorder(usageExportEndpoint, api.UsageExportRequest{Format: "dummy-format"})
+		require.NoError(t, err)
+
+		err = srv.ExportUsage(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+}
+
+func TestAPI_UsageScope(t *testing.T) {
+
+	withKey := func(req *http.Request) {
+		req.Header.Set(bls.APIHeaderKey, mocks.GenericAPIKey)
+	}
+
+	// Create a handler authenticating the given client, that records the filter used to retrieve usage.
+	newHandler := func(t *testing.T, client bls.APIClient, handler func(*api.API) echo.HandlerFunc) (echo.HandlerFunc, *bls.UsageFilter) {
+		t.Helper()
+
+		store := mocks.BaselineStore(t)
+		store.RetrieveAPIClientByKeyFunc = func(context.Context, string) (bls.APIClient, error) {
+			return client, nil
+		}
+
+		var filter bls.UsageFilter
+		node := mocks.BaselineNode(t)
+		node.UsageEntriesFunc = func(_ context.Context, f bls.UsageFilter) ([]execute.UsageEntry, error) {
+			filter = f
+			return []execute.UsageEntry{mocks.GenericUsageEntry}, nil
+		}
+
+		return api.NewAuthenticator(mocks.NoopLogger, store).Middleware(handler(api.New(mocks.NoopLogger, node))), &filter
+	}
+
+	admin := mocks.GenericAPIClient
+	admin.ID = "admin-client"
+	admin.Admin = true
+
+	t.Run("usage is restricted to the client", func(t *testing.T) {
+
+		handler, filter := newHandler(t, mocks.GenericAPIClient, func(srv *api.API) echo.HandlerFunc { return srv.UsageTotals })
+
+		rec, ctx, err := setupRecorder(usageEndpoint, api.UsageRequest{GroupBy: api.UsageGroupByClient}, withKey)
+		require.NoError(t, err)
+
+		err = handler(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		require.Equal(t, mocks.GenericAPIClient.ID, filter.Client)
+	})
+	t.Run("export is restricted to the client", func(t *testing.T) {
+
+		handler, filter := newHandler(t, mocks.GenericAPIClient, func(srv *api.API) echo.HandlerFunc { return srv.ExportUsage })
+
+		rec, ctx, err := setupRecorder(usageExportEndpoint, api.UsageExportRequest{Client: mocks.GenericAPIClient.ID}, withKey)
+		require.NoError(t, err)
+
+		err = handler(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		require.Equal(t, mocks.GenericAPIClient.ID, filter.Client)
+	})
+	t.Run("usage of other clients", func(t *testing.T) {
+
+		handlers := []struct {
+			endpoint string
+			request  any
+			handler  func(*api.API) echo.HandlerFunc
+		}{
+			{usageEndpoint, api.UsageRequest{Client: admin.ID}, func(srv *api.API) echo.HandlerFunc { return srv.UsageTotals }},
+			{usageExportEndpoint, api.UsageExportRequest{Client: admin.ID}, func(srv *api.API) echo.HandlerFunc { return srv.ExportUsage }},
+		}
+
+		for _, h := range handlers {
+
+			handler, _ := newHandler(t, mocks.GenericAPIClient, h.handler)
+
+			_, ctx, err := setupRecorder(h.endpoint, h.request, withKey)
+			require.NoError(t, err)
+
+			err = handler(ctx)
+			require.Error(t, err)
+
+			echoErr, ok := err.(*echo.HTTPError)
+			require.True(t, ok)
+			require.Equal(t, http.StatusForbidden, echoErr.Code)
+		}
+	})
+	t.Run("admin can access usage of all clients", func(t *testing.T) {
+
+		handler, filter := newHandler(t, admin, func(srv *api.API) echo.HandlerFunc { return srv.UsageTotals })
+
+		rec, ctx, err := setupRecorder(usageEndpoint, api.UsageRequest{GroupBy: api.UsageGroupByClient}, withKey)
+		require.NoError(t, err)
+
+		err = handler(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		require.Empty(t, filter.Client)
+
+		handler, filter = newHandler(t, admin, func(srv *api.API) echo.HandlerFunc { return srv.ExportUsage })
+
+		rec, ctx, err = setupRecorder(usageExportEndpoint, api.UsageExportRequest{Client: mocks.GenericAPIClient.ID}, withKey)
+		require.NoError(t, err)
+
+		err = handler(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		require.Equal(t, mocks.GenericAPIClient.ID, filter.Client)
+	})
+}
@@ -19,6 +19,12 @@ const (
 )
 
 func main() {
+
+	if len(os.Args) > 1 && os.Args[1] == verifyReceiptCommand {
+		VerifyReceipts(os.Args[2:])
+		return
+	}
+
 	var (
 		flagOutputDir string
 		flagString    string
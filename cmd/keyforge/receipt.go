@@ -0,0 +1,162 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+
+	"github.com/spf13/pflag"
+
+	"github.com/blessnetwork/b7s/api"
+	"github.com/blessnetwork/b7s/models/execute"
+)
+
+const verifyReceiptCommand = "verify-receipt"
+
+// VerifyReceipts verifies execution receipts. Either a single receipt is verified, or all of the receipts in an
+// execution response returned by the REST API. If the execution request is provided, receipts are checked against it too.
+func VerifyReceipts(args []string) {
+
+	var (
+		flagReceipt  string
+		flagResponse string
+		flagRequest  string
+	)
+
+	fs := pflag.NewFlagSet(verifyReceiptCommand, pflag.ExitOnError)
+	fs.StringVar(&flagReceipt, "receipt", "", "file with the receipt to verify")
+	fs.StringVar(&flagResponse, "response", "", "file with the execution response whose receipts should be verified")
+	fs.StringVar(&flagRequest, "request", "", "file with the execution request, to verify the receipts describe it")
+
+	err := fs.Parse(args)
+	if err != nil {
+		log.Fatalf("Could not parse flags: %s", err)
+	}
+
+	if (flagReceipt == "") == (flagResponse == "") {
+		log.Fatalf("Exactly one of --receipt or --response is required")
+	}
+
+	var req *execute.Request
+	if flagRequest != "" {
+		var er api.ExecutionRequest
+		readJSON(flagRequest, &er)
+
+		req = &execute.Request{
+			FunctionID: er.FunctionId,
+			Method:     er.Method,
+			Parameters: er.Parameters,
+			Config:     er.Config,
+		}
+	}
+
+	if flagReceipt != "" {
+		var receipt execute.Receipt
+		readJSON(flagReceipt, &receipt)
+
+		err = receipt.VerifySignature()
+		if err == nil && req != nil {
+			err = verifyReceiptInputs(receipt, *req)
+		}
+		if err != nil {
+			log.Fatalf("Receipt verification failed: %s", err)
+		}
+
+		fmt.Printf("Receipt signed by %s verified successfully.\n", receipt.Worker)
+		return
+	}
+
+	var res api.ExecutionResponse
+	readJSON(flagResponse, &res)
+
+	if len(res.Receipts) == 0 {
+		log.Fatalf("Execution response has no receipts")
+	}
+
+	failed := 0
+	for _, receipt := range res.Receipts {
+		err := verifyResponseReceipt(res, receipt, req)
+		if err != nil {
+			fmt.Printf("Receipt signed by %s verification failed: %s\n", receipt.Worker, err)
+			failed++
+			continue
+		}
+
+		fmt.Printf("Receipt signed by %s verified successfully.\n", receipt.Worker)
+	}
+
+	if failed > 0 {
+		log.Fatalf("%d of %d receipts failed verification", failed, len(res.Receipts))
+	}
+}
+
+// verifyResponseReceipt verifies the receipt against the result the worker produced, according to the execution response.
+func verifyResponseReceipt(res api.ExecutionResponse, receipt execute.Receipt, req *execute.Request) error {
+
+	idx := slices.IndexFunc(res.Results, func(result api.AggregatedResult) bool {
+		return slices.Contains(result.Peers, receipt.Worker)
+	})
+	if idx < 0 {
+		return errors.New("no result from the worker in the response")
+	}
+
+	output := res.Results[idx].Result
+
+	if req == nil {
+		err := receipt.VerifySignature()
+		if err != nil {
+			return err
+		}
+
+		if receipt.RequestID != res.RequestId {
+			return fmt.Errorf("request ID mismatch (have: %s, want: %s)", receipt.RequestID, res.RequestId)
+		}
+
+		hash, err := execute.OutputHash(output)
+		if err != nil {
+			return fmt.Errorf("could not hash outputs: %w", err)
+		}
+
+		if receipt.OutputHash != hash {
+			return errors.New("output hash mismatch")
+		}
+
+		return nil
+	}
+
+	return receipt.Verify(res.RequestId, *req, output)
+}
+
+func verifyReceiptInputs(receipt execute.Receipt, req execute.Request) error {
+
+	if receipt.FunctionID != req.FunctionID || receipt.Method != req.Method {
+		return errors.New("receipt is for a different function or method")
+	}
+
+	hash, err := execute.InputHash(req)
+	if err != nil {
+		return fmt.Errorf("could not hash inputs: %w", err)
+	}
+
+	if receipt.InputHash != hash {
+		return errors.New("input hash mismatch")
+	}
+
+	return nil
+}
+
+func readJSON(path string, v any) {
+
+	payload, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatalf("Could not read file: %s", err)
+	}
+
+	err = json.Unmarshal(payload, v)
+	if err != nil {
+		log.Fatalf("Could not decode %s: %s", path, err)
+	}
+}
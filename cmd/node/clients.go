@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/blessnetwork/b7s/models/bls"
+)
+
+// apiClientEntry describes a REST API client listed in the clients file.
+// Clients can be listed with a plain API key, which is hashed before it is stored.
+type apiClientEntry struct {
+	ID        string `json:"id"`
+	APIKey    string `json:"api_key,omitempty"`
+	KeyHash   string `json:"key_hash,omitempty"`
+	PublicKey string `json:"public_key,omitempty"`
+	Admin     bool   `json:"admin,omitempty"`
+}
+
+// importAPIClients makes the allowlist match the REST API clients listed in the file. Existing clients with the same ID are replaced,
+// and clients no longer listed in the file are removed. It returns the number of imported and removed clients.
+func importAPIClients(ctx context.Context, store bls.APIClientStore, path string) (int, int, error) {
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not read clients file: %w", err)
+	}
+
+	var entries []apiClientEntry
+	err = json.Unmarshal(data, &entries)
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not unpack clients file: %w", err)
+	}
+
+	listed := make(map[string]struct{}, len(entries))
+	for i, entry := range entries {
+
+		client := bls.APIClient{
+			ID:        entry.ID,
+			KeyHash:   entry.KeyHash,
+			PublicKey: entry.PublicKey,
+			Admin:     entry.Admin,
+			CreatedAt: time.Now().UTC(),
+		}
+		if entry.APIKey != "" {
+			client.KeyHash = bls.HashAPIKey(entry.APIKey)
+		}
+
+		err = client.Valid()
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid client (index: %d): %w", i, err)
+		}
+
+		err = store.SaveAPIClient(ctx, client)
+		if err != nil {
+			return 0, 0, fmt.Errorf("could not save client (id: %s): %w", client.ID, err)
+		}
+
+		listed[client.ID] = struct{}{}
+	}
+
+	// Revoke access for clients removed from the file.
+	clients, err := store.RetrieveAPIClients(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not retrieve clients: %w", err)
+	}
+
+	removed := 0
+	for _, client := range clients {
+
+		_, ok := listed[client.ID]
+		if ok {
+			continue
+		}
+
+		err = store.RemoveAPIClient(ctx, client.ID)
+		if err != nil {
+			return 0, 0, fmt.Errorf("could not remove client (id: %s): %w", client.ID, err)
+		}
+
+		removed++
+	}
+
+	return len(entries), removed, nil
+}
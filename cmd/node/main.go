@@ -245,7 +245,17 @@ func run() int {
 		}
 
 	case bls.HeadNode:
-		node, err = createHeadNode(core, cfg)
+		node, err = createHeadNode(core, store, cfg)
+
+		if err == nil && cfg.Head.APIClients != "" {
+			n, removed, ierr := importAPIClients(ctx, store, cfg.Head.APIClients)
+			if ierr != nil {
+				log.Error().Err(ierr).Str("path", cfg.Head.APIClients).Msg("could not import API clients")
+				return failure
+			}
+
+			log.Info().Int("count", n).Int("removed", removed).Msg("imported API clients")
+		}
 	}
 	if err != nil {
 		log.Error().Err(err).Msg("could not create node")
@@ -284,6 +294,13 @@ func run() int {
 				log.Error().Msg("invalid node type - not a head node")
 			}
 
+			if cfg.Head.APIAuth {
+				auth := api.NewAuthenticator(log.With().Str("component", "api-auth").Logger(), store)
+				server.Use(auth.Middleware)
+
+				log.Info().Msg("REST API authentication enabled")
+			}
+
 			apiHandler := api.New(log.With().Str("component", "api").Logger(), headNode)
 			api.RegisterHandlers(server, apiHandler)
 		}
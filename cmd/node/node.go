@@ -3,6 +3,9 @@ package main
 import (
 	"context"
 	"fmt"
+	"net/netip"
+
+	"github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/blessnetwork/b7s/config"
 	"github.com/blessnetwork/b7s/executor"
@@ -11,6 +14,7 @@ import (
 	"github.com/blessnetwork/b7s/models/bls"
 	"github.com/blessnetwork/b7s/node"
 	"github.com/blessnetwork/b7s/node/head"
+	"github.com/blessnetwork/b7s/node/head/selection"
 	"github.com/blessnetwork/b7s/node/worker"
 )
 
@@ -30,6 +34,26 @@ func createWorkerNode(core node.Core, store bls.Store, cfg *config.Config) (Node
 		executor.WithExecutableName(cfg.Worker.RuntimeCLI),
 	}
 
+	uninstallPeers := make([]peer.ID, 0, len(cfg.Worker.UninstallPeers))
+	for _, id := range cfg.Worker.UninstallPeers {
+		peerID, err := peer.Decode(id)
+		if err != nil {
+			return nil, func() error { return nil }, fmt.Errorf("could not parse uninstall peer ID: %w", err)
+		}
+
+		uninstallPeers = append(uninstallPeers, peerID)
+	}
+
+	workerOptions := []worker.Option{
+		worker.AttributeLoading(cfg.LoadAttributes),
+		worker.AttributesPath(cfg.Worker.AttributesPath),
+		worker.AttributesGateway(cfg.Worker.AttributesGateway),
+		worker.AttributesRefreshInterval(cfg.Worker.AttributesRefresh),
+		worker.Capacity(cfg.Concurrency),
+		worker.UninstallPeers(uninstallPeers),
+		worker.Workspace(cfg.Workspace),
+	}
+
 	shutdown := func() error {
 		return nil
 	}
@@ -44,6 +68,9 @@ func createWorkerNode(core node.Core, store bls.Store, cfg *config.Config) (Node
 		}
 
 		execOptions = append(execOptions, executor.WithLimiter(limiter))
+
+		// Executions run in the limiter cgroup, so that is where their CPU usage is reported.
+		workerOptions = append(workerOptions, worker.Cgroup(limits.DefaultCgroup))
 	}
 
 	// Create an executor.
@@ -52,10 +79,7 @@ func createWorkerNode(core node.Core, store bls.Store, cfg *config.Config) (Node
 		return nil, shutdown, fmt.Errorf("could not create an executor: %w", err)
 	}
 
-	worker, err := worker.New(core, fstore, executor,
-		worker.AttributeLoading(cfg.LoadAttributes),
-		worker.Workspace(cfg.Workspace),
-	)
+	worker, err := worker.New(core, fstore, executor, workerOptions...)
 	if err != nil {
 		return nil, shutdown, fmt.Errorf("could not create a worker node: %w", err)
 	}
@@ -63,9 +87,52 @@ func createWorkerNode(core node.Core, store bls.Store, cfg *config.Config) (Node
 	return worker, shutdown, nil
 }
 
-func createHeadNode(core node.Core, cfg *config.Config) (Node, error) {
+func createHeadNode(core node.Core, store bls.Store, cfg *config.Config) (Node, error) {
+
+	peerSelection := head.DefaultPeerSelectionStrategy
+	if cfg.Head.PeerSelection != "" {
+		t, err := selection.Parse(cfg.Head.PeerSelection)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse peer selection strategy: %w", err)
+		}
+
+		peerSelection = t
+	}
+
+	webhookNetworks := make([]netip.Prefix, 0, len(cfg.Head.WebhookAllowedNetworks))
+	for _, network := range cfg.Head.WebhookAllowedNetworks {
+		prefix, err := netip.ParsePrefix(network)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse webhook network: %w", err)
+		}
+
+		webhookNetworks = append(webhookNetworks, prefix)
+	}
 
-	head, err := head.New(core)
+	head, err := head.New(core, store,
+		head.ExecutionResultTTL(cfg.Head.ResultTTL),
+		head.ExecutionResultLimit(cfg.Head.ResultLimit),
+		head.WebhookAttempts(cfg.Head.WebhookAttempts),
+		head.WebhookTimeout(cfg.Head.WebhookTimeout),
+		head.WebhookBackoff(cfg.Head.WebhookBackoff),
+		head.WebhookAllowedNetworks(webhookNetworks...),
+		head.DefaultPeerSelection(peerSelection),
+		head.ReputationHalfLife(cfg.Head.ReputationHalfLife),
+		head.MinimumReputation(cfg.Head.MinimumReputation),
+		head.BatchParallelism(cfg.Head.BatchParallelism),
+		head.AsyncExecutionLimit(cfg.Head.AsyncExecutionLimit),
+		head.VerifyAttributes(cfg.Head.VerifyAttributes),
+		head.PeerExpiry(cfg.Head.PeerExpiry),
+		head.ClientRateLimit(cfg.Head.ClientRateLimit, cfg.Head.ClientRateBurst),
+		head.FunctionRateLimit(cfg.Head.FunctionRateLimit, cfg.Head.FunctionRateBurst),
+		head.ClientConcurrency(cfg.Head.ClientConcurrency),
+		head.FunctionConcurrency(cfg.Head.FunctionConcurrency),
+		head.ClientDailyQuota(head.UsageQuota{
+			WallClockTime: cfg.Head.ClientDailyWallClock,
+			CPUTime:       cfg.Head.ClientDailyCPUTime,
+			MemoryKB:      cfg.Head.ClientDailyMemoryKB,
+		}),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("could not create a head node: %w", err)
 	}
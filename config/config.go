@@ -14,6 +14,26 @@ const (
 	DefaultLogLevel     = "info"
 )
 
+// Default values for head node options.
+const (
+	DefaultExecutionResultTTL   = 24 * time.Hour
+	DefaultExecutionResultLimit = 10_000
+	DefaultWebhookAttempts      = 5
+	DefaultWebhookTimeout       = 10 * time.Second
+	DefaultWebhookBackoff       = 1 * time.Second
+	DefaultReputationHalfLife   = 24 * time.Hour
+	DefaultMinimumReputation    = 0.1
+	DefaultBatchParallelism     = 10
+	DefaultPeerExpiry           = 5 * time.Minute
+	DefaultAsyncExecutionLimit  = 1000
+)
+
+// Default values for worker node options.
+const (
+	DefaultAttributesGateway = "https://{name}.ipns.cf-ipfs.com"
+	DefaultAttributesRefresh = time.Hour
+)
+
 // Default names for storage directories.
 const (
 	DefaultDBName        = "db"
@@ -31,6 +51,22 @@ var DefaultConfig = Config{
 		Port:      DefaultPort,
 		Websocket: DefaultUseWebsocket,
 	},
+	Head: Head{
+		ResultTTL:           DefaultExecutionResultTTL,
+		ResultLimit:         DefaultExecutionResultLimit,
+		WebhookAttempts:     DefaultWebhookAttempts,
+		WebhookTimeout:      DefaultWebhookTimeout,
+		WebhookBackoff:      DefaultWebhookBackoff,
+		ReputationHalfLife:  DefaultReputationHalfLife,
+		MinimumReputation:   DefaultMinimumReputation,
+		BatchParallelism:    DefaultBatchParallelism,
+		AsyncExecutionLimit: DefaultAsyncExecutionLimit,
+		PeerExpiry:          DefaultPeerExpiry,
+	},
+	Worker: Worker{
+		AttributesGateway: DefaultAttributesGateway,
+		AttributesRefresh: DefaultAttributesRefresh,
+	},
 }
 
 // Config describes the Bless configuration options.
@@ -74,14 +110,42 @@ type Connectivity struct {
 }
 
 type Head struct {
-	RestAPI string `koanf:"rest-api" flag:"rest-api"`
+	RestAPI                string        `koanf:"rest-api"                 flag:"rest-api"`
+	ResultTTL              time.Duration `koanf:"result-ttl"               flag:"result-ttl"`
+	ResultLimit            uint          `koanf:"result-limit"             flag:"result-limit"`
+	WebhookAttempts        uint          `koanf:"webhook-attempts"         flag:"webhook-attempts"`
+	WebhookTimeout         time.Duration `koanf:"webhook-timeout"          flag:"webhook-timeout"`
+	WebhookBackoff         time.Duration `koanf:"webhook-backoff"          flag:"webhook-backoff"`
+	WebhookAllowedNetworks []string      `koanf:"webhook-allowed-networks" flag:"webhook-allowed-networks"`
+	PeerSelection          string        `koanf:"peer-selection"           flag:"peer-selection"`
+	ReputationHalfLife     time.Duration `koanf:"reputation-half-life"     flag:"reputation-half-life"`
+	MinimumReputation      float64       `koanf:"minimum-reputation"       flag:"minimum-reputation"`
+	BatchParallelism       uint          `koanf:"batch-parallelism"        flag:"batch-parallelism"`
+	AsyncExecutionLimit    uint          `koanf:"async-execution-limit"    flag:"async-execution-limit"`
+	VerifyAttributes       bool          `koanf:"verify-attributes"        flag:"verify-attributes"`
+	PeerExpiry             time.Duration `koanf:"peer-expiry"              flag:"peer-expiry"`
+	APIAuth                bool          `koanf:"api-auth"                 flag:"api-auth"`
+	APIClients             string        `koanf:"api-clients"              flag:"api-clients"`
+	ClientRateLimit        float64       `koanf:"client-rate-limit"        flag:"client-rate-limit"`
+	ClientRateBurst        uint          `koanf:"client-rate-burst"        flag:"client-rate-burst"`
+	ClientConcurrency      uint          `koanf:"client-concurrency"       flag:"client-concurrency"`
+	FunctionRateLimit      float64       `koanf:"function-rate-limit"      flag:"function-rate-limit"`
+	FunctionRateBurst      uint          `koanf:"function-rate-burst"      flag:"function-rate-burst"`
+	FunctionConcurrency    uint          `koanf:"function-concurrency"     flag:"function-concurrency"`
+	ClientDailyWallClock   time.Duration `koanf:"client-daily-wall-clock"  flag:"client-daily-wall-clock"`
+	ClientDailyCPUTime     time.Duration `koanf:"client-daily-cpu-time"    flag:"client-daily-cpu-time"`
+	ClientDailyMemoryKB    int64         `koanf:"client-daily-memory"      flag:"client-daily-memory"`
 }
 
 type Worker struct {
-	RuntimePath        string  `koanf:"runtime-path"         flag:"runtime-path"`
-	RuntimeCLI         string  `koanf:"runtime-cli"          flag:"runtime-cli"`
-	CPUPercentageLimit float64 `koanf:"cpu-percentage-limit" flag:"cpu-percentage-limit"`
-	MemoryLimitKB      int64   `koanf:"memory-limit"         flag:"memory-limit"`
+	RuntimePath        string        `koanf:"runtime-path"         flag:"runtime-path"`
+	RuntimeCLI         string        `koanf:"runtime-cli"          flag:"runtime-cli"`
+	CPUPercentageLimit float64       `koanf:"cpu-percentage-limit" flag:"cpu-percentage-limit"`
+	MemoryLimitKB      int64         `koanf:"memory-limit"         flag:"memory-limit"`
+	AttributesPath     string        `koanf:"attributes-path"      flag:"attributes-path"`
+	AttributesGateway  string        `koanf:"attributes-gateway"   flag:"attributes-gateway"`
+	AttributesRefresh  time.Duration `koanf:"attributes-refresh"   flag:"attributes-refresh"`
+	UninstallPeers     []string      `koanf:"uninstall-peers"      flag:"uninstall-peers"`
 }
 
 type Telemetry struct {
@@ -159,6 +223,54 @@ func getFlagDescription(flag string) string {
 		return "maximum number of connections the b7s host will aim to have"
 	case "rest-api":
 		return "address where the head node REST API will listen on"
+	case "result-ttl":
+		return "how long the head node keeps execution results"
+	case "result-limit":
+		return "maximum number of execution results the head node keeps"
+	case "webhook-attempts":
+		return "how many times the head node tries to deliver the execution result to a webhook"
+	case "webhook-timeout":
+		return "how long the head node waits for the webhook to respond"
+	case "webhook-backoff":
+		return "how long the head node waits before retrying webhook delivery, doubles after each failed attempt"
+	case "webhook-allowed-networks":
+		return "non-public networks (CIDR) webhooks may be delivered to, by default only public addresses are allowed"
+	case "peer-selection":
+		return "default strategy for choosing worker nodes among those reporting for roll call"
+	case "reputation-half-life":
+		return "how long until recorded worker node behavior counts half as much"
+	case "minimum-reputation":
+		return "reputation score in the 0-1 range worker nodes need to be chosen for execution"
+	case "batch-parallelism":
+		return "how many requests from a batch the head node executes at the same time"
+	case "async-execution-limit":
+		return "how many asynchronous executions the head node runs at the same time"
+	case "verify-attributes":
+		return "verify attestations of worker nodes reporting for roll calls with attribute requirements"
+	case "peer-expiry":
+		return "how long the head node lists a node after its last health ping (0 means nodes are never removed)"
+	case "api-auth":
+		return "require REST API clients to authenticate using an API key or a signature"
+	case "api-clients":
+		return "file with the REST API client allowlist, loaded on startup (clients not listed are removed)"
+	case "client-rate-limit":
+		return "how many requests per second a REST API client can make, zero means no limit"
+	case "client-rate-burst":
+		return "how many requests a REST API client can make at once"
+	case "client-concurrency":
+		return "how many requests of a REST API client the head node executes at the same time, zero means no limit"
+	case "function-rate-limit":
+		return "how many requests per second can be made for a function, zero means no limit"
+	case "function-rate-burst":
+		return "how many requests can be made for a function at once"
+	case "function-concurrency":
+		return "how many requests for a function the head node executes at the same time, zero means no limit"
+	case "client-daily-wall-clock":
+		return "total execution wall clock time a REST API client can use in a day, zero means no limit"
+	case "client-daily-cpu-time":
+		return "total execution CPU time a REST API client can use in a day, zero means no limit"
+	case "client-daily-memory":
+		return "total of peak execution memory (kB) a REST API client can use in a day, zero means no limit"
 	case "runtime-path":
 		return "Bless Runtime location (used by the worker node)"
 	case "runtime-cli":
@@ -167,6 +279,14 @@ func getFlagDescription(flag string) string {
 		return "amount of CPU time allowed for Bless Functions in the 0-1 range, 1 being unlimited"
 	case "memory-limit":
 		return "memory limit (kB) for Bless Functions"
+	case "attributes-path":
+		return "local file the worker node loads its attributes from, instead of IPFS"
+	case "attributes-gateway":
+		return "IPNS gateway URL the worker node loads its attributes from, {name} is replaced with the node IPNS name"
+	case "attributes-refresh":
+		return "how often the worker node reloads its attributes (0 means attributes are loaded only on startup)"
+	case "uninstall-peers":
+		return "IDs of head nodes allowed to uninstall functions from the worker node, by default uninstall requests are refused"
 	case "no-dialback-peers":
 		return "start without dialing back peers from previous runs"
 	case "must-reach-boot-nodes":
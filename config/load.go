@@ -117,8 +117,8 @@ func envClean(key string, value string) (string, any) {
 	default:
 		return ss, value
 
-	// Kludge: For boot nodes and topics, return type should be a string slice.
-	case "boot-nodes", "topics":
+	// Kludge: For boot nodes, topics, webhook networks and uninstall peers, return type should be a string slice.
+	case "boot-nodes", "topics", "head_webhook-allowed-networks", "worker_uninstall-peers":
 		return ss, strings.Split(value, ",")
 	}
 }
@@ -357,6 +357,52 @@ func TestConfig_Priority(t *testing.T) {
 
 }
 
+func TestConfig_Defaults(t *testing.T) {
+
+	t.Run("defaults are used when options are not set", func(t *testing.T) {
+
+		cfg, err := load(nil)
+		require.NoError(t, err)
+
+		require.Equal(t, DefaultConfig.Head.ResultTTL, cfg.Head.ResultTTL)
+		require.Equal(t, DefaultConfig.Head.ResultLimit, cfg.Head.ResultLimit)
+		require.Equal(t, DefaultConfig.Head.BatchParallelism, cfg.Head.BatchParallelism)
+		require.Equal(t, DefaultConfig.Head.ReputationHalfLife, cfg.Head.ReputationHalfLife)
+		require.Equal(t, DefaultConfig.Head.MinimumReputation, cfg.Head.MinimumReputation)
+		require.Equal(t, DefaultConfig.Head.PeerExpiry, cfg.Head.PeerExpiry)
+		require.Equal(t, DefaultConfig.Worker.AttributesGateway, cfg.Worker.AttributesGateway)
+		require.Equal(t, DefaultConfig.Worker.AttributesRefresh, cfg.Worker.AttributesRefresh)
+	})
+	t.Run("zero values override defaults", func(t *testing.T) {
+
+		cfgMap := map[string]any{
+			"head": map[string]any{
+				"result-ttl": "0s",
+			},
+		}
+
+		filepath := writeConfigFile(t, cfgMap)
+
+		args, err := shlex.Split("--result-limit 0 --reputation-half-life 0s --minimum-reputation 0 --peer-expiry 0s --attributes-refresh 0s")
+		require.NoError(t, err)
+
+		args = append(args, "--config", fmt.Sprintf("%v", filepath))
+
+		cfg, err := load(args)
+		require.NoError(t, err)
+
+		require.Zero(t, cfg.Head.ResultTTL)
+		require.Zero(t, cfg.Head.ResultLimit)
+		require.Zero(t, cfg.Head.ReputationHalfLife)
+		require.Zero(t, cfg.Head.MinimumReputation)
+		require.Zero(t, cfg.Head.PeerExpiry)
+		require.Zero(t, cfg.Worker.AttributesRefresh)
+
+		// Options not set explicitly keep their defaults.
+		require.Equal(t, DefaultConfig.Head.BatchParallelism, cfg.Head.BatchParallelism)
+	})
+}
+
 func writeConfigFile(t *testing.T, m map[string]any) string {
 	t.Helper()
 
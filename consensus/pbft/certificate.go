@@ -0,0 +1,196 @@
+package pbft
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blessnetwork/b7s/models/execute"
+)
+
+// ResultCertificate proves that enough replicas of a PBFT cluster produced the same execution result.
+// It bundles the results signed by the replicas, so the result can be trusted without trusting the head node.
+type ResultCertificate execute.ResultCertificate
+
+// NewResultCertificate creates a certificate from the results of a PBFT cluster. Only results signed by replicas
+// from the replica set, with a receipt the replica issued for this request, and with the same output and request
+// timestamp, are certified. If there are fewer than `MinClusterResults` matching results, it returns nil.
+func NewResultCertificate(requestID string, replicas []peer.ID, results execute.ResultMap) *ResultCertificate {
+
+	var signed []execute.NodeResult
+	for _, res := range results {
+
+		if res.Signature == "" || res.PBFT.Replica == "" || !slices.Contains(replicas, res.PBFT.Replica) {
+			continue
+		}
+
+		// Request ID is only part of the signed result via the receipt, so results without one cannot be certified.
+		if res.Receipt == nil || res.Receipt.RequestID != requestID || res.Receipt.Worker != res.PBFT.Replica {
+			continue
+		}
+
+		signed = append(signed, res)
+	}
+
+	slices.SortFunc(signed, func(a, b execute.NodeResult) int {
+		return strings.Compare(a.PBFT.Replica.String(), b.PBFT.Replica.String())
+	})
+
+	// Group results by output. Groups are kept in the order of their first replica, so ties are resolved deterministically.
+	type certifiedKey struct {
+		output    execute.RuntimeOutput
+		timestamp int64
+	}
+
+	var (
+		keys   []certifiedKey
+		groups = make(map[certifiedKey][]execute.NodeResult)
+	)
+	for _, res := range signed {
+
+		key := certifiedKey{
+			output:    res.Result.Result,
+			timestamp: res.PBFT.RequestTimestamp.UnixNano(),
+		}
+
+		_, ok := groups[key]
+		if !ok {
+			keys = append(keys, key)
+		}
+
+		groups[key] = append(groups[key], res)
+	}
+
+	var matching []execute.NodeResult
+	for _, key := range keys {
+		if len(groups[key]) > len(matching) {
+			matching = groups[key]
+		}
+	}
+
+	if uint(len(matching)) < MinClusterResults(uint(len(replicas))) {
+		return nil
+	}
+
+	cert := ResultCertificate{
+		RequestID:        requestID,
+		RequestTimestamp: matching[0].PBFT.RequestTimestamp,
+		Replicas:         replicas,
+		Result:           matching[0].Result.Result,
+		Results:          matching,
+	}
+
+	for _, res := range matching {
+		cert.View = max(cert.View, res.PBFT.View)
+	}
+
+	return &cert
+}
+
+// Verify checks that the certificate holds enough matching results, signed by distinct replicas from the given cluster.
+func (c ResultCertificate) Verify(peers []peer.ID) error {
+
+	if len(peers) == 0 {
+		return errors.New("cluster peers are required")
+	}
+
+	if len(c.Replicas) != len(peers) {
+		return fmt.Errorf("replica set does not match the cluster (have: %d, want: %d)", len(c.Replicas), len(peers))
+	}
+
+	for _, replica := range c.Replicas {
+		if !slices.Contains(peers, replica) {
+			return fmt.Errorf("replica is not part of the cluster (replica: %s)", replica)
+		}
+	}
+
+	var (
+		view uint
+		seen = make(map[peer.ID]struct{})
+	)
+	for _, res := range c.Results {
+
+		replica := res.PBFT.Replica
+
+		if !slices.Contains(peers, replica) {
+			return fmt.Errorf("result signed by a peer outside of the cluster (peer: %s)", replica)
+		}
+
+		_, ok := seen[replica]
+		if ok {
+			return fmt.Errorf("duplicate result from replica (replica: %s)", replica)
+		}
+		seen[replica] = struct{}{}
+
+		err := verifyCertifiedResult(c, res)
+		if err != nil {
+			return fmt.Errorf("invalid result from replica (replica: %s): %w", replica, err)
+		}
+
+		view = max(view, res.PBFT.View)
+	}
+
+	if view != c.View {
+		return fmt.Errorf("view mismatch (have: %d, want: %d)", c.View, view)
+	}
+
+	need := MinClusterResults(uint(len(peers)))
+	if uint(len(seen)) < need {
+		return fmt.Errorf("not enough matching results (have: %d, want: %d)", len(seen), need)
+	}
+
+	return nil
+}
+
+func verifyCertifiedResult(c ResultCertificate, res execute.NodeResult) error {
+
+	pub, err := res.PBFT.Replica.ExtractPublicKey()
+	if err != nil {
+		return fmt.Errorf("could not derive public key from replica ID: %w", err)
+	}
+
+	err = res.VerifySignature(pub)
+	if err != nil {
+		return fmt.Errorf("could not verify signature: %w", err)
+	}
+
+	if !res.PBFT.RequestTimestamp.Equal(c.RequestTimestamp) {
+		return errors.New("request timestamp mismatch")
+	}
+
+	if res.Result.Result != c.Result {
+		return errors.New("result mismatch")
+	}
+
+	// Request ID is only part of the signed result via the receipt.
+	if res.Receipt == nil {
+		return errors.New("result has no receipt")
+	}
+
+	if res.Receipt.RequestID != c.RequestID {
+		return fmt.Errorf("request ID mismatch (have: %s, want: %s)", res.Receipt.RequestID, c.RequestID)
+	}
+
+	if res.Receipt.Worker != res.PBFT.Replica {
+		return errors.New("receipt issued by a different peer")
+	}
+
+	err = res.Receipt.VerifySignature()
+	if err != nil {
+		return fmt.Errorf("could not verify receipt signature: %w", err)
+	}
+
+	output, err := execute.OutputHash(c.Result)
+	if err != nil {
+		return fmt.Errorf("could not hash result: %w", err)
+	}
+
+	if res.Receipt.OutputHash != output {
+		return errors.New("receipt output mismatch")
+	}
+
+	return nil
+}
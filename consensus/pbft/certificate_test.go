@@ -0,0 +1,252 @@
+package pbft
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestResultCertificate(t *testing.T) {
+
+	const clusterSize = 4
+
+	var (
+		requestID = mocks.GenericUUID.String()
+		req       = mocks.GenericExecutionRequest
+		timestamp = time.Now().UTC()
+		view      = uint(3)
+
+		result = execute.Result{
+			Code: codes.OK,
+			Result: execute.RuntimeOutput{
+				Stdout: "generic-execution-result",
+			},
+		}
+
+		keys  = make([]crypto.PrivKey, 0, clusterSize)
+		peers = make([]peer.ID, 0, clusterSize)
+	)
+
+	for i := 0; i < clusterSize; i++ {
+		key, _, err := crypto.GenerateEd25519Key(nil)
+		require.NoError(t, err)
+
+		id, err := peer.IDFromPrivateKey(key)
+		require.NoError(t, err)
+
+		keys = append(keys, key)
+		peers = append(peers, id)
+	}
+
+	// Create a result with the given output as the replica would, signed with its key.
+	signedOutput := func(t *testing.T, replica int, result execute.Result) execute.NodeResult {
+		t.Helper()
+
+		receipt, err := execute.NewReceipt(requestID, req, peers[replica], result)
+		require.NoError(t, err)
+		require.NoError(t, receipt.Sign(keys[replica]))
+
+		res := execute.NodeResult{
+			Result: result,
+			PBFT: execute.PBFTResultInfo{
+				View:             view,
+				RequestTimestamp: timestamp,
+				Replica:          peers[replica],
+			},
+			Receipt: &receipt,
+		}
+		require.NoError(t, res.Sign(keys[replica]))
+
+		return res
+	}
+
+	// Create a result as the replica would, signed with its key.
+	signedResult := func(t *testing.T, replica int) execute.NodeResult {
+		t.Helper()
+		return signedOutput(t, replica, result)
+	}
+
+	// Create a certificate with results from the given replicas.
+	newCertificate := func(t *testing.T, replicas ...int) *ResultCertificate {
+		t.Helper()
+
+		results := make(execute.ResultMap)
+		for _, replica := range replicas {
+			results[peers[replica]] = signedResult(t, replica)
+		}
+
+		cert := NewResultCertificate(requestID, peers, results)
+		require.NotNil(t, cert)
+
+		return cert
+	}
+
+	t.Run("nominal case", func(t *testing.T) {
+
+		cert := newCertificate(t, 2, 0)
+
+		require.Equal(t, requestID, cert.RequestID)
+		require.Equal(t, view, cert.View)
+		require.True(t, timestamp.Equal(cert.RequestTimestamp))
+		require.Equal(t, result.Result, cert.Result)
+		require.Len(t, cert.Results, 2)
+		require.Less(t, cert.Results[0].PBFT.Replica.String(), cert.Results[1].PBFT.Replica.String())
+
+		require.NoError(t, cert.Verify(peers))
+	})
+	t.Run("certificate survives serialization", func(t *testing.T) {
+
+		cert := newCertificate(t, 0, 1, 3)
+
+		payload, err := json.Marshal(cert)
+		require.NoError(t, err)
+
+		var decoded ResultCertificate
+		require.NoError(t, json.Unmarshal(payload, &decoded))
+
+		require.NoError(t, decoded.Verify(peers))
+	})
+	t.Run("results not signed by PBFT replicas", func(t *testing.T) {
+
+		results := execute.ResultMap{
+			peers[0]: {Result: result},
+		}
+
+		require.Nil(t, NewResultCertificate(requestID, peers, results))
+	})
+	t.Run("only matching results are certified", func(t *testing.T) {
+
+		other := result
+		other.Result.Stdout = "dummy-result"
+
+		results := execute.ResultMap{
+			peers[0]: signedOutput(t, 0, other),
+			peers[1]: signedResult(t, 1),
+			peers[2]: signedResult(t, 2),
+		}
+
+		cert := NewResultCertificate(requestID, peers, results)
+		require.NotNil(t, cert)
+
+		require.Equal(t, result.Result, cert.Result)
+		require.Len(t, cert.Results, 2)
+		require.NoError(t, cert.Verify(peers))
+	})
+	t.Run("results without a receipt for the request are not certified", func(t *testing.T) {
+
+		// Replica sent no receipt.
+		missing := signedResult(t, 0)
+		missing.Receipt = nil
+		require.NoError(t, missing.Sign(keys[0]))
+
+		// Replica sent a receipt for a different request.
+		receipt, err := execute.NewReceipt("other-request", req, peers[1], result)
+		require.NoError(t, err)
+		require.NoError(t, receipt.Sign(keys[1]))
+
+		other := signedResult(t, 1)
+		other.Receipt = &receipt
+		require.NoError(t, other.Sign(keys[1]))
+
+		results := execute.ResultMap{
+			peers[0]: missing,
+			peers[1]: other,
+			peers[2]: signedResult(t, 2),
+		}
+		require.Nil(t, NewResultCertificate(requestID, peers, results))
+
+		results[peers[3]] = signedResult(t, 3)
+
+		cert := NewResultCertificate(requestID, peers, results)
+		require.NotNil(t, cert)
+
+		require.Len(t, cert.Results, 2)
+		for _, res := range cert.Results {
+			require.NotNil(t, res.Receipt)
+			require.Equal(t, requestID, res.Receipt.RequestID)
+		}
+		require.NoError(t, cert.Verify(peers))
+	})
+	t.Run("not enough matching results", func(t *testing.T) {
+
+		other := result
+		other.Result.Stdout = "dummy-result"
+
+		results := execute.ResultMap{
+			peers[0]: signedOutput(t, 0, other),
+			peers[1]: signedResult(t, 1),
+		}
+		require.Nil(t, NewResultCertificate(requestID, peers, results))
+
+		cert := newCertificate(t, 0, 1)
+		cert.Results = cert.Results[:1]
+		require.Error(t, cert.Verify(peers))
+	})
+	t.Run("duplicate results", func(t *testing.T) {
+
+		cert := newCertificate(t, 0, 1)
+		cert.Results[1] = cert.Results[0]
+
+		require.Error(t, cert.Verify(peers))
+	})
+	t.Run("tampered receipt", func(t *testing.T) {
+
+		cert := newCertificate(t, 0, 1)
+
+		// Result signature covers only the receipt signature, so the receipt must be verified on its own.
+		receipt := *cert.Results[0].Receipt
+		receipt.Usage.WallClockTime = time.Hour
+		cert.Results[0].Receipt = &receipt
+
+		require.Error(t, cert.Verify(peers))
+	})
+	t.Run("different cluster", func(t *testing.T) {
+
+		cert := newCertificate(t, 0, 1)
+
+		// Verifier expects a different replica set.
+		other := append([]peer.ID{mocks.GenericPeerID}, peers[1:]...)
+		require.Error(t, cert.Verify(other))
+
+		// Certificate claims a smaller cluster, requiring fewer matching results.
+		cert.Replicas = peers[:1]
+		require.Error(t, cert.Verify(peers))
+	})
+	t.Run("tampered result", func(t *testing.T) {
+
+		cert := newCertificate(t, 0, 1)
+		cert.Results[0].Result.Result.Stdout = "dummy-result"
+		cert.Result = cert.Results[0].Result.Result
+
+		require.Error(t, cert.Verify(peers))
+	})
+	t.Run("certified result does not match", func(t *testing.T) {
+
+		cert := newCertificate(t, 0, 1)
+		cert.Result.Stdout = "dummy-result"
+
+		require.Error(t, cert.Verify(peers))
+	})
+	t.Run("certificate for a different request", func(t *testing.T) {
+
+		cert := newCertificate(t, 0, 1)
+		cert.RequestID = "dummy-request-id"
+
+		require.Error(t, cert.Verify(peers))
+	})
+	t.Run("view mismatch", func(t *testing.T) {
+
+		cert := newCertificate(t, 0, 1)
+		cert.View++
+
+		require.Error(t, cert.Verify(peers))
+	})
+}
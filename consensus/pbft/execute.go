@@ -105,6 +105,13 @@ func (r *Replica) execute(ctx context.Context, view uint, sequence uint, digest
 		},
 	}
 
+	// Sign the resource usage so the head node can record it in its usage ledger, and the receipt so the result can be audited.
+	// Result is sent without the usage entry or the receipt if they cannot be signed.
+	nres.UsageEntry, nres.Receipt, err = execute.SignExecution(r.host.PrivateKey(), request.ID, request.Execute, res)
+	if err != nil {
+		log.Error().Err(err).Msg("could not sign execution")
+	}
+
 	err = nres.Sign(r.host.PrivateKey())
 	if err != nil {
 		return fmt.Errorf("could not sign execution result: %w", err)
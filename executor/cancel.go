@@ -0,0 +1,47 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blessnetwork/b7s/models/bls"
+)
+
+// Cancel aborts the execution of the given request. The runtime process is killed, along with any processes it started.
+func (e *Executor) Cancel(requestID string) error {
+
+	e.lock.Lock()
+	cancel, ok := e.running[requestID]
+	e.lock.Unlock()
+
+	if !ok {
+		return fmt.Errorf("no execution in progress (request: %s): %w", requestID, bls.ErrNotFound)
+	}
+
+	e.log.Info().Str("request", requestID).Msg("cancelling execution")
+
+	cancel(bls.ErrExecutionCancelled)
+
+	return nil
+}
+
+// track registers the execution as in progress, returning a context that is cancelled if the execution is cancelled.
+// Returned function should be called once the execution is done.
+func (e *Executor) track(requestID string) (context.Context, func()) {
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+
+	e.lock.Lock()
+	e.running[requestID] = cancel
+	e.lock.Unlock()
+
+	done := func() {
+		e.lock.Lock()
+		delete(e.running, requestID)
+		e.lock.Unlock()
+
+		cancel(nil)
+	}
+
+	return ctx, done
+}
@@ -0,0 +1,71 @@
+//go:build !windows
+// +build !windows
+
+package executor_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/executor"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestExecutor_Cancel(t *testing.T) {
+
+	const (
+		requestID = "dummy-request-id"
+
+		// Runtime that never finishes on its own, and starts a child process too.
+		runtime = "#!/bin/sh\nsleep 60 &\nsleep 60\n"
+	)
+
+	runtimeDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(runtimeDir, bls.RuntimeCLI()), []byte(runtime), 0o755)
+	require.NoError(t, err)
+
+	exec, err := executor.New(mocks.NoopLogger,
+		executor.WithRuntimeDir(runtimeDir),
+		executor.WithWorkDir(t.TempDir()),
+	)
+	require.NoError(t, err)
+
+	// Nothing to cancel yet.
+	require.ErrorIs(t, exec.Cancel(requestID), bls.ErrNotFound)
+
+	type result struct {
+		res execute.Result
+		err error
+	}
+
+	done := make(chan result, 1)
+	go func() {
+		res, err := exec.ExecuteFunction(context.Background(), requestID, mocks.GenericExecutionRequest)
+		done <- result{res: res, err: err}
+	}()
+
+	// Wait for the execution to start.
+	require.Eventually(t, func() bool {
+		return exec.Cancel(requestID) == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	select {
+	case out := <-done:
+		require.ErrorIs(t, out.err, bls.ErrExecutionCancelled)
+		require.Equal(t, codes.Cancelled, out.res.Code)
+
+	case <-time.After(10 * time.Second):
+		t.Fatal("execution was not cancelled")
+	}
+
+	// Execution is done so there's nothing to cancel anymore.
+	require.ErrorIs(t, exec.Cancel(requestID), bls.ErrNotFound)
+}
@@ -1,6 +1,7 @@
 package executor
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -12,7 +13,8 @@ import (
 )
 
 // createCmd will create the command to be executed, prepare working directory, environment, standard input and all else.
-func (e *Executor) createCmd(paths requestPaths, req execute.Request) *exec.Cmd {
+// The process is killed if the context is cancelled before the command completes.
+func (e *Executor) createCmd(ctx context.Context, paths requestPaths, req execute.Request) *exec.Cmd {
 
 	// Prepare command to be executed.
 	exePath := filepath.Join(e.cfg.RuntimeDir, e.cfg.ExecutableName)
@@ -41,8 +43,9 @@ func (e *Executor) createCmd(paths requestPaths, req execute.Request) *exec.Cmd
 		}
 	}
 
-	cmd := exec.Command(exePath, args...)
+	cmd := exec.CommandContext(ctx, exePath, args...)
 	cmd.Dir = paths.workdir
+	killOnCancel(cmd)
 
 	// Setup stdin of the command.
 	var stdin io.Reader
@@ -2,12 +2,14 @@ package executor
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/armon/go-metrics"
 	"go.opentelemetry.io/otel/trace"
 
+	"github.com/blessnetwork/b7s/models/bls"
 	"github.com/blessnetwork/b7s/models/codes"
 	"github.com/blessnetwork/b7s/models/execute"
 	"github.com/blessnetwork/b7s/telemetry/tracing"
@@ -41,6 +43,16 @@ func (e *Executor) ExecuteFunction(ctx context.Context, requestID string, req ex
 
 	// Execute the function.
 	out, usage, err := e.executeFunction(requestID, req)
+	if errors.Is(err, bls.ErrExecutionCancelled) {
+
+		res := execute.Result{
+			Code:   codes.Cancelled,
+			Result: out,
+			Usage:  usage,
+		}
+
+		return res, err
+	}
 	if err != nil {
 
 		res := execute.Result{
@@ -86,12 +98,20 @@ func (e *Executor) executeFunction(requestID string, req execute.Request) (execu
 
 	log.Debug().Str("dir", paths.workdir).Msg("working directory for the request")
 
+	// Track the execution so it can be cancelled.
+	ctx, done := e.track(requestID)
+	defer done()
+
 	// Create command that will be executed.
-	cmd := e.createCmd(paths, req)
+	cmd := e.createCmd(ctx, paths, req)
 
 	log.Debug().Int("env_vars_set", len(cmd.Env)).Str("cmd", cmd.String()).Msg("command ready for execution")
 
 	out, usage, err := e.executeCommand(cmd)
+	if err != nil && errors.Is(context.Cause(ctx), bls.ErrExecutionCancelled) {
+		log.Info().Msg("command execution cancelled")
+		return out, execute.Usage{}, bls.ErrExecutionCancelled
+	}
 	if err != nil {
 		return out, execute.Usage{}, fmt.Errorf("command execution failed: %w", err)
 	}
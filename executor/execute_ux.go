@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"fmt"
 	"os/exec"
+	"syscall"
 	"time"
 
 	"github.com/blessnetwork/b7s/executor/internal/process"
@@ -63,3 +64,17 @@ func (e *Executor) executeCommand(cmd *exec.Cmd) (execute.RuntimeOutput, execute
 
 	return out, usage, nil
 }
+
+// killOnCancel makes the runtime process the leader of a new process group. When the execution is cancelled, the whole
+// process group is killed, taking down any processes the runtime started. These are also the members of the resource limit cgroup
+// that belong to this execution.
+func killOnCancel(cmd *exec.Cmd) {
+
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Setpgid: true,
+	}
+
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+}
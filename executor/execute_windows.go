@@ -107,3 +107,6 @@ func (e *Executor) executeCommand(cmd *exec.Cmd) (execute.RuntimeOutput, execute
 
 	return out, usage, nil
 }
+
+// killOnCancel on Windows relies on the default behavior of killing the runtime process when the execution is cancelled.
+func killOnCancel(cmd *exec.Cmd) {}
@@ -2,9 +2,11 @@ package executor
 
 import (
 	"cmp"
+	"context"
 	"errors"
 	"fmt"
 	"path/filepath"
+	"sync"
 
 	"github.com/armon/go-metrics"
 	"github.com/rs/zerolog"
@@ -18,6 +20,9 @@ type Executor struct {
 	cfg     Config
 	tracer  *tracing.Tracer
 	metrics *metrics.Metrics
+
+	lock    sync.Mutex
+	running map[string]context.CancelCauseFunc // running maps request IDs to the cancel functions of executions in progress.
 }
 
 // New creates a new Executor with the specified working directory.
@@ -62,6 +67,7 @@ func New(log zerolog.Logger, options ...Option) (*Executor, error) {
 		cfg:     cfg,
 		tracer:  tracing.NewTracer(tracerName),
 		metrics: cmp.Or(cfg.Metrics, metrics.Default()),
+		running: make(map[string]context.CancelCauseFunc),
 	}
 
 	return &e, nil
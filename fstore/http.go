@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -72,6 +73,9 @@ func (f *FStore) download(ctx context.Context, cid string, manifest bls.Function
 
 	// Wait until the download is complete.
 	err = res.Err()
+	if errors.Is(err, grab.ErrBadChecksum) {
+		return "", fmt.Errorf("could not download function: %w: %w", bls.ErrChecksumMismatch, err)
+	}
 	if err != nil {
 		return "", fmt.Errorf("could not download function: %w", err)
 	}
@@ -217,6 +217,28 @@ func TestFunction_DownloadHandlesErrors(t *testing.T) {
 		_, err = fh.download(ctx, "", manifest)
 		require.Error(t, err)
 	})
+	t.Run("handles checksum mismatch", func(t *testing.T) {
+
+		workdir, err := os.MkdirTemp("", "b7s-function-download-")
+		require.NoError(t, err)
+
+		defer os.RemoveAll(workdir)
+
+		fh := New(mocks.NoopLogger, newInMemoryStore(t), workdir)
+
+		address := fmt.Sprintf("%s/test-file", srv.URL)
+		hash := sha256.Sum256(append(payload, 0))
+
+		manifest := bls.FunctionManifest{
+			Deployment: bls.Deployment{
+				URI:      address,
+				Checksum: fmt.Sprintf("%x", hash),
+			},
+		}
+
+		_, err = fh.download(ctx, "", manifest)
+		require.ErrorIs(t, err, bls.ErrChecksumMismatch)
+	})
 	t.Run("handles invalid URI", func(t *testing.T) {
 
 		workdir, err := os.MkdirTemp("", "b7s-function-download-")
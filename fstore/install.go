@@ -14,6 +14,7 @@ import (
 )
 
 // Install will download and install function identified by the manifest/CID.
+// Installing a function that was previously uninstalled removes its tombstone.
 func (f *FStore) Install(ctx context.Context, address string, cid string) (retErr error) {
 
 	defer f.metrics.MeasureSince(functionsInstallTimeMetric, time.Now())
@@ -85,6 +86,14 @@ func (f *FStore) Install(ctx context.Context, address string, cid string) (retEr
 			Msg("could not save function record")
 	}
 
+	err = f.store.RemoveFunctionTombstone(ctx, cid)
+	if err != nil && !errors.Is(err, bls.ErrNotFound) {
+		f.log.Error().
+			Err(err).
+			Str("cid", cid).
+			Msg("could not remove function tombstone")
+	}
+
 	f.log.Debug().
 		Str("cid", cid).
 		Str("address", address).
@@ -117,3 +126,14 @@ func (f *FStore) IsInstalled(cid string) (bool, error) {
 	// We have the function in the database and all files - we're good.
 	return true, nil
 }
+
+// InstalledFunctions returns the records of functions installed on the node.
+func (f *FStore) InstalledFunctions(ctx context.Context) ([]bls.FunctionRecord, error) {
+
+	functions, err := f.store.RetrieveFunctions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve functions: %w", err)
+	}
+
+	return functions, nil
+}
@@ -18,6 +18,7 @@ const (
 	spanInstall     = "FunctionInstall"
 	spanIsInstalled = "IsFunctionInstalled"
 	spanSync        = "FunctionSync"
+	spanUninstall   = "FunctionUninstall"
 )
 
 var (
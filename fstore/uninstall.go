@@ -0,0 +1,100 @@
+package fstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/telemetry/b7ssemconv"
+)
+
+// Uninstall removes the function identified by the CID - its record, archive and unpacked files.
+// A tombstone is recorded for the function, even if it was not installed, so it is not installed again on roll call.
+// If the function is not installed, the returned error wraps `bls.ErrNotFound`.
+func (f *FStore) Uninstall(ctx context.Context, cid string) error {
+
+	ctx, span := f.tracer.Start(ctx, spanUninstall, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(b7ssemconv.FunctionCID.String(cid)))
+	defer span.End()
+
+	// CID is used as the name of the function directory - make sure we don't remove anything outside of it.
+	if cid == "" || cid == "." || cid == ".." || filepath.Base(cid) != cid {
+		return fmt.Errorf("invalid function CID: %s", cid)
+	}
+
+	err := f.store.SaveFunctionTombstone(ctx, bls.FunctionTombstone{CID: cid, RemovedAt: time.Now().UTC()})
+	if err != nil {
+		return fmt.Errorf("could not save function tombstone: %w", err)
+	}
+
+	// Read the function directly from storage - no need to update the timestamp of a function we're removing.
+	fn, err := f.store.RetrieveFunction(ctx, cid)
+	if err != nil {
+		return fmt.Errorf("could not retrieve function record: %w", err)
+	}
+
+	err = f.store.RemoveFunction(ctx, cid)
+	if err != nil {
+		return fmt.Errorf("could not remove function record: %w", err)
+	}
+
+	// Archive and files are normally both found in the function directory, but remove them explicitly in case they were moved.
+	var errs []error
+	for _, p := range []string{fn.Archive, fn.Files, cid} {
+
+		if p == "" {
+			continue
+		}
+
+		path := filepath.Join(f.workdir, p)
+		if !f.inWorkdir(path) {
+			f.log.Warn().Str("cid", cid).Str("path", path).Msg("skipping removal of function files outside of the workdir")
+			continue
+		}
+
+		err = os.RemoveAll(path)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("could not remove function files (path: %s): %w", path, err))
+		}
+	}
+
+	err = errors.Join(errs...)
+	if err != nil {
+		return err
+	}
+
+	f.log.Debug().Str("cid", cid).Msg("uninstalled function")
+
+	return nil
+}
+
+// IsRetired checks if the function with the given CID was uninstalled. Retired functions are installed again only on explicit request.
+func (f *FStore) IsRetired(cid string) (bool, error) {
+
+	_, err := f.store.RetrieveFunctionTombstone(context.Background(), cid)
+	if err != nil && errors.Is(err, bls.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("could not get function tombstone from store: %w", err)
+	}
+
+	return true, nil
+}
+
+// inWorkdir returns true if the path is found inside the workdir (and is not the workdir itself).
+func (f *FStore) inWorkdir(path string) bool {
+
+	rel, err := filepath.Rel(f.workdir, path)
+	if err != nil {
+		return false
+	}
+
+	return rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,79 @@
+package fstore_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/fstore"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestFunction_Uninstall(t *testing.T) {
+
+	const (
+		manifestURL = "manifest.json"
+		functionURL = "function.tar.gz"
+		testFile    = "testdata/testFunction.tar.gz"
+
+		testCID = "dummy-cid"
+	)
+	ctx := context.Background()
+
+	workdir := t.TempDir()
+
+	functionPayload, err := os.ReadFile(testFile)
+	require.NoError(t, err)
+
+	msrv, fsrv := createServers(t, manifestURL, functionURL, functionPayload)
+	defer fsrv.Close()
+	defer msrv.Close()
+
+	fh := fstore.New(mocks.NoopLogger, newInMemoryStore(t), workdir)
+
+	err = fh.Install(ctx, fmt.Sprintf("%s/%v", msrv.URL, manifestURL), testCID)
+	require.NoError(t, err)
+	require.DirExists(t, filepath.Join(workdir, testCID))
+
+	t.Run("function uninstall works", func(t *testing.T) {
+
+		err = fh.Uninstall(ctx, testCID)
+		require.NoError(t, err)
+
+		installed, err := fh.IsInstalled(testCID)
+		require.NoError(t, err)
+		require.False(t, installed)
+
+		require.NoDirExists(t, filepath.Join(workdir, testCID))
+		require.DirExists(t, workdir)
+
+		retired, err := fh.IsRetired(testCID)
+		require.NoError(t, err)
+		require.True(t, retired)
+	})
+	t.Run("function not installed", func(t *testing.T) {
+		err = fh.Uninstall(ctx, testCID)
+		require.ErrorIs(t, err, bls.ErrNotFound)
+	})
+	t.Run("invalid CID", func(t *testing.T) {
+		for _, cid := range []string{"", ".", "..", "../other", "dir/cid"} {
+			err = fh.Uninstall(ctx, cid)
+			require.Error(t, err)
+		}
+		require.DirExists(t, workdir)
+	})
+	t.Run("reinstalled function is no longer retired", func(t *testing.T) {
+
+		err = fh.Install(ctx, fmt.Sprintf("%s/%v", msrv.URL, manifestURL), testCID)
+		require.NoError(t, err)
+
+		retired, err := fh.IsRetired(testCID)
+		require.NoError(t, err)
+		require.False(t, retired)
+	})
+}
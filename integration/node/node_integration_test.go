@@ -76,32 +76,36 @@ func instantiateNode(t *testing.T, nodeDir string, role bls.NodeRole) *nodeScaff
 
 	core := node.NewCore(logger, host)
 
+	var (
+		dbDir      = filepath.Join(dir, "db")
+		workspace  = filepath.Join(dir, "workspace")
+		runtimeDir = os.Getenv(runtimeDirEnv)
+	)
+
+	// Open a DB - both node types need it.
+	db, err := pebble.Open(dbDir, &pebble.Options{})
+	require.NoError(t, err)
+	store := store.New(db, codec.NewJSONCodec())
+
 	// If we're creating a head node - we have everything we need.
 	if role == bls.HeadNode {
 
-		headNode, err := head.New(core)
+		headNode, err := head.New(core, store)
 		require.NoError(t, err)
 
 		return &nodeScaffolding{
 			dir:     dir,
+			db:      db,
 			logFile: logFile,
 			host:    host,
 			node:    headNode,
 		}
 	}
 
-	var (
-		dbDir      = filepath.Join(dir, "db")
-		workspace  = filepath.Join(dir, "workspace")
-		runtimeDir = os.Getenv(runtimeDirEnv)
-	)
-
 	// We're creating a worker node.
 
-	// Open a DB and initialize an fstore.
-	db, err := pebble.Open(dbDir, &pebble.Options{})
-	require.NoError(t, err)
-	fstore := fstore.New(logger, store.New(db, codec.NewJSONCodec()), workspace)
+	// Initialize an fstore.
+	fstore := fstore.New(logger, store, workspace)
 
 	executor, err := executor.New(logger, executor.WithRuntimeDir(runtimeDir), executor.WithWorkDir(workspace))
 	require.NoError(t, err)
@@ -83,6 +83,7 @@ This is the end of my program
 			}
 		}
 
+		head.db.Close()
 		head.logFile.Close()
 		if !cleanupDisabled {
 			os.RemoveAll(head.dir)
@@ -0,0 +1,158 @@
+package bls
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// HTTP headers used to authenticate REST API requests.
+const (
+	APIHeaderKey       = "X-API-Key"
+	APIHeaderClientID  = "X-B7S-Client-ID"
+	APIHeaderTimestamp = "X-B7S-Timestamp" // Unix timestamp (seconds) of the signed request.
+	APIHeaderSignature = "X-B7S-Signature" // Hex-encoded signature of the request, made using the client's key.
+)
+
+// APIClient describes a client allowed to use the head node REST API.
+// Clients authenticate either using an API key, or by signing their requests.
+type APIClient struct {
+	ID string `json:"id"`
+	// Hex-encoded SHA-256 hash of the API key. The key itself is never stored.
+	KeyHash string `json:"key_hash,omitempty"`
+	// Key used to verify request signatures. It can be a peer ID, or a base64-encoded libp2p or raw ed25519 public key.
+	PublicKey string `json:"public_key,omitempty"`
+	// Admin clients can access the usage of all clients. Other clients can only access their own.
+	Admin bool `json:"admin,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (c APIClient) Valid() error {
+
+	if c.ID == "" {
+		return errors.New("client ID is required")
+	}
+
+	if c.KeyHash == "" && c.PublicKey == "" {
+		return errors.New("client has neither an API key nor a public key")
+	}
+
+	if c.KeyHash != "" {
+		hash, err := hex.DecodeString(c.KeyHash)
+		if err != nil || len(hash) != sha256.Size {
+			return errors.New("invalid API key hash")
+		}
+	}
+
+	if c.PublicKey != "" {
+		_, err := ParseClientKey(c.PublicKey)
+		if err != nil {
+			return fmt.Errorf("invalid public key: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// MatchesKey returns true if the API key belongs to the client.
+func (c APIClient) MatchesKey(key string) bool {
+
+	if c.KeyHash == "" || key == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(c.KeyHash), []byte(HashAPIKey(key))) == 1
+}
+
+// VerifyRequestSignature verifies that the REST API request was signed by the client.
+func (c APIClient) VerifyRequestSignature(method string, path string, timestamp string, body []byte, signature string) error {
+
+	if c.PublicKey == "" {
+		return errors.New("client has no public key")
+	}
+
+	key, err := ParseClientKey(c.PublicKey)
+	if err != nil {
+		return fmt.Errorf("could not parse client key: %w", err)
+	}
+
+	sig, err := hex.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("could not decode signature from hex: %w", err)
+	}
+
+	ok, err := key.Verify(APIRequestPayload(method, path, timestamp, body), sig)
+	if err != nil {
+		return fmt.Errorf("could not verify signature: %w", err)
+	}
+
+	if !ok {
+		return errors.New("invalid signature")
+	}
+
+	return nil
+}
+
+// HashAPIKey returns the hex-encoded SHA-256 hash of the API key.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// APIRequestPayload returns the payload clients sign to authenticate a REST API request.
+func APIRequestPayload(method string, path string, timestamp string, body []byte) []byte {
+
+	payload := fmt.Appendf(nil, "%s\n%s\n%s\n", method, path, timestamp)
+	return append(payload, body...)
+}
+
+// SignAPIRequest signs the REST API request using the given key.
+func SignAPIRequest(key crypto.PrivKey, method string, path string, timestamp string, body []byte) (string, error) {
+
+	sig, err := key.Sign(APIRequestPayload(method, path, timestamp, body))
+	if err != nil {
+		return "", fmt.Errorf("could not sign request: %w", err)
+	}
+
+	return hex.EncodeToString(sig), nil
+}
+
+// ParseClientKey parses the public key of a REST API client. The key can be a peer ID,
+// or a base64-encoded libp2p public key or raw ed25519 public key.
+func ParseClientKey(key string) (crypto.PubKey, error) {
+
+	id, err := peer.Decode(key)
+	if err == nil {
+		pub, err := id.ExtractPublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("could not extract public key from peer ID: %w", err)
+		}
+
+		return pub, nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode key from base64: %w", err)
+	}
+
+	pub, err := crypto.UnmarshalPublicKey(data)
+	if err == nil {
+		return pub, nil
+	}
+
+	pub, err = crypto.UnmarshalEd25519PublicKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal public key: %w", err)
+	}
+
+	return pub, nil
+}
@@ -0,0 +1,125 @@
+package bls_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/bls"
+)
+
+func TestAPIClient_ParseClientKey(t *testing.T) {
+
+	_, pub, err := crypto.GenerateEd25519Key(nil)
+	require.NoError(t, err)
+
+	id, err := peer.IDFromPublicKey(pub)
+	require.NoError(t, err)
+
+	marshalled, err := crypto.MarshalPublicKey(pub)
+	require.NoError(t, err)
+
+	raw, err := pub.Raw()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		key   string
+		valid bool
+	}{
+		{name: "peer ID", key: id.String(), valid: true},
+		{name: "libp2p public key", key: base64.StdEncoding.EncodeToString(marshalled), valid: true},
+		{name: "raw ed25519 public key", key: base64.StdEncoding.EncodeToString(raw), valid: true},
+		{name: "not base64", key: "not-a-key!"},
+		{name: "wrong key length", key: base64.StdEncoding.EncodeToString(raw[:16])},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key, err := bls.ParseClientKey(test.key)
+			if !test.valid {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, pub.Equals(key))
+		})
+	}
+}
+
+func TestAPIClient_Valid(t *testing.T) {
+
+	_, pub, err := crypto.GenerateEd25519Key(nil)
+	require.NoError(t, err)
+
+	id, err := peer.IDFromPublicKey(pub)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		client bls.APIClient
+		valid  bool
+	}{
+		{name: "API key", client: bls.APIClient{ID: "client", KeyHash: bls.HashAPIKey("key")}, valid: true},
+		{name: "public key", client: bls.APIClient{ID: "client", PublicKey: id.String()}, valid: true},
+		{name: "missing ID", client: bls.APIClient{KeyHash: bls.HashAPIKey("key")}},
+		{name: "no credentials", client: bls.APIClient{ID: "client"}},
+		{name: "invalid key hash", client: bls.APIClient{ID: "client", KeyHash: "abcd"}},
+		{name: "invalid public key", client: bls.APIClient{ID: "client", PublicKey: "not-a-key!"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.client.Valid()
+			if test.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestAPIClient_Authentication(t *testing.T) {
+
+	priv, pub, err := crypto.GenerateEd25519Key(nil)
+	require.NoError(t, err)
+
+	id, err := peer.IDFromPublicKey(pub)
+	require.NoError(t, err)
+
+	client := bls.APIClient{
+		ID:        "client",
+		KeyHash:   bls.HashAPIKey("secret-key"),
+		PublicKey: id.String(),
+	}
+
+	t.Run("API key", func(t *testing.T) {
+		require.True(t, client.MatchesKey("secret-key"))
+		require.False(t, client.MatchesKey("other-key"))
+		require.False(t, client.MatchesKey(""))
+		require.False(t, bls.APIClient{ID: "client"}.MatchesKey(""))
+	})
+	t.Run("request signature", func(t *testing.T) {
+
+		body := []byte(`{"function_id":"dummy"}`)
+
+		sig, err := bls.SignAPIRequest(priv, "POST", "/api/v1/functions/execute", "1700000000", body)
+		require.NoError(t, err)
+
+		err = client.VerifyRequestSignature("POST", "/api/v1/functions/execute", "1700000000", body, sig)
+		require.NoError(t, err)
+
+		// Any change to the request invalidates the signature.
+		err = client.VerifyRequestSignature("POST", "/api/v1/functions/execute", "1700000001", body, sig)
+		require.Error(t, err)
+		err = client.VerifyRequestSignature("POST", "/api/v1/schedules", "1700000000", body, sig)
+		require.Error(t, err)
+		err = client.VerifyRequestSignature("POST", "/api/v1/functions/execute", "1700000000", []byte(`{}`), sig)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,51 @@
+package bls
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+)
+
+// ExecutionEventType describes a notable step in processing of an execution request.
+type ExecutionEventType string
+
+const (
+	ExecutionEventRollCallStarted ExecutionEventType = "roll_call_started" // Roll call was published.
+	ExecutionEventPeerReported    ExecutionEventType = "peer_reported"     // Peer was chosen for the execution after reporting for roll call.
+	ExecutionEventClusterFormed   ExecutionEventType = "cluster_formed"    // Cluster of peers that will execute the request is formed.
+	ExecutionEventWorkOrderSent   ExecutionEventType = "work_order_sent"   // Work order was sent to the cluster.
+	ExecutionEventResultReceived  ExecutionEventType = "result_received"   // Peer reported its execution result.
+	ExecutionEventDone            ExecutionEventType = "done"              // Execution is done.
+)
+
+func (t ExecutionEventType) String() string {
+	return string(t)
+}
+
+// ExecutionEvent describes the progress of an execution request.
+type ExecutionEvent struct {
+	RequestID string             `json:"request_id"`
+	Type      ExecutionEventType `json:"type"`
+	Timestamp time.Time          `json:"timestamp"`
+
+	// Peer the event relates to, if any.
+	Peer peer.ID `json:"peer,omitempty"`
+	// Set of peers the event relates to, if any.
+	Peers []peer.ID `json:"peers,omitempty"`
+	// Execution result reported by the peer.
+	Result *execute.NodeResult `json:"result,omitempty"`
+
+	// Outcome of the execution - set for the final event.
+	Code    codes.Code                `json:"code,omitempty"`
+	Message string                    `json:"message,omitempty"`
+	Results execute.AggregatedResults `json:"results,omitempty"`
+	// Outcome of the aggregation strategy from the request, if aggregation was enabled.
+	Aggregation *execute.Aggregation `json:"aggregation,omitempty"`
+	// Receipts signed by the worker nodes, ordered by worker peer ID.
+	Receipts []execute.Receipt `json:"receipts,omitempty"`
+	// Proof that enough replicas produced the same result, if the request was executed using PBFT.
+	Certificate *execute.ResultCertificate `json:"certificate,omitempty"`
+}
@@ -0,0 +1,27 @@
+package bls
+
+import (
+	"time"
+
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+)
+
+// ExecutionRecord describes the outcome of an execution request, as persisted by the head node.
+type ExecutionRecord struct {
+	RequestID  string                    `json:"request_id"`
+	FunctionID string                    `json:"function_id"`
+	Method     string                    `json:"method"`
+	Client     string                    `json:"client,omitempty"` // REST API client that submitted the request.
+	Code       codes.Code                `json:"code"`
+	Results    execute.ResultMap         `json:"results,omitempty"`
+	Cluster    execute.Cluster           `json:"cluster,omitempty"`
+	Aggregated execute.AggregatedResults `json:"aggregated,omitempty"`
+	// Outcome of the aggregation strategy from the request, if aggregation was enabled.
+	Aggregation *execute.Aggregation `json:"aggregation,omitempty"`
+
+	// Used to communicate the reason for failure to the user.
+	Message string `json:"message,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
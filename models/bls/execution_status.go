@@ -0,0 +1,25 @@
+package bls
+
+// ExecutionPhase describes how far along the head node is with processing an execution request.
+type ExecutionPhase string
+
+const (
+	ExecutionPhaseRollCall      ExecutionPhase = "roll_call"
+	ExecutionPhaseClusterFormed ExecutionPhase = "cluster_formed"
+	ExecutionPhaseExecuting     ExecutionPhase = "executing"
+	ExecutionPhaseDone          ExecutionPhase = "done"
+)
+
+func (p ExecutionPhase) String() string {
+	return string(p)
+}
+
+// ExecutionStatus describes the current state of an execution request.
+type ExecutionStatus struct {
+	RequestID string         `json:"request_id"`
+	Phase     ExecutionPhase `json:"phase"`
+	Client    string         `json:"client,omitempty"` // REST API client that submitted the request.
+
+	// Record is set once the execution is done.
+	Record *ExecutionRecord `json:"record,omitempty"`
+}
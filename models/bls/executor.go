@@ -8,4 +8,5 @@ import (
 
 type Executor interface {
 	ExecuteFunction(ctx context.Context, requestID string, request execute.Request) (execute.Result, error)
+	Cancel(requestID string) error
 }
@@ -0,0 +1,62 @@
+package bls
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blessnetwork/b7s/models/codes"
+)
+
+// FunctionInstallStatus describes the outcome of a function installation on a worker node.
+type FunctionInstallStatus string
+
+const (
+	FunctionInstalled        FunctionInstallStatus = "installed"
+	FunctionInstallFailed    FunctionInstallStatus = "failed"
+	FunctionChecksumMismatch FunctionInstallStatus = "checksum-mismatch"
+)
+
+// InstallStatusFromCode returns the install status matching the response code.
+// It is used for workers that report only the response code.
+func InstallStatusFromCode(code codes.Code) FunctionInstallStatus {
+
+	switch code {
+	case codes.OK, codes.Accepted:
+		return FunctionInstalled
+	default:
+		return FunctionInstallFailed
+	}
+}
+
+// WorkerInstallStatus describes the install confirmation a worker node sent.
+type WorkerInstallStatus struct {
+	Peer     peer.ID               `json:"peer"`
+	Status   FunctionInstallStatus `json:"status"`
+	Code     codes.Code            `json:"code"`
+	Message  string                `json:"message,omitempty"`
+	Received time.Time             `json:"received"`
+}
+
+// FunctionInstallRecord describes a function installation published by the head node, along with the confirmations of worker nodes.
+type FunctionInstallRecord struct {
+	ID          string                `json:"id"`
+	CID         string                `json:"cid"`
+	ManifestURL string                `json:"manifest_url,omitempty"`
+	Topic       string                `json:"topic"`
+	Started     time.Time             `json:"started"`
+	Workers     []WorkerInstallStatus `json:"workers"`
+}
+
+// Installed returns the number of workers that confirmed they have the function installed.
+func (r FunctionInstallRecord) Installed() uint {
+
+	var n uint
+	for _, worker := range r.Workers {
+		if worker.Status == FunctionInstalled {
+			n++
+		}
+	}
+
+	return n
+}
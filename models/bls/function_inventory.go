@@ -0,0 +1,96 @@
+package bls
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blessnetwork/b7s/models/codes"
+)
+
+// InstalledFunction describes a function installed on a worker node.
+type InstalledFunction struct {
+	CID           string    `json:"cid"`
+	Name          string    `json:"name,omitempty"`
+	Version       string    `json:"version,omitempty"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	LastRetrieved time.Time `json:"last_retrieved"`
+}
+
+// NewInstalledFunction returns the description of the installed function, based on its function record.
+func NewInstalledFunction(fn FunctionRecord) InstalledFunction {
+	return InstalledFunction{
+		CID:           fn.CID,
+		Name:          cmp.Or(fn.Manifest.Function.Name, fn.Manifest.Name),
+		Version:       fn.Manifest.Function.Version,
+		UpdatedAt:     fn.UpdatedAt,
+		LastRetrieved: fn.LastRetrieved,
+	}
+}
+
+// WorkerFunctions lists the functions installed on a worker node.
+type WorkerFunctions struct {
+	Peer      peer.ID             `json:"peer"`
+	Functions []InstalledFunction `json:"functions"`
+}
+
+// FunctionUninstallResult describes the outcome of removing a function from a worker node.
+type FunctionUninstallResult struct {
+	Peer    peer.ID    `json:"peer"`
+	Code    codes.Code `json:"code"`
+	Message string     `json:"message,omitempty"`
+}
+
+// FunctionInventory describes how widely a function is installed across worker nodes.
+type FunctionInventory struct {
+	CID           string    `json:"cid"`
+	Workers       uint      `json:"workers"`                  // Number of workers that have the function installed.
+	Peers         []peer.ID `json:"peers"`                    // Workers that have the function installed.
+	Versions      []string  `json:"versions,omitempty"`       // Function versions installed on the workers.
+	LastRetrieved time.Time `json:"last_retrieved,omitempty"` // Most recent time any of the workers used the function.
+}
+
+// AggregateFunctionInventory groups the functions installed on worker nodes by CID.
+// Functions are ordered by the number of workers they are installed on, most widely installed functions first.
+func AggregateFunctionInventory(workers []WorkerFunctions) []FunctionInventory {
+
+	byCID := make(map[string]*FunctionInventory)
+	for _, worker := range workers {
+		for _, fn := range worker.Functions {
+
+			inventory, ok := byCID[fn.CID]
+			if !ok {
+				inventory = &FunctionInventory{CID: fn.CID}
+				byCID[fn.CID] = inventory
+			}
+
+			inventory.Workers++
+			inventory.Peers = append(inventory.Peers, worker.Peer)
+
+			if fn.Version != "" && !slices.Contains(inventory.Versions, fn.Version) {
+				inventory.Versions = append(inventory.Versions, fn.Version)
+			}
+
+			if fn.LastRetrieved.After(inventory.LastRetrieved) {
+				inventory.LastRetrieved = fn.LastRetrieved
+			}
+		}
+	}
+
+	out := make([]FunctionInventory, 0, len(byCID))
+	for _, inventory := range byCID {
+		slices.Sort(inventory.Versions)
+		out = append(out, *inventory)
+	}
+
+	slices.SortFunc(out, func(a, b FunctionInventory) int {
+		return cmp.Or(
+			cmp.Compare(b.Workers, a.Workers),
+			cmp.Compare(a.CID, b.CID),
+		)
+	})
+
+	return out
+}
@@ -0,0 +1,56 @@
+package bls_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestAggregateFunctionInventory(t *testing.T) {
+
+	var (
+		peers = mocks.GenericPeerIDs[:3]
+		now   = time.Now().UTC()
+	)
+
+	workers := []bls.WorkerFunctions{
+		{
+			Peer: peers[0],
+			Functions: []bls.InstalledFunction{
+				{CID: "cid-a", Version: "1.0.0", LastRetrieved: now.Add(-time.Hour)},
+				{CID: "cid-b", Version: "1.0.0"},
+			},
+		},
+		{
+			Peer: peers[1],
+			Functions: []bls.InstalledFunction{
+				{CID: "cid-a", Version: "1.1.0", LastRetrieved: now},
+			},
+		},
+		{
+			Peer: peers[2],
+			Functions: []bls.InstalledFunction{
+				{CID: "cid-a", Version: "1.1.0"},
+			},
+		},
+	}
+
+	inventory := bls.AggregateFunctionInventory(workers)
+	require.Len(t, inventory, 2)
+
+	// Most widely installed functions come first.
+	require.Equal(t, "cid-a", inventory[0].CID)
+	require.Equal(t, uint(3), inventory[0].Workers)
+	require.Equal(t, peers, inventory[0].Peers)
+	require.Equal(t, []string{"1.0.0", "1.1.0"}, inventory[0].Versions)
+	require.Equal(t, now, inventory[0].LastRetrieved)
+
+	require.Equal(t, "cid-b", inventory[1].CID)
+	require.Equal(t, uint(1), inventory[1].Workers)
+
+	require.Empty(t, bls.AggregateFunctionInventory(nil))
+}
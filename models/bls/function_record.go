@@ -14,3 +14,9 @@ type FunctionRecord struct {
 	UpdatedAt     time.Time `json:"updated_at"`
 	LastRetrieved time.Time `json:"last_retrieved"`
 }
+
+// FunctionTombstone records that a function was uninstalled, so that it is not installed again on roll call.
+type FunctionTombstone struct {
+	CID       string    `json:"cid"`
+	RemovedAt time.Time `json:"removed_at"`
+}
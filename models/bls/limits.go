@@ -0,0 +1,41 @@
+package bls
+
+import (
+	"fmt"
+	"time"
+)
+
+// Limits that a request can run into.
+const (
+	LimitClientRate          = "client rate limit"
+	LimitFunctionRate        = "function rate limit"
+	LimitClientConcurrency   = "client concurrency limit"
+	LimitFunctionConcurrency = "function concurrency limit"
+	LimitClientQuota         = "client daily quota"
+	LimitAsyncExecutions     = "async execution limit"
+)
+
+// LimitError is returned when a request is rejected because the client or the function is over its limit.
+type LimitError struct {
+	Limit      string        // Limit the request ran into.
+	RetryAfter time.Duration // How long until the request may be accepted.
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%s exceeded, retry after %ds", e.Limit, e.RetryAfterSeconds())
+}
+
+// RetryAfterSeconds returns the time until the request may be accepted, rounded up to whole seconds.
+func (e *LimitError) RetryAfterSeconds() int64 {
+
+	seconds := int64((e.RetryAfter + time.Second - 1) / time.Second)
+	if seconds < 1 {
+		return 1
+	}
+
+	return seconds
+}
+
+func (e *LimitError) Unwrap() error {
+	return ErrLimitExceeded
+}
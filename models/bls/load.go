@@ -0,0 +1,28 @@
+package bls
+
+// NodeLoad describes how busy a worker node is.
+type NodeLoad struct {
+	ActiveExecutions uint `json:"active_executions"`  // Number of executions currently in progress on the node.
+	Capacity         uint `json:"capacity,omitempty"` // Maximum number of executions the node runs at the same time. Zero means unknown.
+
+	FreeMemoryKB uint64  `json:"free_memory_kb,omitempty"` // Memory available on the node, in kB. Zero means unknown.
+	CPUUsage     float64 `json:"cpu_usage,omitempty"`      // CPU usage of the node, as a percentage of all available CPUs.
+
+	// FunctionInstalled is true if the requested function was already installed, so the execution does not wait for the installation.
+	FunctionInstalled bool `json:"function_installed,omitempty"`
+}
+
+// Utilization returns the ratio of active executions to capacity. If capacity is not known, the number of active executions is returned.
+func (l NodeLoad) Utilization() float64 {
+
+	if l.Capacity == 0 {
+		return float64(l.ActiveExecutions)
+	}
+
+	return float64(l.ActiveExecutions) / float64(l.Capacity)
+}
+
+// Saturated returns true if the node is running as many executions as it can.
+func (l NodeLoad) Saturated() bool {
+	return l.Capacity > 0 && l.ActiveExecutions >= l.Capacity
+}
@@ -0,0 +1,23 @@
+package bls_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/bls"
+)
+
+func TestNodeLoad(t *testing.T) {
+
+	unknown := bls.NodeLoad{ActiveExecutions: 3}
+	require.Equal(t, 3.0, unknown.Utilization())
+	require.False(t, unknown.Saturated())
+
+	busy := bls.NodeLoad{ActiveExecutions: 2, Capacity: 4}
+	require.Equal(t, 0.5, busy.Utilization())
+	require.False(t, busy.Saturated())
+
+	busy.ActiveExecutions = 4
+	require.True(t, busy.Saturated())
+}
@@ -10,18 +10,25 @@ type Message interface {
 
 // Message types in the bls protocol.
 const (
-	MessageHealthCheck             = "MsgHealthCheck"
-	MessageInstallFunction         = "MsgInstallFunction"
-	MessageInstallFunctionResponse = "MsgInstallFunctionResponse"
-	MessageRollCall                = "MsgRollCall"
-	MessageRollCallResponse        = "MsgRollCallResponse"
-	MessageExecute                 = "MsgExecute" // MessageExecute is the execution request, as expected by the head node.
-	MessageExecuteResponse         = "MsgExecuteResponse"
-	MessageWorkOrder               = "MsgWorkOrder" // MessageWorkOrder is the execution request, as expected by the worker node.
-	MessageWorkOrderResponse       = "MsgWorkOrderResponse"
-	MessageFormCluster             = "MsgFormCluster"
-	MessageFormClusterResponse     = "MsgFormClusterResponse"
-	MessageDisbandCluster          = "MsgDisbandCluster"
+	MessageHealthCheck               = "MsgHealthCheck"
+	MessageInstallFunction           = "MsgInstallFunction"
+	MessageInstallFunctionResponse   = "MsgInstallFunctionResponse"
+	MessageRollCall                  = "MsgRollCall"
+	MessageRollCallResponse          = "MsgRollCallResponse"
+	MessageExecute                   = "MsgExecute" // MessageExecute is the execution request, as expected by the head node.
+	MessageExecuteResponse           = "MsgExecuteResponse"
+	MessageWorkOrder                 = "MsgWorkOrder" // MessageWorkOrder is the execution request, as expected by the worker node.
+	MessageWorkOrderResponse         = "MsgWorkOrderResponse"
+	MessageFormCluster               = "MsgFormCluster"
+	MessageFormClusterResponse       = "MsgFormClusterResponse"
+	MessageDisbandCluster            = "MsgDisbandCluster"
+	MessageCancelExecution           = "MsgCancelExecution"
+	MessageAttestation               = "MsgAttestation"
+	MessageAttestationResponse       = "MsgAttestationResponse"
+	MessageListFunctions             = "MsgListFunctions"
+	MessageListFunctionsResponse     = "MsgListFunctionsResponse"
+	MessageUninstallFunction         = "MsgUninstallFunction"
+	MessageUninstallFunctionResponse = "MsgUninstallFunctionResponse"
 )
 
 type TraceableMessage interface {
@@ -12,6 +12,9 @@ var (
 	ErrNotFound                = errors.New("not found")
 	ErrRollCallTimeout         = errors.New("roll call timed out - not enough nodes responded")
 	ErrExecutionNotEnoughNodes = errors.New("not enough execution results received")
+	ErrExecutionCancelled      = errors.New("execution cancelled")
+	ErrChecksumMismatch        = errors.New("function checksum mismatch")
+	ErrLimitExceeded           = errors.New("limit exceeded")
 )
 
 const (
@@ -1,6 +1,8 @@
 package bls
 
 import (
+	"time"
+
 	"github.com/libp2p/go-libp2p/core/peer"
 )
 
@@ -11,6 +13,24 @@ type Peer struct {
 	AddrInfo  peer.AddrInfo `json:"addrinfo,omitempty"`
 }
 
+// NodeStatus describes the state of a node, as shared with other nodes in health pings.
+type NodeStatus struct {
+	Version    string            `json:"version,omitempty"`
+	Role       string            `json:"role,omitempty"`
+	Topics     []string          `json:"topics,omitempty"`
+	Load       *NodeLoad         `json:"load,omitempty"`       // Set for worker nodes only.
+	Functions  []string          `json:"functions,omitempty"`  // CIDs of functions installed on the node.
+	Attributes map[string]string `json:"attributes,omitempty"` // Attested attributes of the node, by name.
+	Truncated  bool              `json:"truncated,omitempty"`  // Set if the node did not list all of its functions or attributes.
+}
+
+// PeerInfo describes a node as last seen by the head node.
+type PeerInfo struct {
+	ID peer.ID `json:"id"`
+	NodeStatus
+	LastSeen time.Time `json:"last_seen"`
+}
+
 // PeerIDsToStr will convert a list of peer.IDs to strings.
 func PeerIDsToStr(ids []peer.ID) []string {
 
@@ -0,0 +1,21 @@
+package bls
+
+import (
+	"time"
+
+	"github.com/blessnetwork/b7s/models/codes"
+)
+
+// PipelineRecord describes the outcome of a pipeline execution.
+type PipelineRecord struct {
+	PipelineID string     `json:"pipeline_id"`
+	Code       codes.Code `json:"code"`
+	Client     string     `json:"client,omitempty"` // REST API client that submitted the pipeline.
+
+	// Execution records of the pipeline steps, mapped by step ID.
+	Steps map[string]ExecutionRecord `json:"steps,omitempty"`
+	// Steps that were not executed because a step they depend on failed.
+	Skipped []string `json:"skipped,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
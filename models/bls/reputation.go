@@ -0,0 +1,84 @@
+package bls
+
+import (
+	"math"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// Weights of the different kinds of misbehavior when calculating the reputation score.
+const (
+	reputationTimeoutPenalty          = 1.0
+	reputationFailurePenalty          = 1.0
+	reputationDisagreementPenalty     = 2.0
+	reputationSignatureFailurePenalty = 4.0
+
+	// Successes a peer is credited with upfront, so that a single failure does not ruin a new peer.
+	reputationPrior = 1.0
+)
+
+// PeerReputation tracks how a worker node behaved when executing requests.
+// Counters decay over time so recent behavior weighs more than old behavior.
+type PeerReputation struct {
+	Peer              peer.ID   `json:"peer"`
+	RollCalls         float64   `json:"roll_calls"`         // Roll calls the peer reported for.
+	Successes         float64   `json:"successes"`          // Work orders the peer successfully executed.
+	Timeouts          float64   `json:"timeouts"`           // Work orders the peer did not respond to in time.
+	Failures          float64   `json:"failures"`           // Work orders the peer responded to with a non-OK code.
+	Disagreements     float64   `json:"disagreements"`      // Results that disagreed with the majority.
+	SignatureFailures float64   `json:"signature_failures"` // Results with an invalid signature.
+	Score             float64   `json:"score"`              // Score in the (0, 1] range, higher is better.
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// NewPeerReputation returns the reputation of a peer we know nothing about.
+func NewPeerReputation(id peer.ID) PeerReputation {
+	r := PeerReputation{
+		Peer:      id,
+		UpdatedAt: time.Now().UTC(),
+	}
+	r.Score = r.score()
+	return r
+}
+
+// Decay returns the reputation with counters decayed to the given moment, using exponential decay with the given half-life.
+// Non-positive half-life means the counters do not decay.
+func (r PeerReputation) Decay(halfLife time.Duration, now time.Time) PeerReputation {
+
+	elapsed := now.Sub(r.UpdatedAt)
+	if halfLife <= 0 || elapsed <= 0 {
+		return r
+	}
+
+	factor := math.Exp2(-float64(elapsed) / float64(halfLife))
+
+	r.RollCalls *= factor
+	r.Successes *= factor
+	r.Timeouts *= factor
+	r.Failures *= factor
+	r.Disagreements *= factor
+	r.SignatureFailures *= factor
+	r.Score = r.score()
+	r.UpdatedAt = now
+
+	return r
+}
+
+// Update recalculates the score after the counters have been changed.
+func (r *PeerReputation) Update(now time.Time) {
+	r.Score = r.score()
+	r.UpdatedAt = now
+}
+
+func (r PeerReputation) score() float64 {
+
+	penalty := reputationTimeoutPenalty*r.Timeouts +
+		reputationFailurePenalty*r.Failures +
+		reputationDisagreementPenalty*r.Disagreements +
+		reputationSignatureFailurePenalty*r.SignatureFailures
+
+	credit := reputationPrior + r.Successes
+
+	return credit / (credit + penalty)
+}
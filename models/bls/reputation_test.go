@@ -0,0 +1,60 @@
+package bls_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestPeerReputation_Score(t *testing.T) {
+
+	fresh := bls.NewPeerReputation(mocks.GenericPeerID)
+	require.Equal(t, 1.0, fresh.Score)
+
+	now := time.Now()
+
+	failing := fresh
+	failing.Timeouts = 3
+	failing.Update(now)
+	require.Equal(t, 0.25, failing.Score)
+
+	// Invalid signatures weigh more than timeouts.
+	cheating := fresh
+	cheating.SignatureFailures = 3
+	cheating.Update(now)
+	require.Less(t, cheating.Score, failing.Score)
+
+	// Successes make up for failures.
+	recovering := failing
+	recovering.Successes = 9
+	recovering.Update(now)
+	require.Greater(t, recovering.Score, failing.Score)
+}
+
+func TestPeerReputation_Decay(t *testing.T) {
+
+	var (
+		halfLife = time.Hour
+		start    = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	rep := bls.NewPeerReputation(mocks.GenericPeerID)
+	rep.Successes = 8
+	rep.Timeouts = 4
+	rep.Update(start)
+
+	decayed := rep.Decay(halfLife, start.Add(2*halfLife))
+	require.InDelta(t, 2.0, decayed.Successes, 1e-9)
+	require.InDelta(t, 1.0, decayed.Timeouts, 1e-9)
+	require.Equal(t, start.Add(2*halfLife), decayed.UpdatedAt)
+
+	// As bad behavior is forgotten, the score improves.
+	require.Greater(t, decayed.Score, rep.Score)
+
+	// No decay without half-life.
+	require.Equal(t, rep, rep.Decay(0, start.Add(halfLife)))
+}
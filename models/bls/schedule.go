@@ -0,0 +1,25 @@
+package bls
+
+import (
+	"time"
+
+	"github.com/blessnetwork/b7s/models/execute"
+)
+
+// Schedule describes a recurring execution, started by the head node according to a cron expression.
+type Schedule struct {
+	ID      string          `json:"id"`
+	Cron    string          `json:"cron"`
+	Request execute.Request `json:"request"`
+	Topic   string          `json:"topic,omitempty"`
+	// Webhook the execution results are delivered to. If not set, results are only kept in the result store.
+	Webhook Webhook `json:"webhook,omitempty"`
+	// Paused schedules do not start executions until resumed.
+	Paused bool `json:"paused,omitempty"`
+
+	NextRun       time.Time `json:"next_run,omitempty"`
+	LastRun       time.Time `json:"last_run,omitempty"`
+	LastRequestID string    `json:"last_request_id,omitempty"` // Request ID of the last execution started by the schedule.
+
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -2,13 +2,23 @@ package bls
 
 import (
 	"context"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blessnetwork/b7s/models/execute"
 )
 
 type Store interface {
 	PeerStore
 	FunctionStore
+	ExecutionResultStore
+	PipelineResultStore
+	WebhookDeliveryStore
+	PeerReputationStore
+	ScheduleStore
+	APIClientStore
+	UsageStore
 }
 
 type PeerStore interface {
@@ -23,4 +33,56 @@ type FunctionStore interface {
 	RetrieveFunction(ctx context.Context, cid string) (FunctionRecord, error)
 	RetrieveFunctions(ctx context.Context) ([]FunctionRecord, error)
 	RemoveFunction(ctx context.Context, id string) error
+	SaveFunctionTombstone(ctx context.Context, tombstone FunctionTombstone) error
+	RetrieveFunctionTombstone(ctx context.Context, cid string) (FunctionTombstone, error)
+	RemoveFunctionTombstone(ctx context.Context, cid string) error
+}
+
+type ExecutionResultStore interface {
+	SaveExecutionResult(ctx context.Context, record ExecutionRecord) error
+	RetrieveExecutionResult(ctx context.Context, id string) (ExecutionRecord, error)
+	RetrieveExecutionResults(ctx context.Context) ([]ExecutionRecord, error)
+	RetrieveStaleExecutionResults(ctx context.Context, before time.Time, keep uint) ([]string, error)
+	RemoveExecutionResult(ctx context.Context, id string) error
+}
+
+type PipelineResultStore interface {
+	SavePipelineResult(ctx context.Context, record PipelineRecord) error
+	RetrievePipelineResult(ctx context.Context, id string) (PipelineRecord, error)
+	RetrieveStalePipelineResults(ctx context.Context, before time.Time, keep uint) ([]string, error)
+	RemovePipelineResult(ctx context.Context, id string) error
+}
+
+type WebhookDeliveryStore interface {
+	SaveWebhookDelivery(ctx context.Context, record WebhookDelivery) error
+	RetrieveWebhookDelivery(ctx context.Context, id string) (WebhookDelivery, error)
+	RetrieveWebhookDeliveries(ctx context.Context) ([]WebhookDelivery, error)
+	RemoveWebhookDelivery(ctx context.Context, id string) error
+}
+
+type PeerReputationStore interface {
+	SavePeerReputation(ctx context.Context, record PeerReputation) error
+	RetrievePeerReputation(ctx context.Context, id peer.ID) (PeerReputation, error)
+	RetrievePeerReputations(ctx context.Context) ([]PeerReputation, error)
+	RemovePeerReputation(ctx context.Context, id peer.ID) error
+}
+
+type ScheduleStore interface {
+	SaveSchedule(ctx context.Context, record Schedule) error
+	RetrieveSchedule(ctx context.Context, id string) (Schedule, error)
+	RetrieveSchedules(ctx context.Context) ([]Schedule, error)
+	RemoveSchedule(ctx context.Context, id string) error
+}
+
+type APIClientStore interface {
+	SaveAPIClient(ctx context.Context, client APIClient) error
+	RetrieveAPIClient(ctx context.Context, id string) (APIClient, error)
+	RetrieveAPIClientByKey(ctx context.Context, keyHash string) (APIClient, error)
+	RetrieveAPIClients(ctx context.Context) ([]APIClient, error)
+	RemoveAPIClient(ctx context.Context, id string) error
+}
+
+type UsageStore interface {
+	SaveUsageEntry(ctx context.Context, entry execute.UsageEntry) error
+	RetrieveUsageEntries(ctx context.Context, filter UsageFilter) ([]execute.UsageEntry, error)
 }
@@ -0,0 +1,62 @@
+package bls
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blessnetwork/b7s/models/execute"
+)
+
+// UsageFilter selects entries from the usage ledger. Zero values match all entries.
+type UsageFilter struct {
+	From       time.Time // Start of the time range, inclusive.
+	To         time.Time // End of the time range, exclusive.
+	Client     string
+	FunctionID string
+	Worker     peer.ID
+}
+
+// Matches returns true if the entry passes the filter.
+func (f UsageFilter) Matches(entry execute.UsageEntry) bool {
+
+	if !f.From.IsZero() && entry.Timestamp.Before(f.From) {
+		return false
+	}
+
+	if !f.To.IsZero() && !entry.Timestamp.Before(f.To) {
+		return false
+	}
+
+	if f.Client != "" && entry.Client != f.Client {
+		return false
+	}
+
+	if f.FunctionID != "" && entry.FunctionID != f.FunctionID {
+		return false
+	}
+
+	if f.Worker != "" && entry.Worker != f.Worker {
+		return false
+	}
+
+	return true
+}
+
+// UsageTotals is the resource usage accumulated over a number of executions.
+type UsageTotals struct {
+	Executions    uint          `json:"executions"`
+	WallClockTime time.Duration `json:"wall_clock_time"`
+	CPUUserTime   time.Duration `json:"cpu_user_time"`
+	CPUSysTime    time.Duration `json:"cpu_sys_time"`
+	MemoryMaxKB   int64         `json:"memory_max_kb"` // Total of the peak memory usage of executions.
+}
+
+// Add accounts the usage of an execution.
+func (t *UsageTotals) Add(usage execute.Usage) {
+	t.Executions++
+	t.WallClockTime += usage.WallClockTime
+	t.CPUUserTime += usage.CPUUserTime
+	t.CPUSysTime += usage.CPUSysTime
+	t.MemoryMaxKB += usage.MemoryMaxKB
+}
@@ -0,0 +1,169 @@
+package bls
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// HTTP headers set on webhook deliveries.
+const (
+	WebhookHeaderRequestID = "X-B7S-Request-ID"
+	WebhookHeaderNodeID    = "X-B7S-Node-ID"   // Peer ID of the head node that sent the webhook.
+	WebhookHeaderSignature = "X-B7S-Signature" // Hex-encoded signature of the payload, made using the head node's key.
+	WebhookHeaderHMAC      = "X-B7S-HMAC"      // Hex-encoded HMAC-SHA256 of the payload, if a secret was provided.
+)
+
+// Webhook describes the callback invoked once an execution is done.
+type Webhook struct {
+	URL    string `json:"url"`
+	Secret string `json:"secret,omitempty"` // Optional secret used to create an HMAC of the payload.
+}
+
+// Valid checks if the webhook is valid. Empty webhook is valid - it means no callback was requested.
+// Webhooks pointing to loopback, link-local or private addresses are rejected. Since host names can resolve
+// to different addresses later, the head node checks the address again when delivering the result.
+func (w Webhook) Valid() error {
+
+	if w.URL == "" {
+		if w.Secret != "" {
+			return errors.New("webhook secret set without a URL")
+		}
+
+		return nil
+	}
+
+	u, err := url.Parse(w.URL)
+	if err != nil {
+		return fmt.Errorf("could not parse webhook URL: %w", err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("unsupported webhook URL scheme: %v", u.Scheme)
+	}
+
+	if u.Hostname() == "" {
+		return errors.New("webhook URL has no host")
+	}
+
+	if strings.EqualFold(u.Hostname(), "localhost") {
+		return errors.New("webhook URL points to a local address")
+	}
+
+	addr, err := netip.ParseAddr(u.Hostname())
+	if err == nil && !PublicAddress(addr) {
+		return fmt.Errorf("webhook URL points to a non-public address: %v", addr)
+	}
+
+	return nil
+}
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), not routable on the public internet.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// PublicAddress returns true if the address is a global unicast address, outside of the private and shared address ranges.
+func PublicAddress(addr netip.Addr) bool {
+
+	addr = addr.Unmap()
+
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !sharedAddressSpace.Contains(addr)
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery tracks the delivery of the execution result to the webhook. Payload and its HMAC are prepared
+// when the delivery starts, so the webhook secret is never stored.
+type WebhookDelivery struct {
+	RequestID string                `json:"request_id"`
+	URL       string                `json:"url"`
+	Payload   json.RawMessage       `json:"payload"`
+	HMAC      string                `json:"hmac,omitempty"` // Hex-encoded HMAC of the payload, if the webhook has a secret.
+	Status    WebhookDeliveryStatus `json:"status"`
+	Attempts  uint                  `json:"attempts"`
+
+	// Outcome of the last delivery attempt.
+	StatusCode int    `json:"status_code,omitempty"`
+	Error      string `json:"error,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// SignWebhookPayload signs the webhook payload using the given key.
+func SignWebhookPayload(key crypto.PrivKey, payload []byte) (string, error) {
+
+	sig, err := key.Sign(payload)
+	if err != nil {
+		return "", fmt.Errorf("could not sign payload: %w", err)
+	}
+
+	return hex.EncodeToString(sig), nil
+}
+
+// VerifyWebhookSignature verifies that the webhook payload was signed by the node with the given ID.
+func VerifyWebhookSignature(id peer.ID, payload []byte, signature string) error {
+
+	key, err := id.ExtractPublicKey()
+	if err != nil {
+		return fmt.Errorf("could not extract public key from peer ID: %w", err)
+	}
+
+	sig, err := hex.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("could not decode signature from hex: %w", err)
+	}
+
+	ok, err := key.Verify(payload, sig)
+	if err != nil {
+		return fmt.Errorf("could not verify signature: %w", err)
+	}
+
+	if !ok {
+		return errors.New("invalid signature")
+	}
+
+	return nil
+}
+
+// WebhookHMAC returns the hex-encoded HMAC-SHA256 of the payload.
+func WebhookHMAC(secret string, payload []byte) string {
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhookHMAC verifies the HMAC of the webhook payload.
+func VerifyWebhookHMAC(secret string, payload []byte, signature string) error {
+
+	sig, err := hex.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("could not decode HMAC from hex: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	if !hmac.Equal(sig, mac.Sum(nil)) {
+		return errors.New("invalid HMAC")
+	}
+
+	return nil
+}
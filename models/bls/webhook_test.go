@@ -0,0 +1,75 @@
+package bls_test
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/bls"
+)
+
+func TestWebhook_Valid(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		webhook bls.Webhook
+		valid   bool
+	}{
+		{name: "empty webhook", webhook: bls.Webhook{}, valid: true},
+		{name: "https URL", webhook: bls.Webhook{URL: "https://example.com/webhook"}, valid: true},
+		{name: "http URL with secret", webhook: bls.Webhook{URL: "http://example.com/webhook", Secret: "secret"}, valid: true},
+		{name: "secret without URL", webhook: bls.Webhook{Secret: "secret"}},
+		{name: "unsupported scheme", webhook: bls.Webhook{URL: "ftp://example.com/webhook"}},
+		{name: "missing host", webhook: bls.Webhook{URL: "https:///webhook"}},
+		{name: "public address", webhook: bls.Webhook{URL: "https://93.184.215.14:8443/webhook"}, valid: true},
+		{name: "localhost", webhook: bls.Webhook{URL: "http://localhost:8080/webhook"}},
+		{name: "loopback address", webhook: bls.Webhook{URL: "http://127.0.0.1/webhook"}},
+		{name: "loopback IPv6 address", webhook: bls.Webhook{URL: "http://[::1]/webhook"}},
+		{name: "link-local address", webhook: bls.Webhook{URL: "http://169.254.169.254/latest/meta-data"}},
+		{name: "private address", webhook: bls.Webhook{URL: "http://10.0.0.1/webhook"}},
+		{name: "IPv4-mapped private address", webhook: bls.Webhook{URL: "http://[::ffff:192.168.0.1]/webhook"}},
+		{name: "unspecified address", webhook: bls.Webhook{URL: "http://0.0.0.0/webhook"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.webhook.Valid()
+			if test.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestWebhook_Signature(t *testing.T) {
+
+	payload := []byte(`{"request_id":"dummy-request-id"}`)
+
+	priv, _, err := crypto.GenerateEd25519Key(nil)
+	require.NoError(t, err)
+
+	id, err := peer.IDFromPrivateKey(priv)
+	require.NoError(t, err)
+
+	signature, err := bls.SignWebhookPayload(priv, payload)
+	require.NoError(t, err)
+
+	t.Run("valid signature", func(t *testing.T) {
+		err := bls.VerifyWebhookSignature(id, payload, signature)
+		require.NoError(t, err)
+	})
+	t.Run("tampered payload", func(t *testing.T) {
+		err := bls.VerifyWebhookSignature(id, []byte(`{"request_id":"other-request-id"}`), signature)
+		require.Error(t, err)
+	})
+	t.Run("HMAC", func(t *testing.T) {
+		mac := bls.WebhookHMAC("secret", payload)
+
+		require.NoError(t, bls.VerifyWebhookHMAC("secret", payload, mac))
+		require.Error(t, bls.VerifyWebhookHMAC("other-secret", payload, mac))
+	})
+}
@@ -0,0 +1,175 @@
+package execute
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// AggregationType determines how the head node combines the results of multiple peers into a single value.
+type AggregationType string
+
+const (
+	// AggregationExact groups identical results (stdout, stderr and exit code). This is the default.
+	AggregationExact AggregationType = "exact"
+	// AggregationStdout groups results with identical stdout, ignoring stderr and exit code.
+	AggregationStdout AggregationType = "stdout"
+	// AggregationJSONField groups results by the value of a field in the JSON output of the execution.
+	AggregationJSONField AggregationType = "json_field"
+	// AggregationMedian returns the median of numeric results.
+	AggregationMedian AggregationType = "median"
+	// AggregationMean returns the mean of numeric results.
+	AggregationMean AggregationType = "mean"
+	// AggregationTrimmedMean returns the mean of numeric results, after discarding a fraction of the lowest and highest values.
+	AggregationTrimmedMean AggregationType = "trimmed_mean"
+	// AggregationMajority returns the most common stdout, provided enough peers agree on it.
+	AggregationMajority AggregationType = "majority"
+)
+
+// Names of the aggregation parameters.
+const (
+	// AggregationParamPath is the dot-separated path of a field in the JSON output, e.g. `data.items.0.price`.
+	// Required for `json_field` aggregation, optional for numeric aggregations.
+	AggregationParamPath = "path"
+	// AggregationParamTrim is the fraction of values discarded from each end for `trimmed_mean` aggregation. Defaults to 0.1.
+	AggregationParamTrim = "trim"
+	// AggregationParamMinAgreement is the fraction of peers that must agree on the result for `majority` aggregation.
+	AggregationParamMinAgreement = "min_agreement"
+)
+
+// DefaultAggregationTrim is the fraction of values discarded from each end for `trimmed_mean` aggregation, if not specified.
+const DefaultAggregationTrim = 0.1
+
+// ResultAggregation describes how the results of multiple peers should be aggregated.
+type ResultAggregation struct {
+	Enable     bool            `json:"enable,omitempty"`
+	Type       AggregationType `json:"type,omitempty"`
+	Parameters []Parameter     `json:"parameters,omitempty"`
+}
+
+// Parameter returns the value of the named aggregation parameter.
+func (a ResultAggregation) Parameter(name string) (string, bool) {
+
+	for _, param := range a.Parameters {
+		if param.Name == name {
+			return param.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// FloatParameter returns the value of the named aggregation parameter as a number.
+// If the parameter is not set, the given default value is returned.
+func (a ResultAggregation) FloatParameter(name string, def float64) (float64, error) {
+
+	value, ok := a.Parameter(name)
+	if !ok {
+		return def, nil
+	}
+
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s parameter: %w", name, err)
+	}
+
+	return f, nil
+}
+
+func (a ResultAggregation) Valid() error {
+
+	if !a.Enable {
+		return nil
+	}
+
+	switch a.Type {
+	case "", AggregationExact, AggregationStdout, AggregationMedian, AggregationMean:
+		return nil
+
+	case AggregationJSONField:
+		path, _ := a.Parameter(AggregationParamPath)
+		if path == "" {
+			return errors.New("path parameter is required")
+		}
+		return nil
+
+	case AggregationTrimmedMean:
+		trim, err := a.FloatParameter(AggregationParamTrim, DefaultAggregationTrim)
+		if err != nil {
+			return err
+		}
+		if trim < 0 || trim >= 0.5 {
+			return errors.New("trim must be in range [0, 0.5)")
+		}
+		return nil
+
+	case AggregationMajority:
+		_, ok := a.Parameter(AggregationParamMinAgreement)
+		if !ok {
+			return errors.New("min_agreement parameter is required")
+		}
+		agreement, err := a.FloatParameter(AggregationParamMinAgreement, 0)
+		if err != nil {
+			return err
+		}
+		if agreement <= 0 || agreement > 1 {
+			return errors.New("min_agreement must be in range (0, 1]")
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown aggregation type (%s)", a.Type)
+	}
+}
+
+// AggregatedResults are the execution results grouped by output.
+type AggregatedResults []AggregatedResult
+
+// AggregatedResult represents the execution result along with its aggregation stats.
+type AggregatedResult struct {
+	Result RuntimeOutput `json:"result,omitempty"`
+	// Peers that got this result.
+	Peers []peer.ID `json:"peers,omitempty"`
+	// Peers metadata
+	Metadata NodeMetadata `json:"metadata,omitempty"`
+	// How frequent was this result, in percentages.
+	Frequency float64 `json:"frequency,omitempty"`
+}
+
+type NodeMetadata map[peer.ID]any
+
+func (m NodeMetadata) MarshalJSON() ([]byte, error) {
+
+	em := make(map[string]any, len(m))
+	for p, v := range m {
+		em[p.String()] = v
+	}
+
+	return json.Marshal(em)
+}
+
+// Aggregation is the outcome of aggregating execution results using one of the aggregation strategies.
+type Aggregation struct {
+	Type AggregationType `json:"type"`
+	// Aggregated value.
+	Value string `json:"value,omitempty"`
+	// Percentage of peers whose values agree with, or were used to compute, the aggregated value.
+	Agreement float64 `json:"agreement,omitempty"`
+	// Per-peer breakdown.
+	Peers []AggregationPeerValue `json:"peers,omitempty"`
+	// Used to communicate why the results could not be aggregated.
+	Error string `json:"error,omitempty"`
+}
+
+// AggregationPeerValue describes the value a single peer contributed to the aggregation.
+type AggregationPeerValue struct {
+	Peer  peer.ID `json:"peer"`
+	Value string  `json:"value,omitempty"`
+	// Included is true if the value agrees with, or was used to compute, the aggregated value.
+	Included bool `json:"included"`
+	// Used to communicate why the result of the peer could not be used.
+	Error string `json:"error,omitempty"`
+}
@@ -0,0 +1,47 @@
+package execute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResultAggregation_Valid(t *testing.T) {
+
+	aggregation := func(t AggregationType, params ...Parameter) ResultAggregation {
+		return ResultAggregation{
+			Enable:     true,
+			Type:       t,
+			Parameters: params,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		aggregation ResultAggregation
+		valid       bool
+	}{
+		{name: "disabled", aggregation: ResultAggregation{Type: "unknown"}, valid: true},
+		{name: "default type", aggregation: aggregation(""), valid: true},
+		{name: "stdout", aggregation: aggregation(AggregationStdout), valid: true},
+		{name: "json field", aggregation: aggregation(AggregationJSONField, Parameter{Name: AggregationParamPath, Value: "data.price"}), valid: true},
+		{name: "json field without path", aggregation: aggregation(AggregationJSONField)},
+		{name: "trimmed mean with default trim", aggregation: aggregation(AggregationTrimmedMean), valid: true},
+		{name: "trimmed mean with invalid trim", aggregation: aggregation(AggregationTrimmedMean, Parameter{Name: AggregationParamTrim, Value: "0.5"})},
+		{name: "majority", aggregation: aggregation(AggregationMajority, Parameter{Name: AggregationParamMinAgreement, Value: "0.66"}), valid: true},
+		{name: "majority without min agreement", aggregation: aggregation(AggregationMajority)},
+		{name: "majority with invalid min agreement", aggregation: aggregation(AggregationMajority, Parameter{Name: AggregationParamMinAgreement, Value: "66"})},
+		{name: "unknown type", aggregation: aggregation("mode")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.aggregation.Valid()
+			if test.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package execute
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// ResultCertificate proves that enough replicas of a PBFT cluster produced the same execution result.
+// It bundles the results signed by the replicas, so the result can be trusted without trusting the head node.
+// Certificates are created and verified by the PBFT consensus package.
+type ResultCertificate struct {
+	RequestID        string        `json:"request_id"`
+	View             uint          `json:"view"` // Latest view reported by the replicas.
+	RequestTimestamp time.Time     `json:"request_timestamp"`
+	Replicas         []peer.ID     `json:"replicas"` // Replica set of the cluster.
+	Result           RuntimeOutput `json:"result"`
+	Results          []NodeResult  `json:"results"` // Signed results of the replicas, ordered by replica ID.
+}
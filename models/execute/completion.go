@@ -0,0 +1,50 @@
+package execute
+
+import (
+	"errors"
+	"fmt"
+)
+
+// CompletionType determines when the head node stops waiting for execution results.
+type CompletionType string
+
+const (
+	// CompletionWaitAll waits for all peers to respond, or for the execution timeout.
+	CompletionWaitAll CompletionType = "wait_all"
+	// CompletionThreshold waits until enough peers respond with identical results to satisfy the request threshold.
+	CompletionThreshold CompletionType = "threshold"
+	// CompletionFirstN waits for the first N successful results.
+	CompletionFirstN CompletionType = "first_n"
+	// CompletionFirstMatchingK waits for the first K identical successful results.
+	CompletionFirstMatchingK CompletionType = "first_matching_k"
+)
+
+// CompletionPolicy describes when the head node should stop waiting for execution results.
+// Peers that did not respond by then are reported as late.
+type CompletionPolicy struct {
+	Type CompletionType `json:"type,omitempty"`
+	// Count is the number of results required for `first_n` and `first_matching_k` policies.
+	Count int `json:"count,omitempty"`
+}
+
+// WaitAll returns true if the policy requires waiting for all peers. This is the default.
+func (p CompletionPolicy) WaitAll() bool {
+	return p.Type == "" || p.Type == CompletionWaitAll
+}
+
+func (p CompletionPolicy) Valid() error {
+
+	switch p.Type {
+	case "", CompletionWaitAll, CompletionThreshold:
+		return nil
+
+	case CompletionFirstN, CompletionFirstMatchingK:
+		if p.Count <= 0 {
+			return errors.New("result count must be positive")
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown completion policy (%s)", p.Type)
+	}
+}
@@ -0,0 +1,211 @@
+package execute
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// InputTarget determines how the output of an earlier pipeline step is passed to a step.
+type InputTarget string
+
+const (
+	// InputStdin passes the output as the standard input of the step.
+	InputStdin InputTarget = "stdin"
+	// InputParameter passes the output as a named parameter of the step.
+	InputParameter InputTarget = "parameter"
+	// InputEnvironment passes the output as an environment variable of the step.
+	InputEnvironment InputTarget = "env"
+)
+
+// Pipeline describes a set of execution steps, where steps can use the output of earlier steps.
+// Steps and their inputs form a directed acyclic graph.
+type Pipeline struct {
+	Steps []PipelineStep `json:"steps"`
+}
+
+// PipelineStep is a single execution request in a pipeline.
+type PipelineStep struct {
+	ID      string          `json:"id"`
+	Request Request         `json:"request"`
+	Inputs  []PipelineInput `json:"inputs,omitempty"`
+}
+
+// PipelineInput maps the aggregated output (stdout) of an earlier step to the stdin, a parameter or an environment variable of a step.
+type PipelineInput struct {
+	Step   string      `json:"step"`
+	Target InputTarget `json:"target"`
+	// Name of the parameter or environment variable. Not used for stdin.
+	Name string `json:"name,omitempty"`
+}
+
+func (i PipelineInput) Valid() error {
+
+	if i.Step == "" {
+		return errors.New("input step is required")
+	}
+
+	switch i.Target {
+	case InputStdin:
+		return nil
+
+	case InputParameter, InputEnvironment:
+		if i.Name == "" {
+			return fmt.Errorf("name is required for %s inputs", i.Target)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown input target (%s)", i.Target)
+	}
+}
+
+// Dependencies returns the IDs of the steps this step uses the output of.
+func (s PipelineStep) Dependencies() []string {
+
+	deps := make([]string, 0, len(s.Inputs))
+	seen := make(map[string]struct{}, len(s.Inputs))
+	for _, input := range s.Inputs {
+		if _, ok := seen[input.Step]; ok {
+			continue
+		}
+
+		seen[input.Step] = struct{}{}
+		deps = append(deps, input.Step)
+	}
+
+	return deps
+}
+
+// WithInputs returns the execution request of the step, with the outputs of earlier steps mapped to it.
+func (s PipelineStep) WithInputs(outputs map[string]string) Request {
+
+	req := s.Request
+
+	// Make sure we don't modify the slices of the original request.
+	req.Parameters = append([]Parameter(nil), s.Request.Parameters...)
+	req.Config.Environment = append([]EnvVar(nil), s.Request.Config.Environment...)
+
+	for _, input := range s.Inputs {
+
+		output := outputs[input.Step]
+
+		switch input.Target {
+		case InputStdin:
+			req.Config.Stdin = &output
+
+		case InputParameter:
+			req.Parameters = append(req.Parameters, Parameter{Name: input.Name, Value: output})
+
+		case InputEnvironment:
+			req.Config.Environment = append(req.Config.Environment, EnvVar{Name: input.Name, Value: output})
+		}
+	}
+
+	return req
+}
+
+func (p Pipeline) Valid() error {
+
+	if len(p.Steps) == 0 {
+		return errors.New("pipeline has no steps")
+	}
+
+	var err *multierror.Error
+
+	steps := make(map[string]PipelineStep, len(p.Steps))
+	for i, step := range p.Steps {
+
+		if step.ID == "" {
+			err = multierror.Append(err, fmt.Errorf("step %d: step ID is required", i))
+			continue
+		}
+
+		_, ok := steps[step.ID]
+		if ok {
+			err = multierror.Append(err, fmt.Errorf("step %s: duplicate step ID", step.ID))
+			continue
+		}
+
+		steps[step.ID] = step
+
+		rerr := step.Request.Valid()
+		if rerr != nil {
+			err = multierror.Append(err, fmt.Errorf("step %s: invalid request: %w", step.ID, rerr))
+		}
+
+		for _, input := range step.Inputs {
+			ierr := input.Valid()
+			if ierr != nil {
+				err = multierror.Append(err, fmt.Errorf("step %s: invalid input: %w", step.ID, ierr))
+			}
+		}
+	}
+
+	for _, step := range p.Steps {
+		for _, dep := range step.Dependencies() {
+			_, ok := steps[dep]
+			if !ok {
+				err = multierror.Append(err, fmt.Errorf("step %s: unknown input step (%s)", step.ID, dep))
+			}
+		}
+	}
+
+	// Cycle check only makes sense if the steps are otherwise valid.
+	if err.ErrorOrNil() != nil {
+		return err
+	}
+
+	_, oerr := p.Order()
+	if oerr != nil {
+		return oerr
+	}
+
+	return nil
+}
+
+// Order returns the steps in topological order - each step comes after all of the steps it depends on.
+// Steps that do not depend on each other keep the order from the pipeline.
+func (p Pipeline) Order() ([]PipelineStep, error) {
+
+	var (
+		remaining = make(map[string]int, len(p.Steps))
+		dependent = make(map[string][]string, len(p.Steps))
+	)
+
+	for _, step := range p.Steps {
+		deps := step.Dependencies()
+		remaining[step.ID] = len(deps)
+		for _, dep := range deps {
+			dependent[dep] = append(dependent[dep], step.ID)
+		}
+	}
+
+	ordered := make([]PipelineStep, 0, len(p.Steps))
+	done := make(map[string]struct{}, len(p.Steps))
+
+	for len(ordered) < len(p.Steps) {
+
+		progress := false
+		for _, step := range p.Steps {
+			if _, ok := done[step.ID]; ok || remaining[step.ID] > 0 {
+				continue
+			}
+
+			done[step.ID] = struct{}{}
+			ordered = append(ordered, step)
+			progress = true
+
+			for _, id := range dependent[step.ID] {
+				remaining[id]--
+			}
+		}
+
+		if !progress {
+			return nil, errors.New("pipeline steps form a cycle")
+		}
+	}
+
+	return ordered, nil
+}
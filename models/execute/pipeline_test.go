@@ -0,0 +1,110 @@
+package execute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipeline_Valid(t *testing.T) {
+
+	step := func(id string, inputs ...PipelineInput) PipelineStep {
+		return PipelineStep{
+			ID: id,
+			Request: Request{
+				FunctionID: "function-id",
+				Method:     "method-value",
+			},
+			Inputs: inputs,
+		}
+	}
+
+	stdin := func(step string) PipelineInput {
+		return PipelineInput{Step: step, Target: InputStdin}
+	}
+
+	tests := []struct {
+		name     string
+		pipeline Pipeline
+		valid    bool
+	}{
+		{name: "single step", pipeline: Pipeline{Steps: []PipelineStep{step("a")}}, valid: true},
+		{name: "diamond", pipeline: Pipeline{Steps: []PipelineStep{step("a"), step("b", stdin("a")), step("c", stdin("a")), step("d", stdin("b"), stdin("c"))}}, valid: true},
+		{name: "no steps", pipeline: Pipeline{}},
+		{name: "missing step ID", pipeline: Pipeline{Steps: []PipelineStep{step("")}}},
+		{name: "duplicate step ID", pipeline: Pipeline{Steps: []PipelineStep{step("a"), step("a")}}},
+		{name: "invalid request", pipeline: Pipeline{Steps: []PipelineStep{{ID: "a"}}}},
+		{name: "unknown input step", pipeline: Pipeline{Steps: []PipelineStep{step("a", stdin("b"))}}},
+		{name: "parameter input without name", pipeline: Pipeline{Steps: []PipelineStep{step("a"), step("b", PipelineInput{Step: "a", Target: InputParameter})}}},
+		{name: "unknown input target", pipeline: Pipeline{Steps: []PipelineStep{step("a"), step("b", PipelineInput{Step: "a", Target: "file"})}}},
+		{name: "self reference", pipeline: Pipeline{Steps: []PipelineStep{step("a", stdin("a"))}}},
+		{name: "cycle", pipeline: Pipeline{Steps: []PipelineStep{step("a", stdin("c")), step("b", stdin("a")), step("c", stdin("b"))}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.pipeline.Valid()
+			if test.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPipeline_Order(t *testing.T) {
+
+	pipeline := Pipeline{
+		Steps: []PipelineStep{
+			{ID: "report", Inputs: []PipelineInput{{Step: "transform", Target: InputStdin}, {Step: "fetch", Target: InputStdin}}},
+			{ID: "transform", Inputs: []PipelineInput{{Step: "fetch", Target: InputStdin}}},
+			{ID: "fetch"},
+			{ID: "audit"},
+		},
+	}
+
+	steps, err := pipeline.Order()
+	require.NoError(t, err)
+
+	ids := make([]string, 0, len(steps))
+	for _, step := range steps {
+		ids = append(ids, step.ID)
+	}
+
+	require.Equal(t, []string{"fetch", "audit", "transform", "report"}, ids)
+}
+
+func TestPipelineStep_WithInputs(t *testing.T) {
+
+	step := PipelineStep{
+		ID: "report",
+		Request: Request{
+			FunctionID: "function-id",
+			Method:     "method-value",
+			Parameters: []Parameter{{Name: "format", Value: "json"}},
+		},
+		Inputs: []PipelineInput{
+			{Step: "fetch", Target: InputStdin},
+			{Step: "transform", Target: InputParameter, Name: "data"},
+			{Step: "transform", Target: InputEnvironment, Name: "DATA"},
+		},
+	}
+
+	outputs := map[string]string{
+		"fetch":     "fetched",
+		"transform": "transformed",
+	}
+
+	req := step.WithInputs(outputs)
+
+	require.NotNil(t, req.Config.Stdin)
+	require.Equal(t, "fetched", *req.Config.Stdin)
+	require.Equal(t, []Parameter{{Name: "format", Value: "json"}, {Name: "data", Value: "transformed"}}, req.Parameters)
+	require.Equal(t, []EnvVar{{Name: "DATA", Value: "transformed"}}, req.Config.Environment)
+
+	// Original request is not modified.
+	require.Nil(t, step.Request.Config.Stdin)
+	require.Len(t, step.Request.Parameters, 1)
+	require.Empty(t, step.Request.Config.Environment)
+}
@@ -0,0 +1,288 @@
+package execute
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// ReceiptVersion identifies the canonical encoding used for receipt signatures.
+const ReceiptVersion = "b7s-receipt-v1"
+
+// Receipt is a worker-signed statement that it executed a request, and which output it produced.
+// Receipts can be verified offline by anyone who has the request and its result.
+//
+// Signature covers the canonical encoding of the receipt (see `CanonicalPayload`), not the
+// JSON encoding of this struct, so receipts can be verified without depending on this type.
+type Receipt struct {
+	RequestID  string    `json:"request_id"`
+	FunctionID string    `json:"function_id"`
+	Method     string    `json:"method"`
+	InputHash  string    `json:"input_hash"`  // See `InputHash`.
+	OutputHash string    `json:"output_hash"` // See `OutputHash`.
+	Usage      Usage     `json:"usage"`
+	Timestamp  time.Time `json:"timestamp"`
+	Worker     peer.ID   `json:"worker"`
+	Signature  string    `json:"signature,omitempty"`
+}
+
+// NewReceipt creates a receipt for the execution of the request on the given worker.
+func NewReceipt(requestID string, req Request, worker peer.ID, res Result) (Receipt, error) {
+
+	input, err := InputHash(req)
+	if err != nil {
+		return Receipt{}, fmt.Errorf("could not hash inputs: %w", err)
+	}
+
+	output, err := OutputHash(res.Result)
+	if err != nil {
+		return Receipt{}, fmt.Errorf("could not hash outputs: %w", err)
+	}
+
+	receipt := Receipt{
+		RequestID:  requestID,
+		FunctionID: req.FunctionID,
+		Method:     req.Method,
+		InputHash:  input,
+		OutputHash: output,
+		Usage:      res.Usage,
+		Timestamp:  time.Now().UTC(),
+		Worker:     worker,
+	}
+
+	return receipt, nil
+}
+
+// CanonicalPayload returns the byte representation of the receipt that is signed. It is a JSON object
+// with keys sorted lexicographically and no insignificant whitespace. Durations are integers in nanoseconds,
+// and the timestamp is in RFC 3339 format, in UTC.
+func (r Receipt) CanonicalPayload() ([]byte, error) {
+
+	payload := map[string]any{
+		"version":     ReceiptVersion,
+		"request_id":  r.RequestID,
+		"function_id": r.FunctionID,
+		"method":      r.Method,
+		"input_hash":  r.InputHash,
+		"output_hash": r.OutputHash,
+		"usage":       canonicalUsage(r.Usage),
+		"timestamp":   r.Timestamp.UTC().Format(time.RFC3339Nano),
+		"worker":      r.Worker.String(),
+	}
+
+	return canonicalJSON(payload)
+}
+
+// canonicalUsage returns the representation of the resource usage used in canonical payloads.
+func canonicalUsage(u Usage) map[string]any {
+	return map[string]any{
+		"wall_clock_time": int64(u.WallClockTime),
+		"cpu_user_time":   int64(u.CPUUserTime),
+		"cpu_sys_time":    int64(u.CPUSysTime),
+		"memory_max_kb":   u.MemoryMaxKB,
+	}
+}
+
+// Sign signs the receipt with the key of the worker.
+func (r *Receipt) Sign(key crypto.PrivKey) error {
+
+	payload, err := r.CanonicalPayload()
+	if err != nil {
+		return fmt.Errorf("could not get byte representation of the receipt: %w", err)
+	}
+
+	sig, err := key.Sign(payload)
+	if err != nil {
+		return fmt.Errorf("could not sign digest: %w", err)
+	}
+
+	r.Signature = hex.EncodeToString(sig)
+	return nil
+}
+
+// VerifySignature verifies that the receipt was signed by the worker it names.
+func (r Receipt) VerifySignature() error {
+
+	key, err := r.Worker.ExtractPublicKey()
+	if err != nil {
+		return fmt.Errorf("could not derive public key from worker ID: %w", err)
+	}
+
+	payload, err := r.CanonicalPayload()
+	if err != nil {
+		return fmt.Errorf("could not get byte representation of the receipt: %w", err)
+	}
+
+	sig, err := hex.DecodeString(r.Signature)
+	if err != nil {
+		return fmt.Errorf("could not decode signature from hex: %w", err)
+	}
+
+	ok, err := key.Verify(payload, sig)
+	if err != nil {
+		return fmt.Errorf("could not verify signature: %w", err)
+	}
+
+	if !ok {
+		return errors.New("invalid signature")
+	}
+
+	return nil
+}
+
+// Verify verifies the receipt signature, and that the receipt describes the execution of the given request with the given output.
+func (r Receipt) Verify(requestID string, req Request, output RuntimeOutput) error {
+
+	err := r.VerifySignature()
+	if err != nil {
+		return err
+	}
+
+	if r.RequestID != requestID {
+		return fmt.Errorf("request ID mismatch (have: %s, want: %s)", r.RequestID, requestID)
+	}
+
+	if r.FunctionID != req.FunctionID {
+		return fmt.Errorf("function ID mismatch (have: %s, want: %s)", r.FunctionID, req.FunctionID)
+	}
+
+	if r.Method != req.Method {
+		return fmt.Errorf("method mismatch (have: %s, want: %s)", r.Method, req.Method)
+	}
+
+	input, err := InputHash(req)
+	if err != nil {
+		return fmt.Errorf("could not hash inputs: %w", err)
+	}
+
+	if r.InputHash != input {
+		return errors.New("input hash mismatch")
+	}
+
+	out, err := OutputHash(output)
+	if err != nil {
+		return fmt.Errorf("could not hash outputs: %w", err)
+	}
+
+	if r.OutputHash != out {
+		return errors.New("output hash mismatch")
+	}
+
+	return nil
+}
+
+// InputHash returns the hex-encoded SHA-256 hash of the canonical encoding of the execution inputs - parameters,
+// environment variables and standard input. Canonical encoding is a JSON object in the same format as the receipt,
+// with the keys `parameters` and `env_vars` holding lists of objects with `name` and `value` keys, and the `stdin` key.
+func InputHash(req Request) (string, error) {
+
+	params := make([]map[string]any, 0, len(req.Parameters))
+	for _, param := range req.Parameters {
+		params = append(params, map[string]any{"name": param.Name, "value": param.Value})
+	}
+
+	env := make([]map[string]any, 0, len(req.Config.Environment))
+	for _, ev := range req.Config.Environment {
+		env = append(env, map[string]any{"name": ev.Name, "value": ev.Value})
+	}
+
+	stdin := ""
+	if req.Config.Stdin != nil {
+		stdin = *req.Config.Stdin
+	}
+
+	inputs := map[string]any{
+		"parameters": params,
+		"env_vars":   env,
+		"stdin":      stdin,
+	}
+
+	return canonicalHash(inputs)
+}
+
+// OutputHash returns the hex-encoded SHA-256 hash of the canonical encoding of the execution output. Canonical encoding
+// is a JSON object in the same format as the receipt, with the keys `stdout`, `stderr` and `exit_code`.
+func OutputHash(output RuntimeOutput) (string, error) {
+
+	out := map[string]any{
+		"stdout":    output.Stdout,
+		"stderr":    output.Stderr,
+		"exit_code": output.ExitCode,
+	}
+
+	return canonicalHash(out)
+}
+
+// Receipts returns the receipts of the execution results, ordered by worker ID.
+func (m ResultMap) Receipts() []Receipt {
+
+	var receipts []Receipt
+	for _, res := range m {
+		if res.Receipt != nil {
+			receipts = append(receipts, *res.Receipt)
+		}
+	}
+
+	sort.Slice(receipts, func(i, j int) bool {
+		return receipts[i].Worker.String() < receipts[j].Worker.String()
+	})
+
+	return receipts
+}
+
+func canonicalHash(v any) (string, error) {
+
+	payload, err := canonicalJSON(v)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(payload)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// canonicalValue returns the value as decoded from its JSON encoding, so that it consists of only maps, slices and primitive types.
+// Numbers are kept as they were encoded.
+func canonicalValue(v any) (any, error) {
+
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode value: %w", err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(payload))
+	dec.UseNumber()
+
+	var out any
+	err = dec.Decode(&out)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode value: %w", err)
+	}
+
+	return out, nil
+}
+
+// canonicalJSON encodes the value as JSON. Maps are encoded with sorted keys, so values consisting of only maps, slices
+// and primitive types have a single encoding.
+func canonicalJSON(v any) ([]byte, error) {
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	err := enc.Encode(v)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode value: %w", err)
+	}
+
+	// Encoder adds a trailing newline.
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,160 @@
+package execute
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/codes"
+)
+
+func TestReceipt(t *testing.T) {
+
+	priv, pub := newKey(t)
+	worker, err := peer.IDFromPublicKey(pub)
+	require.NoError(t, err)
+
+	stdin := "stdin-value"
+	var (
+		requestID = "request-id"
+		req       = Request{
+			FunctionID: "function-id",
+			Method:     "method-value",
+			Parameters: []Parameter{
+				{
+					Name:  "parameter-name",
+					Value: "parameter-value",
+				},
+			},
+			Config: Config{
+				Environment: []EnvVar{{Name: "env-name", Value: "env-value"}},
+				Stdin:       &stdin,
+			},
+		}
+		res = Result{
+			Code: codes.OK,
+			Result: RuntimeOutput{
+				Stdout:   "<generic-execution-result>",
+				Stderr:   "generic-execution-log",
+				ExitCode: 0,
+			},
+			Usage: Usage{
+				WallClockTime: time.Second,
+				CPUUserTime:   300 * time.Millisecond,
+				MemoryMaxKB:   2048,
+			},
+		}
+	)
+
+	newReceipt := func(t *testing.T) Receipt {
+		t.Helper()
+
+		receipt, err := NewReceipt(requestID, req, worker, res)
+		require.NoError(t, err)
+		require.NoError(t, receipt.Sign(priv))
+
+		return receipt
+	}
+
+	t.Run("nominal case", func(t *testing.T) {
+
+		receipt := newReceipt(t)
+		require.Equal(t, worker, receipt.Worker)
+		require.Equal(t, res.Usage, receipt.Usage)
+
+		require.NoError(t, receipt.VerifySignature())
+		require.NoError(t, receipt.Verify(requestID, req, res.Result))
+	})
+	t.Run("canonical payload is stable", func(t *testing.T) {
+
+		receipt := Receipt{
+			RequestID:  requestID,
+			FunctionID: "function-id",
+			Method:     "method-value",
+			InputHash:  "input-hash",
+			OutputHash: "output-hash",
+			Usage:      Usage{WallClockTime: time.Second, MemoryMaxKB: 2048},
+			Timestamp:  time.Date(2024, time.January, 1, 12, 0, 0, 500, time.FixedZone("CET", 3600)),
+			Worker:     worker,
+			Signature:  "ignored",
+		}
+
+		payload, err := receipt.CanonicalPayload()
+		require.NoError(t, err)
+
+		expected := `{"function_id":"function-id","input_hash":"input-hash","method":"method-value","output_hash":"output-hash",` +
+			`"request_id":"request-id","timestamp":"2024-01-01T11:00:00.0000005Z",` +
+			`"usage":{"cpu_sys_time":0,"cpu_user_time":0,"memory_max_kb":2048,"wall_clock_time":1000000000},` +
+			`"version":"b7s-receipt-v1","worker":"` + worker.String() + `"}`
+		require.Equal(t, expected, string(payload))
+	})
+	t.Run("receipt survives serialization", func(t *testing.T) {
+
+		receipt := newReceipt(t)
+
+		payload, err := json.Marshal(receipt)
+		require.NoError(t, err)
+
+		var decoded Receipt
+		require.NoError(t, json.Unmarshal(payload, &decoded))
+		require.NoError(t, decoded.Verify(requestID, req, res.Result))
+	})
+	t.Run("tampered receipt fails verification", func(t *testing.T) {
+
+		receipt := newReceipt(t)
+		receipt.Usage.WallClockTime *= 2
+
+		require.Error(t, receipt.VerifySignature())
+	})
+	t.Run("receipt signed by a different key fails verification", func(t *testing.T) {
+
+		other, _ := newKey(t)
+
+		receipt, err := NewReceipt(requestID, req, worker, res)
+		require.NoError(t, err)
+		require.NoError(t, receipt.Sign(other))
+
+		require.Error(t, receipt.VerifySignature())
+	})
+	t.Run("receipt for different inputs fails verification", func(t *testing.T) {
+
+		receipt := newReceipt(t)
+
+		other := req
+		other.Parameters = []Parameter{{Name: "parameter-name", Value: "other-value"}}
+		require.Error(t, receipt.Verify(requestID, other, res.Result))
+
+		other = req
+		other.Method = "other-method"
+		require.Error(t, receipt.Verify(requestID, other, res.Result))
+
+		require.Error(t, receipt.Verify("other-request-id", req, res.Result))
+	})
+	t.Run("receipt for different output fails verification", func(t *testing.T) {
+
+		receipt := newReceipt(t)
+
+		output := res.Result
+		output.Stdout += " "
+		require.Error(t, receipt.Verify(requestID, req, output))
+	})
+}
+
+func TestResultMap_Receipts(t *testing.T) {
+
+	var (
+		first  = Receipt{Worker: peer.ID("b")}
+		second = Receipt{Worker: peer.ID("a")}
+	)
+
+	results := ResultMap{
+		peer.ID("b"): {Receipt: &first},
+		peer.ID("a"): {Receipt: &second},
+		peer.ID("c"): {},
+	}
+
+	require.Equal(t, []Receipt{second, first}, results.Receipts())
+}
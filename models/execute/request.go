@@ -2,8 +2,11 @@ package execute
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/hashicorp/go-multierror"
+
+	"github.com/blessnetwork/b7s/consensus"
 )
 
 // Request describes an execution request.
@@ -15,6 +18,9 @@ type Request struct {
 
 	// Optional signature of the request.
 	Signature string `json:"signature,omitempty"`
+
+	// Client is the identity of the REST API client that submitted the request, set by the head node.
+	Client string `json:"client,omitempty"`
 }
 
 func (r Request) Valid() error {
@@ -29,6 +35,41 @@ func (r Request) Valid() error {
 		err = multierror.Append(err, errors.New("method is required"))
 	}
 
+	rerr := r.Config.Retry.Valid()
+	if rerr != nil {
+		err = multierror.Append(err, fmt.Errorf("invalid retry policy: %w", rerr))
+	}
+
+	cerr := r.Config.Completion.Valid()
+	if cerr != nil {
+		err = multierror.Append(err, fmt.Errorf("invalid completion policy: %w", cerr))
+	}
+
+	// Consensus algorithm that cannot be parsed is replaced with the default one by the head node.
+	c, perr := consensus.Parse(r.Config.ConsensusAlgorithm)
+	if perr == nil {
+
+		if c != 0 && r.Config.Retry.Enabled() {
+			err = multierror.Append(err, fmt.Errorf("retry policy is not supported with %v consensus", c))
+		}
+
+		if c == consensus.PBFT && !r.Config.Completion.WaitAll() {
+			err = multierror.Append(err, errors.New("completion policy is not supported with PBFT consensus"))
+		}
+	}
+
+	if r.Config.Attributes != nil {
+		aerr := r.Config.Attributes.Valid()
+		if aerr != nil {
+			err = multierror.Append(err, fmt.Errorf("invalid attributes: %w", aerr))
+		}
+	}
+
+	aerr := r.Config.ResultAggregation.Valid()
+	if aerr != nil {
+		err = multierror.Append(err, fmt.Errorf("invalid result aggregation: %w", aerr))
+	}
+
 	return err.ErrorOrNil()
 }
 
@@ -59,6 +100,15 @@ type Config struct {
 
 	// Threshold (percentage) defines how many nodes should respond with a result to consider this execution successful.
 	Threshold float64 `json:"threshold,omitempty"`
+
+	// Strategy used to choose which of the peers that reported for roll call will execute the request.
+	PeerSelection string `json:"peer_selection,omitempty"`
+
+	// Retry policy for replacing workers that failed to execute the request.
+	Retry RetryPolicy `json:"retry,omitempty"`
+
+	// Completion policy determines when the head node stops waiting for execution results.
+	Completion CompletionPolicy `json:"completion,omitempty"`
 }
 
 // EnvVar represents the name and value of the environment variables set for the execution.
@@ -67,8 +117,29 @@ type EnvVar struct {
 	Value string `json:"value,omitempty"`
 }
 
-type ResultAggregation struct {
-	Enable     bool        `json:"enable,omitempty"`
-	Type       string      `json:"type,omitempty"`
-	Parameters []Parameter `json:"parameters,omitempty"`
+// RetryPolicy describes how the head node replaces workers that did not respond to a work order or responded with an error.
+// Retries are only supported for executions without consensus.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of follow-up roll calls for replacement workers. Zero means failed workers are not replaced.
+	MaxAttempts int `json:"max_attempts,omitempty"`
+	// ReplacementBudget is the maximum number of replacement workers used across all attempts. Zero means there is no limit.
+	ReplacementBudget int `json:"replacement_budget,omitempty"`
+}
+
+// Enabled returns true if failed workers should be replaced.
+func (p RetryPolicy) Enabled() bool {
+	return p.MaxAttempts > 0
+}
+
+func (p RetryPolicy) Valid() error {
+
+	if p.MaxAttempts < 0 {
+		return errors.New("max attempts cannot be negative")
+	}
+
+	if p.ReplacementBudget < 0 {
+		return errors.New("replacement budget cannot be negative")
+	}
+
+	return nil
 }
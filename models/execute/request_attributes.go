@@ -1,14 +1,24 @@
 package execute
 
 import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/go-multierror"
 	"github.com/libp2p/go-libp2p/core/peer"
 )
 
 type Attributes struct {
-	// Values specify which attributes the node in question should have.
-	// At the moment we support strict equality only, so no `if RAM >= 16GB` types of conditions.
+	// Values specify which attributes the node in question should have, with exactly these values.
+	// For conditions like `if RAM >= 16GB`, use predicates.
 	Values []Parameter `json:"values,omitempty"`
 
+	// Predicates specify conditions the attributes of the node should satisfy.
+	Predicates []AttributePredicate `json:"predicates,omitempty"`
+
 	// Should we accept nodes whose attributes are not attested?
 	AttestationRequired bool `json:"attestation_required,omitempty"`
 
@@ -23,3 +33,156 @@ type AttributeAttestors struct {
 	// Any one of these attestors should be found.
 	OneOf []peer.ID `json:"one_of,omitempty"`
 }
+
+func (a Attributes) Valid() error {
+
+	var err *multierror.Error
+	for i, predicate := range a.Predicates {
+		perr := predicate.Valid()
+		if perr != nil {
+			err = multierror.Append(err, fmt.Errorf("predicate %d: %w", i, perr))
+		}
+	}
+
+	return err.ErrorOrNil()
+}
+
+// AttributeOperator determines how the value of a node attribute is compared.
+type AttributeOperator string
+
+const (
+	// AttributeEqual requires the attribute to have the given value.
+	AttributeEqual AttributeOperator = "=="
+	// AttributeNotEqual requires the attribute to have a value different from the given one.
+	AttributeNotEqual AttributeOperator = "!="
+	// AttributeAtLeast requires the attribute to be a number greater than or equal to the given one.
+	AttributeAtLeast AttributeOperator = ">="
+	// AttributeAtMost requires the attribute to be a number less than or equal to the given one.
+	AttributeAtMost AttributeOperator = "<="
+	// AttributeIn requires the attribute value to match one of the listed values. Values can be glob patterns, e.g. `eu-*`.
+	AttributeIn AttributeOperator = "in"
+	// AttributeSemver requires the attribute to be a semantic version within the given range, e.g. `>=1.2.0 <2.0.0` or `^1.2.0`.
+	AttributeSemver AttributeOperator = "semver"
+	// AttributeRegex requires the attribute value to match the given regular expression.
+	AttributeRegex AttributeOperator = "regex"
+)
+
+// AttributePredicate is a condition the value of a node attribute should satisfy.
+// Nodes that do not have the attribute never satisfy the predicate.
+type AttributePredicate struct {
+	Name     string            `json:"name"`
+	Operator AttributeOperator `json:"op"`
+	// Value the attribute is compared to. Not used for `in` predicates.
+	Value string `json:"value,omitempty"`
+	// Values accepted by `in` predicates.
+	Values []string `json:"values,omitempty"`
+}
+
+func (p AttributePredicate) Valid() error {
+
+	if p.Name == "" {
+		return errors.New("attribute name is required")
+	}
+
+	switch p.Operator {
+	case AttributeEqual, AttributeNotEqual:
+		return nil
+
+	case AttributeAtLeast, AttributeAtMost:
+		_, err := strconv.ParseFloat(p.Value, 64)
+		if err != nil {
+			return fmt.Errorf("value must be a number (%s): %w", p.Operator, err)
+		}
+		return nil
+
+	case AttributeIn:
+		if len(p.Values) == 0 {
+			return errors.New("values are required for in predicates")
+		}
+		for _, value := range p.Values {
+			_, err := path.Match(value, "")
+			if err != nil {
+				return fmt.Errorf("invalid pattern (%s): %w", value, err)
+			}
+		}
+		return nil
+
+	case AttributeSemver:
+		_, err := parseSemverRange(p.Value)
+		if err != nil {
+			return fmt.Errorf("invalid version range: %w", err)
+		}
+		return nil
+
+	case AttributeRegex:
+		_, err := regexp.Compile(p.Value)
+		if err != nil {
+			return fmt.Errorf("invalid regular expression: %w", err)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown operator (%s)", p.Operator)
+	}
+}
+
+// Matches returns true if the attribute value satisfies the predicate.
+// An error is returned if the predicate is invalid, or if the value cannot be compared, e.g. a number was expected.
+func (p AttributePredicate) Matches(value string) (bool, error) {
+
+	switch p.Operator {
+	case AttributeEqual:
+		return value == p.Value, nil
+
+	case AttributeNotEqual:
+		return value != p.Value, nil
+
+	case AttributeAtLeast, AttributeAtMost:
+		want, err := strconv.ParseFloat(p.Value, 64)
+		if err != nil {
+			return false, fmt.Errorf("invalid predicate value: %w", err)
+		}
+		have, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return false, fmt.Errorf("attribute value is not a number: %w", err)
+		}
+
+		if p.Operator == AttributeAtLeast {
+			return have >= want, nil
+		}
+		return have <= want, nil
+
+	case AttributeIn:
+		for _, pattern := range p.Values {
+			ok, err := path.Match(pattern, value)
+			if err != nil {
+				return false, fmt.Errorf("invalid pattern (%s): %w", pattern, err)
+			}
+			if ok {
+				return true, nil
+			}
+		}
+		return false, nil
+
+	case AttributeSemver:
+		rng, err := parseSemverRange(p.Value)
+		if err != nil {
+			return false, fmt.Errorf("invalid version range: %w", err)
+		}
+		version, err := parseSemver(value)
+		if err != nil {
+			return false, fmt.Errorf("attribute value is not a version: %w", err)
+		}
+		return rng.contains(version), nil
+
+	case AttributeRegex:
+		re, err := regexp.Compile(p.Value)
+		if err != nil {
+			return false, fmt.Errorf("invalid regular expression: %w", err)
+		}
+		return re.MatchString(value), nil
+
+	default:
+		return false, fmt.Errorf("unknown operator (%s)", p.Operator)
+	}
+}
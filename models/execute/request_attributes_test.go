@@ -0,0 +1,79 @@
+package execute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttributePredicate_Matches(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		predicate AttributePredicate
+		value     string
+		match     bool
+	}{
+		{name: "equal", predicate: AttributePredicate{Operator: AttributeEqual, Value: "x86"}, value: "x86", match: true},
+		{name: "not equal", predicate: AttributePredicate{Operator: AttributeNotEqual, Value: "x86"}, value: "x86"},
+		{name: "at least", predicate: AttributePredicate{Operator: AttributeAtLeast, Value: "16"}, value: "24", match: true},
+		{name: "at least - equal", predicate: AttributePredicate{Operator: AttributeAtLeast, Value: "16"}, value: "16", match: true},
+		{name: "at least - lower", predicate: AttributePredicate{Operator: AttributeAtLeast, Value: "16"}, value: "8"},
+		{name: "at most", predicate: AttributePredicate{Operator: AttributeAtMost, Value: "0.5"}, value: "0.25", match: true},
+		{name: "in - glob", predicate: AttributePredicate{Operator: AttributeIn, Values: []string{"us-east-1", "eu-*"}}, value: "eu-west-2", match: true},
+		{name: "in - no match", predicate: AttributePredicate{Operator: AttributeIn, Values: []string{"us-east-1", "eu-*"}}, value: "ap-south-1"},
+		{name: "semver range", predicate: AttributePredicate{Operator: AttributeSemver, Value: ">=1.2.0 <2.0.0"}, value: "v1.10.3", match: true},
+		{name: "semver caret", predicate: AttributePredicate{Operator: AttributeSemver, Value: "^1.2.0"}, value: "2.0.0-beta.1"},
+		{name: "semver tilde", predicate: AttributePredicate{Operator: AttributeSemver, Value: "~1.2.0"}, value: "1.3.0"},
+		{name: "semver alternatives", predicate: AttributePredicate{Operator: AttributeSemver, Value: "^1.2.0 || ^3.0.0"}, value: "3.4.5", match: true},
+		{name: "semver pre-release", predicate: AttributePredicate{Operator: AttributeSemver, Value: ">1.0.0-alpha.1"}, value: "1.0.0-alpha.beta", match: true},
+		{name: "regex", predicate: AttributePredicate{Operator: AttributeRegex, Value: "^nvidia-(a|h)100$"}, value: "nvidia-h100", match: true},
+		{name: "regex - no match", predicate: AttributePredicate{Operator: AttributeRegex, Value: "^nvidia-(a|h)100$"}, value: "nvidia-h1000"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			match, err := test.predicate.Matches(test.value)
+			require.NoError(t, err)
+			require.Equal(t, test.match, match)
+		})
+	}
+
+	t.Run("value not a number", func(t *testing.T) {
+		_, err := AttributePredicate{Operator: AttributeAtLeast, Value: "16"}.Matches("16GB")
+		require.Error(t, err)
+	})
+	t.Run("value not a version", func(t *testing.T) {
+		_, err := AttributePredicate{Operator: AttributeSemver, Value: "^1.0.0"}.Matches("1.0")
+		require.Error(t, err)
+	})
+}
+
+func TestAttributePredicate_Valid(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		predicate AttributePredicate
+		valid     bool
+	}{
+		{name: "valid", predicate: AttributePredicate{Name: "ram", Operator: AttributeAtLeast, Value: "16"}, valid: true},
+		{name: "missing name", predicate: AttributePredicate{Operator: AttributeEqual, Value: "x"}},
+		{name: "unknown operator", predicate: AttributePredicate{Name: "ram", Operator: ">", Value: "16"}},
+		{name: "non-numeric value", predicate: AttributePredicate{Name: "ram", Operator: AttributeAtMost, Value: "16GB"}},
+		{name: "in without values", predicate: AttributePredicate{Name: "region", Operator: AttributeIn}},
+		{name: "invalid glob", predicate: AttributePredicate{Name: "region", Operator: AttributeIn, Values: []string{"eu-["}}},
+		{name: "invalid version range", predicate: AttributePredicate{Name: "version", Operator: AttributeSemver, Value: ">=1.2"}},
+		{name: "invalid regex", predicate: AttributePredicate{Name: "gpu", Operator: AttributeRegex, Value: "("}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.predicate.Valid()
+			if test.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
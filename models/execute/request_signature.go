@@ -9,14 +9,36 @@ import (
 	"github.com/libp2p/go-libp2p/core/crypto"
 )
 
-func (e *Request) Sign(key crypto.PrivKey) error {
+// RequestVersion identifies the canonical encoding used for request signatures.
+const RequestVersion = "b7s-request-v1"
+
+// CanonicalPayload returns the byte representation of the request that is signed. It is a JSON object with keys
+// sorted lexicographically and no insignificant whitespace. Object members with empty values - null, false, zero,
+// empty strings, arrays and objects - are omitted, so the payload does not depend on which optional fields are encoded.
+func (e Request) CanonicalPayload() ([]byte, error) {
 
-	cp := *e
-	e.Signature = ""
+	payload := map[string]any{
+		"version":     RequestVersion,
+		"function_id": e.FunctionID,
+		"method":      e.Method,
+		"parameters":  e.Parameters,
+		"config":      e.Config,
+		"client":      e.Client,
+	}
 
-	payload, err := json.Marshal(cp)
+	value, err := canonicalValue(payload)
 	if err != nil {
-		return fmt.Errorf("could not get byte representation of the record: %w", err)
+		return nil, err
+	}
+
+	return canonicalJSON(omitEmpty(value))
+}
+
+func (e *Request) Sign(key crypto.PrivKey) error {
+
+	payload, err := e.CanonicalPayload()
+	if err != nil {
+		return fmt.Errorf("could not get byte representation of the request: %w", err)
 	}
 
 	sig, err := key.Sign(payload)
@@ -30,13 +52,9 @@ func (e *Request) Sign(key crypto.PrivKey) error {
 
 func (e Request) VerifySignature(key crypto.PubKey) error {
 
-	// Exclude signature and the `from` field from the signature.
-	cp := e
-	cp.Signature = ""
-
-	payload, err := json.Marshal(cp)
+	payload, err := e.CanonicalPayload()
 	if err != nil {
-		return fmt.Errorf("could not get byte representation of the record: %w", err)
+		return fmt.Errorf("could not get byte representation of the request: %w", err)
 	}
 
 	sig, err := hex.DecodeString(e.Signature)
@@ -55,3 +73,49 @@ func (e Request) VerifySignature(key crypto.PubKey) error {
 
 	return nil
 }
+
+// omitEmpty removes object members with empty values from the decoded JSON value. Array elements are kept, so their positions do not change.
+func omitEmpty(v any) any {
+
+	switch value := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(value))
+		for key, member := range value {
+			member = omitEmpty(member)
+			if !isEmpty(member) {
+				out[key] = member
+			}
+		}
+		return out
+
+	case []any:
+		for i := range value {
+			value[i] = omitEmpty(value[i])
+		}
+		return value
+
+	default:
+		return v
+	}
+}
+
+func isEmpty(v any) bool {
+
+	switch value := v.(type) {
+	case nil:
+		return true
+	case bool:
+		return !value
+	case string:
+		return value == ""
+	case json.Number:
+		f, err := value.Float64()
+		return err == nil && f == 0
+	case map[string]any:
+		return len(value) == 0
+	case []any:
+		return len(value) == 0
+	default:
+		return false
+	}
+}
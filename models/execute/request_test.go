@@ -0,0 +1,53 @@
+package execute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequest_Valid(t *testing.T) {
+
+	request := func(consensus string, retry RetryPolicy, completion CompletionPolicy) Request {
+		return Request{
+			FunctionID: "function-id",
+			Method:     "method-value",
+			Config: Config{
+				ConsensusAlgorithm: consensus,
+				Retry:              retry,
+				Completion:         completion,
+			},
+		}
+	}
+
+	var (
+		retry     = RetryPolicy{MaxAttempts: 2}
+		threshold = CompletionPolicy{Type: CompletionThreshold}
+	)
+
+	tests := []struct {
+		name    string
+		request Request
+		valid   bool
+	}{
+		{name: "no consensus", request: request("", RetryPolicy{}, CompletionPolicy{}), valid: true},
+		{name: "retry without consensus", request: request("", retry, CompletionPolicy{}), valid: true},
+		{name: "completion without consensus", request: request("", RetryPolicy{}, threshold), valid: true},
+		{name: "retry with raft", request: request("raft", retry, CompletionPolicy{})},
+		{name: "retry with pbft", request: request("pbft", retry, CompletionPolicy{})},
+		{name: "completion with raft", request: request("raft", RetryPolicy{}, threshold), valid: true},
+		{name: "completion with pbft", request: request("pbft", RetryPolicy{}, threshold)},
+		{name: "wait all with pbft", request: request("pbft", RetryPolicy{}, CompletionPolicy{Type: CompletionWaitAll}), valid: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.request.Valid()
+			if test.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -13,13 +13,19 @@ import (
 	"github.com/blessnetwork/b7s/models/codes"
 )
 
+// ResultVersion identifies the canonical encoding used for execution result signatures.
+const ResultVersion = "b7s-result-v1"
+
 // NodeResult is an annotated execution result.
+// Signature covers the canonical encoding of the result (see `CanonicalPayload`).
 type NodeResult struct {
 	Result
 
-	Signature string         `json:"signature,omitempty"` // Signed digest of the response.
-	PBFT      PBFTResultInfo `json:"pbft,omitempty"`
-	Metadata  any            `json:"metadata,omitempty"`
+	Signature  string         `json:"signature,omitempty"` // Signed digest of the response.
+	PBFT       PBFTResultInfo `json:"pbft,omitempty"`
+	Metadata   any            `json:"metadata,omitempty"`
+	UsageEntry *UsageEntry    `json:"usage_entry,omitempty"` // Resource usage, signed by the worker.
+	Receipt    *Receipt       `json:"receipt,omitempty"`     // Execution receipt, signed by the worker.
 }
 
 // Result describes an execution result.
@@ -33,6 +39,8 @@ type Result struct {
 type Cluster struct {
 	Main  peer.ID   `json:"main,omitempty"`
 	Peers []peer.ID `json:"peers,omitempty"`
+	// Late lists peers that did not respond before the completion policy was satisfied.
+	Late []peer.ID `json:"late,omitempty"`
 }
 
 // RuntimeOutput describes the output produced by the Bless Runtime during execution.
@@ -75,15 +83,51 @@ func (m ResultMap) MarshalJSON() ([]byte, error) {
 	return json.Marshal(em)
 }
 
-func (r *NodeResult) Sign(key crypto.PrivKey) error {
+// CanonicalPayload returns the byte representation of the result that is signed. It is a JSON object
+// with keys sorted lexicographically and no insignificant whitespace. Durations are integers in nanoseconds,
+// and timestamps are in RFC 3339 format, in UTC. Usage entry and receipt are covered by their signatures.
+func (r NodeResult) CanonicalPayload() ([]byte, error) {
+
+	metadata, err := canonicalValue(r.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode metadata: %w", err)
+	}
+
+	var usageSignature, receiptSignature string
+	if r.UsageEntry != nil {
+		usageSignature = r.UsageEntry.Signature
+	}
+	if r.Receipt != nil {
+		receiptSignature = r.Receipt.Signature
+	}
+
+	payload := map[string]any{
+		"version": ResultVersion,
+		"code":    string(r.Code),
+		"result": map[string]any{
+			"stdout":    r.Result.Result.Stdout,
+			"stderr":    r.Result.Result.Stderr,
+			"exit_code": r.Result.Result.ExitCode,
+		},
+		"usage": canonicalUsage(r.Usage),
+		"pbft": map[string]any{
+			"view":              r.PBFT.View,
+			"request_timestamp": r.PBFT.RequestTimestamp.UTC().Format(time.RFC3339Nano),
+			"replica":           r.PBFT.Replica.String(),
+		},
+		"metadata":          metadata,
+		"usage_signature":   usageSignature,
+		"receipt_signature": receiptSignature,
+	}
 
-	cp := *r
-	// Exclude some of the fields from the signature.
-	cp.Signature = ""
+	return canonicalJSON(payload)
+}
 
-	payload, err := json.Marshal(cp)
+func (r *NodeResult) Sign(key crypto.PrivKey) error {
+
+	payload, err := r.CanonicalPayload()
 	if err != nil {
-		return fmt.Errorf("could not get byte representation of the record: %w", err)
+		return fmt.Errorf("could not get byte representation of the result: %w", err)
 	}
 
 	sig, err := key.Sign(payload)
@@ -97,13 +141,9 @@ func (r *NodeResult) Sign(key crypto.PrivKey) error {
 
 func (r NodeResult) VerifySignature(key crypto.PubKey) error {
 
-	cp := r
-	// Exclude some of the fields from the signature.
-	cp.Signature = ""
-
-	payload, err := json.Marshal(cp)
+	payload, err := r.CanonicalPayload()
 	if err != nil {
-		return fmt.Errorf("could not get byte representation of the record: %w", err)
+		return fmt.Errorf("could not get byte representation of the result: %w", err)
 	}
 
 	sig, err := hex.DecodeString(r.Signature)
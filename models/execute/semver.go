@@ -0,0 +1,212 @@
+package execute
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// semver is a semantic version, as described in https://semver.org. Build metadata is ignored.
+type semver struct {
+	major, minor, patch uint64
+	pre                 []string
+}
+
+func parseSemver(expr string) (semver, error) {
+
+	expr = strings.TrimPrefix(strings.TrimSpace(expr), "v")
+	expr, _, _ = strings.Cut(expr, "+")
+
+	core, pre, hasPre := strings.Cut(expr, "-")
+
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return semver{}, fmt.Errorf("invalid version: %q", expr)
+	}
+
+	var numbers [3]uint64
+	for i, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return semver{}, fmt.Errorf("invalid version: %q", expr)
+		}
+		numbers[i] = n
+	}
+
+	v := semver{major: numbers[0], minor: numbers[1], patch: numbers[2]}
+	if hasPre {
+		if pre == "" {
+			return semver{}, fmt.Errorf("invalid version: %q", expr)
+		}
+		v.pre = strings.Split(pre, ".")
+	}
+
+	return v, nil
+}
+
+func (v semver) compare(o semver) int {
+
+	c := cmp.Or(
+		cmp.Compare(v.major, o.major),
+		cmp.Compare(v.minor, o.minor),
+		cmp.Compare(v.patch, o.patch),
+	)
+	if c != 0 {
+		return c
+	}
+
+	// A pre-release version has lower precedence than the release.
+	switch {
+	case len(v.pre) == 0 && len(o.pre) == 0:
+		return 0
+	case len(v.pre) == 0:
+		return 1
+	case len(o.pre) == 0:
+		return -1
+	}
+
+	for i := 0; i < min(len(v.pre), len(o.pre)); i++ {
+		c := comparePrerelease(v.pre[i], o.pre[i])
+		if c != 0 {
+			return c
+		}
+	}
+
+	return cmp.Compare(len(v.pre), len(o.pre))
+}
+
+// comparePrerelease compares pre-release identifiers. Numeric identifiers are compared numerically and have lower precedence than alphanumeric ones.
+func comparePrerelease(a, b string) int {
+
+	an, aerr := strconv.ParseUint(a, 10, 64)
+	bn, berr := strconv.ParseUint(b, 10, 64)
+
+	switch {
+	case aerr == nil && berr == nil:
+		return cmp.Compare(an, bn)
+	case aerr == nil:
+		return -1
+	case berr == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// semverRange is a set of alternatives separated by `||`. Each alternative is a space-separated list of comparators that all must be satisfied.
+// Supported comparators are `=`, `>`, `>=`, `<`, `<=`, `^` (compatible with version) and `~` (same minor version), e.g. `>=1.2.0 <2.0.0 || ^3.1.0`.
+type semverRange [][]semverComparator
+
+type semverComparator struct {
+	op      string
+	version semver
+}
+
+func parseSemverRange(expr string) (semverRange, error) {
+
+	var rng semverRange
+	for _, alternative := range strings.Split(expr, "||") {
+
+		fields := strings.Fields(alternative)
+		if len(fields) == 0 {
+			return nil, errors.New("empty version range")
+		}
+
+		var comparators []semverComparator
+		for _, field := range fields {
+			c, err := parseSemverComparator(field)
+			if err != nil {
+				return nil, err
+			}
+
+			comparators = append(comparators, c...)
+		}
+
+		rng = append(rng, comparators)
+	}
+
+	return rng, nil
+}
+
+func parseSemverComparator(expr string) ([]semverComparator, error) {
+
+	op := "="
+	for _, prefix := range []string{">=", "<=", ">", "<", "=", "^", "~"} {
+		if strings.HasPrefix(expr, prefix) {
+			op = prefix
+			expr = strings.TrimPrefix(expr, prefix)
+			break
+		}
+	}
+
+	v, err := parseSemver(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Upper bounds exclude pre-releases of the next version.
+	var upper semver
+	switch op {
+	case "^":
+		switch {
+		case v.major > 0:
+			upper = semver{major: v.major + 1}
+		case v.minor > 0:
+			upper = semver{minor: v.minor + 1}
+		default:
+			upper = semver{patch: v.patch + 1}
+		}
+
+	case "~":
+		upper = semver{major: v.major, minor: v.minor + 1}
+
+	default:
+		return []semverComparator{{op: op, version: v}}, nil
+	}
+
+	upper.pre = []string{"0"}
+
+	return []semverComparator{{op: ">=", version: v}, {op: "<", version: upper}}, nil
+}
+
+func (r semverRange) contains(v semver) bool {
+
+	for _, alternative := range r {
+		if allSatisfied(alternative, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func allSatisfied(comparators []semverComparator, v semver) bool {
+
+	for _, c := range comparators {
+		if !c.satisfied(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c semverComparator) satisfied(v semver) bool {
+
+	n := v.compare(c.version)
+
+	switch c.op {
+	case ">=":
+		return n >= 0
+	case "<=":
+		return n <= 0
+	case ">":
+		return n > 0
+	case "<":
+		return n < 0
+	default:
+		return n == 0
+	}
+}
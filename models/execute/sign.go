@@ -0,0 +1,65 @@
+package execute
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// SignExecution returns the usage entry and the receipt for the execution of the request, signed with the key of the worker
+// that executed it. The usage entry lets the head node record the resource usage in its usage ledger, and is nil if the execution
+// reported no resource usage. The receipt lets third parties audit the result. Each is nil if it could not be signed, in which
+// case the returned error says why.
+func SignExecution(key crypto.PrivKey, requestID string, req Request, res Result) (*UsageEntry, *Receipt, error) {
+
+	worker, err := peer.IDFromPrivateKey(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not derive worker ID from key: %w", err)
+	}
+
+	var errs *multierror.Error
+
+	entry, err := signUsageEntry(key, worker, requestID, req, res)
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("could not sign usage entry: %w", err))
+	}
+
+	receipt, err := signReceipt(key, worker, requestID, req, res)
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("could not sign receipt: %w", err))
+	}
+
+	return entry, receipt, errs.ErrorOrNil()
+}
+
+func signUsageEntry(key crypto.PrivKey, worker peer.ID, requestID string, req Request, res Result) (*UsageEntry, error) {
+
+	if res.Usage == (Usage{}) {
+		return nil, nil
+	}
+
+	entry := NewUsageEntry(requestID, req, worker, res.Usage)
+	err := entry.Sign(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+func signReceipt(key crypto.PrivKey, worker peer.ID, requestID string, req Request, res Result) (*Receipt, error) {
+
+	receipt, err := NewReceipt(requestID, req, worker, res)
+	if err != nil {
+		return nil, fmt.Errorf("could not create receipt: %w", err)
+	}
+
+	err = receipt.Sign(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &receipt, nil
+}
@@ -0,0 +1,58 @@
+package execute
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignExecution(t *testing.T) {
+
+	priv, pub := newKey(t)
+	worker, err := peer.IDFromPublicKey(pub)
+	require.NoError(t, err)
+
+	const requestID = "request-id"
+
+	req := Request{
+		FunctionID: "function-id",
+		Method:     "method-value",
+		Client:     "client-id",
+	}
+	res := Result{
+		Result: RuntimeOutput{Stdout: "generic-execution-result"},
+		Usage: Usage{
+			WallClockTime: time.Second,
+			CPUUserTime:   300 * time.Millisecond,
+		},
+	}
+
+	t.Run("nominal case", func(t *testing.T) {
+
+		entry, receipt, err := SignExecution(priv, requestID, req, res)
+		require.NoError(t, err)
+
+		require.NotNil(t, entry)
+		require.Equal(t, worker, entry.Worker)
+		require.Equal(t, res.Usage, entry.Usage)
+		require.NoError(t, entry.VerifySignature())
+
+		require.NotNil(t, receipt)
+		require.Equal(t, worker, receipt.Worker)
+		require.NoError(t, receipt.Verify(requestID, req, res.Result))
+	})
+	t.Run("no resource usage", func(t *testing.T) {
+
+		noUsage := res
+		noUsage.Usage = Usage{}
+
+		entry, receipt, err := SignExecution(priv, requestID, req, noUsage)
+		require.NoError(t, err)
+
+		require.Nil(t, entry)
+		require.NotNil(t, receipt)
+		require.NoError(t, receipt.VerifySignature())
+	})
+}
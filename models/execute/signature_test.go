@@ -1,7 +1,9 @@
 package execute
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/blessnetwork/b7s/models/codes"
 	"github.com/libp2p/go-libp2p/core/crypto"
@@ -33,6 +35,51 @@ func TestRequestExecute_Signing(t *testing.T) {
 		err = req.VerifySignature(pub)
 		require.NoError(t, err)
 	})
+	t.Run("canonical payload is stable", func(t *testing.T) {
+
+		req := sampleReq
+		req.Config.NodeCount = 3
+		req.Config.Retry = RetryPolicy{MaxAttempts: 2}
+		req.Signature = "ignored"
+
+		payload, err := req.CanonicalPayload()
+		require.NoError(t, err)
+
+		expected := `{"config":{"number_of_nodes":3,"retry":{"max_attempts":2}},"function_id":"function-di","method":"method-value",` +
+			`"parameters":[{"name":"parameter-name","value":"parameter-value"}],"version":"b7s-request-v1"}`
+		require.Equal(t, expected, string(payload))
+	})
+	t.Run("signature does not depend on encoding of empty fields", func(t *testing.T) {
+
+		req := sampleReq
+		priv, pub := newKey(t)
+
+		err := req.Sign(priv)
+		require.NoError(t, err)
+
+		// Request as sent by a client that only encodes the fields it sets.
+		encoded := `{"function_id":"function-di","method":"method-value","parameters":[{"name":"parameter-name","value":"parameter-value"}],` +
+			`"signature":"` + req.Signature + `"}`
+
+		var decoded Request
+		require.NoError(t, json.Unmarshal([]byte(encoded), &decoded))
+		require.NoError(t, decoded.VerifySignature(pub))
+	})
+	t.Run("re-signing replaces existing signature", func(t *testing.T) {
+
+		req := sampleReq
+		priv, pub := newKey(t)
+		other, _ := newKey(t)
+
+		err := req.Sign(other)
+		require.NoError(t, err)
+
+		err = req.Sign(priv)
+		require.NoError(t, err)
+
+		err = req.VerifySignature(pub)
+		require.NoError(t, err)
+	})
 	t.Run("empty signature verification fails", func(t *testing.T) {
 
 		req := sampleReq
@@ -105,6 +152,42 @@ func TestResultExecute_Signing(t *testing.T) {
 		err = res.VerifySignature(pub)
 		require.Error(t, err)
 	})
+	t.Run("tampered receipt signature verification fails", func(t *testing.T) {
+
+		res := sampleRes
+		res.Receipt = &Receipt{Signature: "receipt-signature"}
+		priv, pub := newKey(t)
+
+		err := res.Sign(priv)
+		require.NoError(t, err)
+
+		res.Receipt = &Receipt{Signature: "other-signature"}
+
+		err = res.VerifySignature(pub)
+		require.Error(t, err)
+	})
+	t.Run("result survives serialization", func(t *testing.T) {
+
+		type metadata struct {
+			Name  string  `json:"name"`
+			Value float64 `json:"value"`
+		}
+
+		res := sampleRes
+		res.Metadata = metadata{Name: "dummy-name", Value: 0.1}
+		res.PBFT = PBFTResultInfo{View: 2, RequestTimestamp: time.Now()}
+		priv, pub := newKey(t)
+
+		err := res.Sign(priv)
+		require.NoError(t, err)
+
+		payload, err := json.Marshal(res)
+		require.NoError(t, err)
+
+		var decoded NodeResult
+		require.NoError(t, json.Unmarshal(payload, &decoded))
+		require.NoError(t, decoded.VerifySignature(pub))
+	})
 }
 
 func newKey(t *testing.T) (crypto.PrivKey, crypto.PubKey) {
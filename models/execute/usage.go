@@ -0,0 +1,106 @@
+package execute
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// UsageEntryVersion identifies the canonical encoding used for usage entry signatures.
+const UsageEntryVersion = "b7s-usage-v1"
+
+// UsageEntry records the resource usage of an execution on a single worker.
+// Worker signs the canonical encoding of the entry (see `CanonicalPayload`) so the recorded usage cannot be inflated later.
+type UsageEntry struct {
+	RequestID  string    `json:"request_id"`
+	FunctionID string    `json:"function_id"`
+	Client     string    `json:"client,omitempty"`
+	Worker     peer.ID   `json:"worker"`
+	Usage      Usage     `json:"usage"`
+	Timestamp  time.Time `json:"timestamp"`
+	Signature  string    `json:"signature,omitempty"`
+}
+
+// NewUsageEntry creates a usage entry for the execution of the request on the given worker.
+func NewUsageEntry(requestID string, req Request, worker peer.ID, usage Usage) UsageEntry {
+
+	entry := UsageEntry{
+		RequestID:  requestID,
+		FunctionID: req.FunctionID,
+		Client:     req.Client,
+		Worker:     worker,
+		Usage:      usage,
+		Timestamp:  time.Now().UTC(),
+	}
+
+	return entry
+}
+
+// CanonicalPayload returns the byte representation of the usage entry that is signed. It is a JSON object
+// with keys sorted lexicographically and no insignificant whitespace. Durations are integers in nanoseconds,
+// and the timestamp is in RFC 3339 format, in UTC.
+func (u UsageEntry) CanonicalPayload() ([]byte, error) {
+
+	payload := map[string]any{
+		"version":     UsageEntryVersion,
+		"request_id":  u.RequestID,
+		"function_id": u.FunctionID,
+		"client":      u.Client,
+		"worker":      u.Worker.String(),
+		"usage":       canonicalUsage(u.Usage),
+		"timestamp":   u.Timestamp.UTC().Format(time.RFC3339Nano),
+	}
+
+	return canonicalJSON(payload)
+}
+
+// Sign signs the entry with the key of the worker.
+func (u *UsageEntry) Sign(key crypto.PrivKey) error {
+
+	payload, err := u.CanonicalPayload()
+	if err != nil {
+		return fmt.Errorf("could not get byte representation of the usage entry: %w", err)
+	}
+
+	sig, err := key.Sign(payload)
+	if err != nil {
+		return fmt.Errorf("could not sign digest: %w", err)
+	}
+
+	u.Signature = hex.EncodeToString(sig)
+	return nil
+}
+
+// VerifySignature verifies that the entry was signed by the worker it names.
+func (u UsageEntry) VerifySignature() error {
+
+	key, err := u.Worker.ExtractPublicKey()
+	if err != nil {
+		return fmt.Errorf("could not derive public key from worker ID: %w", err)
+	}
+
+	payload, err := u.CanonicalPayload()
+	if err != nil {
+		return fmt.Errorf("could not get byte representation of the usage entry: %w", err)
+	}
+
+	sig, err := hex.DecodeString(u.Signature)
+	if err != nil {
+		return fmt.Errorf("could not decode signature from hex: %w", err)
+	}
+
+	ok, err := key.Verify(payload, sig)
+	if err != nil {
+		return fmt.Errorf("could not verify signature: %w", err)
+	}
+
+	if !ok {
+		return errors.New("invalid signature")
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package execute
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsageEntry_Signing(t *testing.T) {
+
+	priv, pub := newKey(t)
+	worker, err := peer.IDFromPublicKey(pub)
+	require.NoError(t, err)
+
+	req := Request{
+		FunctionID: "function-id",
+		Method:     "method-value",
+		Client:     "client-id",
+	}
+	usage := Usage{
+		WallClockTime: time.Second,
+		CPUUserTime:   300 * time.Millisecond,
+		CPUSysTime:    100 * time.Millisecond,
+		MemoryMaxKB:   2048,
+	}
+
+	t.Run("nominal case", func(t *testing.T) {
+
+		entry := NewUsageEntry("request-id", req, worker, usage)
+		require.Equal(t, req.FunctionID, entry.FunctionID)
+		require.Equal(t, req.Client, entry.Client)
+
+		err := entry.Sign(priv)
+		require.NoError(t, err)
+		require.NotEmpty(t, entry.Signature)
+
+		err = entry.VerifySignature()
+		require.NoError(t, err)
+	})
+	t.Run("canonical payload is stable", func(t *testing.T) {
+
+		entry := UsageEntry{
+			RequestID:  "request-id",
+			FunctionID: "function-id",
+			Client:     "client-id",
+			Worker:     worker,
+			Usage:      Usage{WallClockTime: time.Second, MemoryMaxKB: 2048},
+			Timestamp:  time.Date(2024, time.January, 1, 12, 0, 0, 500, time.FixedZone("CET", 3600)),
+			Signature:  "ignored",
+		}
+
+		payload, err := entry.CanonicalPayload()
+		require.NoError(t, err)
+
+		expected := `{"client":"client-id","function_id":"function-id","request_id":"request-id","timestamp":"2024-01-01T11:00:00.0000005Z",` +
+			`"usage":{"cpu_sys_time":0,"cpu_user_time":0,"memory_max_kb":2048,"wall_clock_time":1000000000},` +
+			`"version":"b7s-usage-v1","worker":"` + worker.String() + `"}`
+		require.Equal(t, expected, string(payload))
+	})
+	t.Run("entry survives serialization", func(t *testing.T) {
+
+		entry := NewUsageEntry("request-id", req, worker, usage)
+		require.NoError(t, entry.Sign(priv))
+
+		payload, err := json.Marshal(entry)
+		require.NoError(t, err)
+
+		var decoded UsageEntry
+		require.NoError(t, json.Unmarshal(payload, &decoded))
+		require.NoError(t, decoded.VerifySignature())
+	})
+	t.Run("tampered usage signature verification fails", func(t *testing.T) {
+
+		entry := NewUsageEntry("request-id", req, worker, usage)
+		err := entry.Sign(priv)
+		require.NoError(t, err)
+
+		entry.Usage.CPUUserTime *= 2
+
+		err = entry.VerifySignature()
+		require.Error(t, err)
+	})
+	t.Run("entry signed by a different worker fails verification", func(t *testing.T) {
+
+		other, _ := newKey(t)
+
+		entry := NewUsageEntry("request-id", req, worker, usage)
+		err := entry.Sign(other)
+		require.NoError(t, err)
+
+		err = entry.VerifySignature()
+		require.Error(t, err)
+	})
+}
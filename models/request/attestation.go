@@ -0,0 +1,42 @@
+package request
+
+import (
+	"encoding/json"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/response"
+	"github.com/blocklessnetwork/b7s-attributes/attributes"
+)
+
+var _ (json.Marshaler) = (*Attestation)(nil)
+
+// Attestation describes the `MessageAttestation` request payload.
+// It is sent by the head node to workers, to have them share their signed attributes.
+type Attestation struct {
+	bls.BaseMessage
+	RequestID string `json:"request_id,omitempty"`
+}
+
+func (a Attestation) Response(c codes.Code, att *attributes.Attestation) *response.Attestation {
+	return &response.Attestation{
+		BaseMessage: bls.BaseMessage{TraceInfo: a.TraceInfo},
+		RequestID:   a.RequestID,
+		Code:        c,
+		Attestation: att,
+	}
+}
+
+func (Attestation) Type() string { return bls.MessageAttestation }
+
+func (a Attestation) MarshalJSON() ([]byte, error) {
+	type Alias Attestation
+	rec := struct {
+		Alias
+		Type string `json:"type"`
+	}{
+		Alias: Alias(a),
+		Type:  a.Type(),
+	}
+	return json.Marshal(rec)
+}
@@ -0,0 +1,31 @@
+package request
+
+import (
+	"encoding/json"
+
+	"github.com/blessnetwork/b7s/models/bls"
+)
+
+var _ (json.Marshaler) = (*CancelExecution)(nil)
+
+// CancelExecution describes the `MessageCancelExecution` request payload.
+// It is sent by the head node to workers executing the request, to have them abort the execution.
+type CancelExecution struct {
+	bls.BaseMessage
+	RequestID string `json:"request_id,omitempty"`
+	ClusterID string `json:"cluster_id,omitempty"` // ClusterID identifies the cluster to leave, when it is shared by multiple requests. Defaults to the request ID.
+}
+
+func (CancelExecution) Type() string { return bls.MessageCancelExecution }
+
+func (c CancelExecution) MarshalJSON() ([]byte, error) {
+	type Alias CancelExecution
+	rec := struct {
+		Alias
+		Type string `json:"type"`
+	}{
+		Alias: Alias(c),
+		Type:  c.Type(),
+	}
+	return json.Marshal(rec)
+}
@@ -14,6 +14,7 @@ var _ (json.Marshaler) = (*InstallFunction)(nil)
 // InstallFunction describes the `MessageInstallFunction` request payload.
 type InstallFunction struct {
 	bls.BaseMessage
+	InstallID   string `json:"install_id,omitempty"`
 	ManifestURL string `json:"manifest_url,omitempty"`
 	CID         string `json:"cid,omitempty"`
 }
@@ -21,7 +22,9 @@ type InstallFunction struct {
 func (f InstallFunction) Response(c codes.Code) *response.InstallFunction {
 	return &response.InstallFunction{
 		BaseMessage: bls.BaseMessage{TraceInfo: f.TraceInfo},
+		InstallID:   f.InstallID,
 		Code:        c,
+		Status:      bls.InstallStatusFromCode(c),
 		Message:     "installed",
 		CID:         f.CID,
 	}
@@ -0,0 +1,41 @@
+package request
+
+import (
+	"encoding/json"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/response"
+)
+
+var _ (json.Marshaler) = (*ListFunctions)(nil)
+
+// ListFunctions describes the `MessageListFunctions` request payload.
+// It is sent by the head node to workers, to have them report the functions they have installed.
+type ListFunctions struct {
+	bls.BaseMessage
+	RequestID string `json:"request_id,omitempty"`
+}
+
+func (l ListFunctions) Response(c codes.Code, functions []bls.InstalledFunction) *response.ListFunctions {
+	return &response.ListFunctions{
+		BaseMessage: bls.BaseMessage{TraceInfo: l.TraceInfo},
+		RequestID:   l.RequestID,
+		Code:        c,
+		Functions:   functions,
+	}
+}
+
+func (ListFunctions) Type() string { return bls.MessageListFunctions }
+
+func (l ListFunctions) MarshalJSON() ([]byte, error) {
+	type Alias ListFunctions
+	rec := struct {
+		Alias
+		Type string `json:"type"`
+	}{
+		Alias: Alias(l),
+		Type:  l.Type(),
+	}
+	return json.Marshal(rec)
+}
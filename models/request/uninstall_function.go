@@ -0,0 +1,53 @@
+package request
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/response"
+)
+
+var _ (json.Marshaler) = (*UninstallFunction)(nil)
+
+// UninstallFunction describes the `MessageUninstallFunction` request payload.
+// It is published by the head node to have workers remove the function.
+type UninstallFunction struct {
+	bls.BaseMessage
+	RequestID string `json:"request_id,omitempty"`
+	CID       string `json:"cid,omitempty"`
+}
+
+func (f UninstallFunction) Response(c codes.Code, message string) *response.UninstallFunction {
+	return &response.UninstallFunction{
+		BaseMessage: bls.BaseMessage{TraceInfo: f.TraceInfo},
+		RequestID:   f.RequestID,
+		Code:        c,
+		Message:     message,
+		CID:         f.CID,
+	}
+}
+
+func (UninstallFunction) Type() string { return bls.MessageUninstallFunction }
+
+func (f UninstallFunction) MarshalJSON() ([]byte, error) {
+	type Alias UninstallFunction
+	rec := struct {
+		Alias
+		Type string `json:"type"`
+	}{
+		Alias: Alias(f),
+		Type:  f.Type(),
+	}
+	return json.Marshal(rec)
+}
+
+func (f UninstallFunction) Valid() error {
+
+	if f.CID == "" {
+		return errors.New("function CID is required")
+	}
+
+	return nil
+}
@@ -16,7 +16,8 @@ type WorkOrder struct {
 	execute.Request // execute request is embedded
 
 	RequestID string    `json:"request_id,omitempty"`
-	Timestamp time.Time `json:"timestamp,omitempty"` // Execution request timestamp is a factor for PBFT.
+	ClusterID string    `json:"cluster_id,omitempty"` // ClusterID identifies the cluster executing the request, when it is shared by multiple requests. Defaults to the request ID.
+	Timestamp time.Time `json:"timestamp,omitempty"`  // Execution request timestamp is a factor for PBFT.
 }
 
 func (w WorkOrder) Response(c codes.Code, res execute.Result) *response.WorkOrder {
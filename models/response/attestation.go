@@ -0,0 +1,34 @@
+package response
+
+import (
+	"encoding/json"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s-attributes/attributes"
+)
+
+var _ (json.Marshaler) = (*Attestation)(nil)
+
+// Attestation describes the `MessageAttestationResponse` response.
+type Attestation struct {
+	bls.BaseMessage
+	RequestID string     `json:"request_id,omitempty"`
+	Code      codes.Code `json:"code,omitempty"`
+	// Attributes of the node, signed by the node and its attestors. Not set if the node has no attributes.
+	Attestation *attributes.Attestation `json:"attestation,omitempty"`
+}
+
+func (Attestation) Type() string { return bls.MessageAttestationResponse }
+
+func (a Attestation) MarshalJSON() ([]byte, error) {
+	type Alias Attestation
+	rec := struct {
+		Alias
+		Type string `json:"type"`
+	}{
+		Alias: Alias(a),
+		Type:  a.Type(),
+	}
+	return json.Marshal(rec)
+}
@@ -12,6 +12,7 @@ var _ (json.Marshaler) = (*Health)(nil)
 type Health struct {
 	bls.BaseMessage
 	Code int `json:"code,omitempty"`
+	bls.NodeStatus
 }
 
 func (Health) Type() string { return bls.MessageHealthCheck }
@@ -12,9 +12,11 @@ var _ (json.Marshaler) = (*InstallFunction)(nil)
 // InstallFunction describes the response to the `MessageInstallFunction` message.
 type InstallFunction struct {
 	bls.BaseMessage
-	Code    codes.Code `json:"code,omitempty"`
-	Message string     `json:"message,omitempty"`
-	CID     string     `json:"cid,omitempty"`
+	InstallID string                    `json:"install_id,omitempty"`
+	Code      codes.Code                `json:"code,omitempty"`
+	Status    bls.FunctionInstallStatus `json:"status,omitempty"`
+	Message   string                    `json:"message,omitempty"`
+	CID       string                    `json:"cid,omitempty"`
 }
 
 func (InstallFunction) Type() string { return bls.MessageInstallFunctionResponse }
@@ -0,0 +1,32 @@
+package response
+
+import (
+	"encoding/json"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+)
+
+var _ (json.Marshaler) = (*ListFunctions)(nil)
+
+// ListFunctions describes the `MessageListFunctionsResponse` response.
+type ListFunctions struct {
+	bls.BaseMessage
+	RequestID string                  `json:"request_id,omitempty"`
+	Code      codes.Code              `json:"code,omitempty"`
+	Functions []bls.InstalledFunction `json:"functions,omitempty"`
+}
+
+func (ListFunctions) Type() string { return bls.MessageListFunctionsResponse }
+
+func (l ListFunctions) MarshalJSON() ([]byte, error) {
+	type Alias ListFunctions
+	rec := struct {
+		Alias
+		Type string `json:"type"`
+	}{
+		Alias: Alias(l),
+		Type:  l.Type(),
+	}
+	return json.Marshal(rec)
+}
@@ -15,6 +15,14 @@ type RollCall struct {
 	Code       codes.Code `json:"code,omitempty"`
 	FunctionID string     `json:"function_id,omitempty"`
 	RequestID  string     `json:"request_id,omitempty"`
+
+	// Load of the worker at the time of the response.
+	Load *bls.NodeLoad `json:"load,omitempty"`
+}
+
+func (r *RollCall) WithLoad(l bls.NodeLoad) *RollCall {
+	r.Load = &l
+	return r
 }
 
 func (RollCall) Type() string { return bls.MessageRollCallResponse }
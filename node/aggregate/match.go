@@ -0,0 +1,184 @@
+package aggregate
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+)
+
+// valueFunc extracts the value used for aggregation from the result of a peer.
+type valueFunc func(res execute.NodeResult) (string, error)
+
+// exactMatch returns the most common result, comparing stdout, stderr and exit code.
+func exactMatch(results execute.ResultMap, _ execute.ResultAggregation) (Aggregation, error) {
+	return match(results, func(res execute.NodeResult) (string, error) {
+		output, err := json.Marshal(res.Result.Result)
+		if err != nil {
+			return "", fmt.Errorf("could not encode output: %w", err)
+		}
+
+		return string(output), nil
+	})
+}
+
+// stdoutMatch returns the most common stdout.
+func stdoutMatch(results execute.ResultMap, _ execute.ResultAggregation) (Aggregation, error) {
+	return match(results, stdout)
+}
+
+// jsonFieldMatch returns the most common value of a field in the JSON output.
+func jsonFieldMatch(results execute.ResultMap, cfg execute.ResultAggregation) (Aggregation, error) {
+
+	path, _ := cfg.Parameter(execute.AggregationParamPath)
+	if path == "" {
+		return Aggregation{}, fmt.Errorf("%s parameter is required", execute.AggregationParamPath)
+	}
+
+	return match(results, func(res execute.NodeResult) (string, error) {
+		return jsonField(res.Result.Result.Stdout, path)
+	})
+}
+
+// majority returns the most common stdout, if the fraction of peers agreeing on it is at least the required minimum.
+func majority(results execute.ResultMap, cfg execute.ResultAggregation) (Aggregation, error) {
+
+	required, err := cfg.FloatParameter(execute.AggregationParamMinAgreement, 0)
+	if err != nil {
+		return Aggregation{}, err
+	}
+
+	aggregation, err := match(results, stdout)
+	if err != nil {
+		return aggregation, err
+	}
+
+	if aggregation.Agreement < 100*required {
+		return aggregation, fmt.Errorf("insufficient agreement (have: %.2f%%, required: %.2f%%)", aggregation.Agreement, 100*required)
+	}
+
+	return aggregation, nil
+}
+
+// match returns the most common value. If multiple values are equally common, the lowest one is chosen so the outcome does not depend on the order of results.
+func match(results execute.ResultMap, extract valueFunc) (Aggregation, error) {
+
+	values := extractValues(results, extract)
+
+	counts := make(map[string]int)
+	for _, v := range values {
+		if v.Error == "" {
+			counts[v.Value]++
+		}
+	}
+
+	if len(counts) == 0 {
+		return Aggregation{Peers: values}, errNoResults
+	}
+
+	var (
+		top   string
+		count int
+	)
+	for _, value := range slices.Sorted(maps.Keys(counts)) {
+		if counts[value] > count {
+			top, count = value, counts[value]
+		}
+	}
+
+	for i := range values {
+		values[i].Included = values[i].Error == "" && values[i].Value == top
+	}
+
+	aggregation := Aggregation{
+		Value:     top,
+		Agreement: 100 * float64(count) / float64(len(results)),
+		Peers:     values,
+	}
+
+	return aggregation, nil
+}
+
+// extractValues returns the values of all peers, ordered by peer ID. Failed executions and values that could not be extracted are reported as errors.
+func extractValues(results execute.ResultMap, extract valueFunc) []PeerValue {
+
+	values := make([]PeerValue, 0, len(results))
+	for _, id := range slices.Sorted(maps.Keys(results)) {
+
+		res := results[id]
+		value := PeerValue{Peer: id}
+
+		if res.Code != codes.OK {
+			value.Error = fmt.Sprintf("execution failed (code: %s)", res.Code)
+			values = append(values, value)
+			continue
+		}
+
+		v, err := extract(res)
+		if err != nil {
+			value.Error = err.Error()
+		} else {
+			value.Value = v
+		}
+
+		values = append(values, value)
+	}
+
+	return values
+}
+
+func stdout(res execute.NodeResult) (string, error) {
+	return res.Result.Result.Stdout, nil
+}
+
+// jsonField returns the value of the field at the given path in a JSON document. Path is a dot-separated list of object keys and array indices.
+// String values are returned as is, other values are returned JSON-encoded.
+func jsonField(doc string, path string) (string, error) {
+
+	dec := json.NewDecoder(strings.NewReader(doc))
+	dec.UseNumber()
+
+	var value any
+	err := dec.Decode(&value)
+	if err != nil {
+		return "", fmt.Errorf("could not decode output: %w", err)
+	}
+
+	for _, key := range strings.Split(path, ".") {
+		switch v := value.(type) {
+		case map[string]any:
+			field, ok := v[key]
+			if !ok {
+				return "", fmt.Errorf("field not found (path: %s, key: %s)", path, key)
+			}
+			value = field
+
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(v) {
+				return "", fmt.Errorf("invalid array index (path: %s, index: %s)", path, key)
+			}
+			value = v[i]
+
+		default:
+			return "", fmt.Errorf("field not found (path: %s, key: %s)", path, key)
+		}
+	}
+
+	s, ok := value.(string)
+	if ok {
+		return s, nil
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("could not encode field: %w", err)
+	}
+
+	return string(encoded), nil
+}
@@ -0,0 +1,131 @@
+package aggregate
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/blessnetwork/b7s/models/execute"
+)
+
+// median returns the median of numeric results.
+func median(results execute.ResultMap, cfg execute.ResultAggregation) (Aggregation, error) {
+	return numeric(results, cfg, 0, func(values []float64) float64 {
+		n := len(values)
+		if n%2 == 1 {
+			return values[n/2]
+		}
+
+		return (values[n/2-1] + values[n/2]) / 2
+	})
+}
+
+// mean returns the arithmetic mean of numeric results.
+func mean(results execute.ResultMap, cfg execute.ResultAggregation) (Aggregation, error) {
+	return numeric(results, cfg, 0, average)
+}
+
+// trimmedMean returns the arithmetic mean of numeric results, after discarding a fraction of the lowest and the highest values.
+func trimmedMean(results execute.ResultMap, cfg execute.ResultAggregation) (Aggregation, error) {
+
+	trim, err := cfg.FloatParameter(execute.AggregationParamTrim, execute.DefaultAggregationTrim)
+	if err != nil {
+		return Aggregation{}, err
+	}
+
+	if trim < 0 || trim >= 0.5 {
+		return Aggregation{}, errors.New("trim must be in range [0, 0.5)")
+	}
+
+	return numeric(results, cfg, trim, average)
+}
+
+// numeric parses the results as numbers, discards the given fraction of the lowest and highest values, and computes the aggregated value from the rest.
+// Values are passed to the compute function in ascending order.
+func numeric(results execute.ResultMap, cfg execute.ResultAggregation, trim float64, compute func([]float64) float64) (Aggregation, error) {
+
+	path, _ := cfg.Parameter(execute.AggregationParamPath)
+
+	values := extractValues(results, func(res execute.NodeResult) (string, error) {
+
+		value := strings.TrimSpace(res.Result.Result.Stdout)
+		if path != "" {
+			var err error
+			value, err = jsonField(value, path)
+			if err != nil {
+				return "", err
+			}
+		}
+
+		_, err := parseNumber(value)
+		if err != nil {
+			return "", err
+		}
+
+		return value, nil
+	})
+
+	type sample struct {
+		peer  int // Index of the peer in the breakdown.
+		value float64
+	}
+
+	var samples []sample
+	for i, v := range values {
+		if v.Error != "" {
+			continue
+		}
+
+		n, _ := parseNumber(v.Value)
+		samples = append(samples, sample{peer: i, value: n})
+	}
+
+	slices.SortStableFunc(samples, func(a, b sample) int {
+		return cmp.Compare(a.value, b.value)
+	})
+
+	discard := int(float64(len(samples)) * trim)
+	samples = samples[discard : len(samples)-discard]
+
+	if len(samples) == 0 {
+		return Aggregation{Peers: values}, errNoResults
+	}
+
+	numbers := make([]float64, 0, len(samples))
+	for _, s := range samples {
+		values[s.peer].Included = true
+		numbers = append(numbers, s.value)
+	}
+
+	aggregation := Aggregation{
+		Value:     strconv.FormatFloat(compute(numbers), 'f', -1, 64),
+		Agreement: 100 * float64(len(samples)) / float64(len(results)),
+		Peers:     values,
+	}
+
+	return aggregation, nil
+}
+
+func parseNumber(value string) (float64, error) {
+
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+		return 0, fmt.Errorf("not a number: %q", value)
+	}
+
+	return n, nil
+}
+
+func average(values []float64) float64 {
+
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+
+	return sum / float64(len(values))
+}
@@ -0,0 +1,77 @@
+package aggregate
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/blessnetwork/b7s/models/execute"
+)
+
+// Aggregator combines the results of multiple peers into a single value.
+// Aggregators should return the per-peer breakdown even if the results could not be aggregated.
+type Aggregator func(results execute.ResultMap, cfg execute.ResultAggregation) (Aggregation, error)
+
+var (
+	registryLock sync.RWMutex
+	registry     = map[execute.AggregationType]Aggregator{
+		execute.AggregationExact:       exactMatch,
+		execute.AggregationStdout:      stdoutMatch,
+		execute.AggregationJSONField:   jsonFieldMatch,
+		execute.AggregationMedian:      median,
+		execute.AggregationMean:        mean,
+		execute.AggregationTrimmedMean: trimmedMean,
+		execute.AggregationMajority:    majority,
+	}
+)
+
+// Register sets the aggregator used for the given aggregation type, replacing any existing one.
+// Note that execution requests received via the API are only accepted with one of the built-in aggregation types.
+func Register(t execute.AggregationType, aggregator Aggregator) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	registry[t] = aggregator
+}
+
+// Get returns the aggregator used for the given aggregation type.
+func Get(t execute.AggregationType) (Aggregator, bool) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	aggregator, ok := registry[t]
+	return aggregator, ok
+}
+
+// Apply aggregates the results using the aggregation strategy from the request config. Exact match is used if no type is set.
+// If aggregation is not enabled, nil is returned. If the results could not be aggregated, the error is reported in the aggregation.
+func Apply(cfg execute.ResultAggregation, results execute.ResultMap) *Aggregation {
+
+	if !cfg.Enable {
+		return nil
+	}
+
+	t := cfg.Type
+	if t == "" {
+		t = execute.AggregationExact
+	}
+
+	aggregator, ok := Get(t)
+	if !ok {
+		return &Aggregation{
+			Type:  t,
+			Error: fmt.Sprintf("unknown aggregation type (%s)", t),
+		}
+	}
+
+	aggregation, err := aggregator(results, cfg)
+	aggregation.Type = t
+	if err != nil {
+		aggregation.Value = ""
+		aggregation.Error = err.Error()
+	}
+
+	return &aggregation
+}
+
+var errNoResults = errors.New("no successful results to aggregate")
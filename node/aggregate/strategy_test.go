@@ -0,0 +1,160 @@
+package aggregate_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/node/aggregate"
+)
+
+func TestAggregate_Apply(t *testing.T) {
+
+	result := func(stdout string) execute.NodeResult {
+		return execute.NodeResult{
+			Result: execute.Result{
+				Code:   codes.OK,
+				Result: execute.RuntimeOutput{Stdout: stdout},
+			},
+		}
+	}
+
+	config := func(t execute.AggregationType, params ...execute.Parameter) execute.ResultAggregation {
+		return execute.ResultAggregation{
+			Enable:     true,
+			Type:       t,
+			Parameters: params,
+		}
+	}
+
+	failed := execute.NodeResult{
+		Result: execute.Result{
+			Code: codes.Error,
+		},
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		require.Nil(t, aggregate.Apply(execute.ResultAggregation{}, execute.ResultMap{"peer-1": result("a")}))
+	})
+	t.Run("stdout match ignores stderr", func(t *testing.T) {
+
+		withStderr := result("a")
+		withStderr.Result.Result.Stderr = "warning"
+
+		results := execute.ResultMap{
+			"peer-1": result("a"),
+			"peer-2": withStderr,
+			"peer-3": result("b"),
+		}
+
+		aggregation := aggregate.Apply(config(execute.AggregationStdout), results)
+		require.Empty(t, aggregation.Error)
+		require.Equal(t, "a", aggregation.Value)
+		require.InDelta(t, 66.67, aggregation.Agreement, 0.01)
+		require.Equal(t, []bool{true, true, false}, included(aggregation.Peers))
+
+		// Exact match compares stderr too.
+		aggregation = aggregate.Apply(config(execute.AggregationExact), results)
+		require.Empty(t, aggregation.Error)
+		require.InDelta(t, 33.33, aggregation.Agreement, 0.01)
+	})
+	t.Run("json field match", func(t *testing.T) {
+
+		results := execute.ResultMap{
+			"peer-1": result(`{"data": {"price": 10, "time": 1}}`),
+			"peer-2": result(`{"data": {"price": 10, "time": 2}}`),
+			"peer-3": result(`{"data": {"price": 11, "time": 3}}`),
+			"peer-4": result(`not json`),
+		}
+
+		aggregation := aggregate.Apply(config(execute.AggregationJSONField, execute.Parameter{Name: execute.AggregationParamPath, Value: "data.price"}), results)
+		require.Empty(t, aggregation.Error)
+		require.Equal(t, "10", aggregation.Value)
+		require.Equal(t, float64(50), aggregation.Agreement)
+		require.NotEmpty(t, aggregation.Peers[3].Error)
+	})
+	t.Run("median", func(t *testing.T) {
+
+		results := execute.ResultMap{
+			"peer-1": result("1"),
+			"peer-2": result("4\n"),
+			"peer-3": result("2"),
+			"peer-4": result("100"),
+			"peer-5": failed,
+		}
+
+		aggregation := aggregate.Apply(config(execute.AggregationMedian), results)
+		require.Empty(t, aggregation.Error)
+		require.Equal(t, "3", aggregation.Value)
+		require.Equal(t, []bool{true, true, true, true, false}, included(aggregation.Peers))
+	})
+	t.Run("mean of json field", func(t *testing.T) {
+
+		results := execute.ResultMap{
+			"peer-1": result(`{"values": [1, 2]}`),
+			"peer-2": result(`{"values": [1, 3]}`),
+		}
+
+		aggregation := aggregate.Apply(config(execute.AggregationMean, execute.Parameter{Name: execute.AggregationParamPath, Value: "values.1"}), results)
+		require.Empty(t, aggregation.Error)
+		require.Equal(t, "2.5", aggregation.Value)
+	})
+	t.Run("trimmed mean", func(t *testing.T) {
+
+		results := execute.ResultMap{
+			"peer-1": result("1"),
+			"peer-2": result("10"),
+			"peer-3": result("11"),
+			"peer-4": result("12"),
+			"peer-5": result("1000"),
+		}
+
+		aggregation := aggregate.Apply(config(execute.AggregationTrimmedMean, execute.Parameter{Name: execute.AggregationParamTrim, Value: "0.2"}), results)
+		require.Empty(t, aggregation.Error)
+		require.Equal(t, "11", aggregation.Value)
+		require.Equal(t, []bool{false, true, true, true, false}, included(aggregation.Peers))
+	})
+	t.Run("majority", func(t *testing.T) {
+
+		results := execute.ResultMap{
+			"peer-1": result("a"),
+			"peer-2": result("a"),
+			"peer-3": result("b"),
+		}
+
+		aggregation := aggregate.Apply(config(execute.AggregationMajority, execute.Parameter{Name: execute.AggregationParamMinAgreement, Value: "0.6"}), results)
+		require.Empty(t, aggregation.Error)
+		require.Equal(t, "a", aggregation.Value)
+
+		aggregation = aggregate.Apply(config(execute.AggregationMajority, execute.Parameter{Name: execute.AggregationParamMinAgreement, Value: "0.7"}), results)
+		require.NotEmpty(t, aggregation.Error)
+		require.Empty(t, aggregation.Value)
+		require.Len(t, aggregation.Peers, 3)
+	})
+	t.Run("no successful results", func(t *testing.T) {
+		aggregation := aggregate.Apply(config(execute.AggregationMean), execute.ResultMap{"peer-1": failed})
+		require.NotEmpty(t, aggregation.Error)
+	})
+	t.Run("custom aggregator", func(t *testing.T) {
+
+		aggregate.Register("count", func(results execute.ResultMap, _ execute.ResultAggregation) (aggregate.Aggregation, error) {
+			return aggregate.Aggregation{Value: "3"}, nil
+		})
+
+		aggregation := aggregate.Apply(config("count"), execute.ResultMap{"peer-1": result("a")})
+		require.Equal(t, execute.AggregationType("count"), aggregation.Type)
+		require.Equal(t, "3", aggregation.Value)
+	})
+}
+
+func included(values []aggregate.PeerValue) []bool {
+
+	out := make([]bool, 0, len(values))
+	for _, v := range values {
+		out = append(out, v.Included)
+	}
+
+	return out
+}
@@ -0,0 +1,112 @@
+package node
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s-attributes/attributes"
+)
+
+// VerifyAttestation checks the signatures of the attestation - the signature of the node over its attributes and the signatures of all attestors.
+// If the attributes are signed, they must be signed by the given node.
+func VerifyAttestation(att attributes.Attestation, id peer.ID) error {
+
+	err := attributes.Validate(att)
+	if err != nil {
+		return fmt.Errorf("invalid attestation: %w", err)
+	}
+
+	if att.Signature != nil && att.Signature.Signer != id {
+		return fmt.Errorf("attributes signed by a different node (signer: %s)", att.Signature.Signer)
+	}
+
+	return nil
+}
+
+// HaveAttributes checks if the attested attributes satisfy the requested ones.
+func HaveAttributes(have attributes.Attestation, want execute.Attributes) error {
+
+	if want.AttestationRequired && len(have.Attestors) == 0 {
+		return errors.New("attestors required but none found")
+	}
+
+	// If we need to check attestors, create a map of them now.
+	var attestors map[peer.ID]struct{}
+	if len(want.Attestors.Each) > 0 || len(want.Attestors.OneOf) > 0 {
+		attestors = make(map[peer.ID]struct{}, len(have.Attestors))
+		for _, attestor := range have.Attestors {
+			attestors[attestor.Signer] = struct{}{}
+		}
+	}
+
+	// If the client wants specific attestors, check if they're present.
+	if len(want.Attestors.Each) > 0 {
+		for _, wa := range want.Attestors.Each {
+			_, ok := attestors[wa]
+			if !ok {
+				return fmt.Errorf("attestor %s explicitly requested but not found", wa.String())
+			}
+		}
+	}
+
+	// If the client wants some of these attestors, check if at least one if found.
+	if len(want.Attestors.OneOf) > 0 {
+		var found bool
+		for _, wa := range want.Attestors.OneOf {
+			_, ok := attestors[wa]
+			if ok {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("at least one attestor wanted but none found (wanted: %s)", bls.PeerIDsToStr(want.Attestors.OneOf))
+		}
+	}
+
+	// It doesn't make a lot of sense to require attestors without wanting specific attributes,
+	// but if that's the case, and there's no attributes wanted, we're done now.
+	if len(want.Values) == 0 && len(want.Predicates) == 0 {
+		return nil
+	}
+
+	attrs := make(map[string]string, len(have.Attributes))
+	for _, attr := range have.Attributes {
+		attrs[attr.Name] = attr.Value
+	}
+
+	for _, wantAttr := range want.Values {
+
+		value, ok := attrs[wantAttr.Name]
+		if !ok {
+			return fmt.Errorf("attribute wanted but not found (attr: %v, value: %v)", wantAttr.Name, wantAttr.Value)
+		}
+
+		if value != wantAttr.Value {
+			return fmt.Errorf("attribute wanted but value doesn't match (attr: %v, want: %v, have: %v)", wantAttr.Name, wantAttr.Value, value)
+		}
+	}
+
+	for _, predicate := range want.Predicates {
+
+		value, ok := attrs[predicate.Name]
+		if !ok {
+			return fmt.Errorf("attribute wanted but not found (attr: %v)", predicate.Name)
+		}
+
+		match, err := predicate.Matches(value)
+		if err != nil {
+			return fmt.Errorf("could not evaluate attribute predicate (attr: %v, op: %v): %w", predicate.Name, predicate.Operator, err)
+		}
+
+		if !match {
+			return fmt.Errorf("attribute value doesn't satisfy predicate (attr: %v, op: %v, have: %v)", predicate.Name, predicate.Operator, value)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package node_test
+
+import (
+	"testing"
+
+	"github.com/blocklessnetwork/b7s-attributes/attributes"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/node"
+)
+
+func TestNode_HaveAttributes(t *testing.T) {
+
+	have := attributes.Attestation{
+		Attributes: []attributes.Attribute{
+			{Name: "region", Value: "eu-west-1"},
+			{Name: "gpu_memory_gb", Value: "24"},
+			{Name: "runtime_version", Value: "1.4.2"},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		want  execute.Attributes
+		match bool
+	}{
+		{
+			name:  "exact value",
+			want:  execute.Attributes{Values: []execute.Parameter{{Name: "region", Value: "eu-west-1"}}},
+			match: true,
+		},
+		{
+			name: "predicates",
+			want: execute.Attributes{
+				Predicates: []execute.AttributePredicate{
+					{Name: "region", Operator: execute.AttributeIn, Values: []string{"eu-*"}},
+					{Name: "gpu_memory_gb", Operator: execute.AttributeAtLeast, Value: "16"},
+					{Name: "runtime_version", Operator: execute.AttributeSemver, Value: "^1.2.0"},
+				},
+			},
+			match: true,
+		},
+		{
+			name: "predicate not satisfied",
+			want: execute.Attributes{
+				Predicates: []execute.AttributePredicate{
+					{Name: "gpu_memory_gb", Operator: execute.AttributeAtLeast, Value: "32"},
+				},
+			},
+		},
+		{
+			name: "missing attribute",
+			want: execute.Attributes{
+				Predicates: []execute.AttributePredicate{
+					{Name: "cpu", Operator: execute.AttributeNotEqual, Value: "arm64"},
+				},
+			},
+		},
+		{
+			name: "value and predicate",
+			want: execute.Attributes{
+				Values:     []execute.Parameter{{Name: "region", Value: "eu-west-1"}},
+				Predicates: []execute.AttributePredicate{{Name: "region", Operator: execute.AttributeRegex, Value: "^us-"}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := node.HaveAttributes(have, test.want)
+			if test.match {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestNode_VerifyAttestation(t *testing.T) {
+
+	var (
+		nodeKey     = newKey(t)
+		attestorKey = newKey(t)
+		attrs       = []attributes.Attribute{{Name: "region", Value: "eu-west-1"}}
+	)
+
+	id, err := peer.IDFromPrivateKey(nodeKey)
+	require.NoError(t, err)
+
+	t.Run("valid attestation", func(t *testing.T) {
+		att := attest(t, sign(t, attrs, nodeKey), attestorKey)
+		require.NoError(t, node.VerifyAttestation(att, id))
+	})
+	t.Run("unsigned attributes", func(t *testing.T) {
+		require.NoError(t, node.VerifyAttestation(attributes.Attestation{Attributes: attrs}, id))
+	})
+	t.Run("signed by a different node", func(t *testing.T) {
+		att := sign(t, attrs, attestorKey)
+		require.Error(t, node.VerifyAttestation(att, id))
+	})
+	t.Run("tampered attributes", func(t *testing.T) {
+		att := attest(t, sign(t, attrs, nodeKey), attestorKey)
+		att.Attributes = []attributes.Attribute{{Name: "region", Value: "us-east-1"}}
+		require.Error(t, node.VerifyAttestation(att, id))
+	})
+	t.Run("invalid attestor signature", func(t *testing.T) {
+		att := attest(t, sign(t, attrs, nodeKey), attestorKey)
+		att.Attestors[0].Signature = att.Signature.Signature
+		require.Error(t, node.VerifyAttestation(att, id))
+	})
+}
+
+func newKey(t *testing.T) crypto.PrivKey {
+	t.Helper()
+
+	key, _, err := crypto.GenerateEd25519Key(nil)
+	require.NoError(t, err)
+
+	return key
+}
+
+func sign(t *testing.T, attrs []attributes.Attribute, key crypto.PrivKey) attributes.Attestation {
+	t.Helper()
+
+	id, err := peer.IDFromPrivateKey(key)
+	require.NoError(t, err)
+
+	sig, err := attributes.SignAttributes(attrs, key)
+	require.NoError(t, err)
+
+	return attributes.Attestation{
+		Attributes: attrs,
+		Signature:  &attributes.Signature{Signer: id, Signature: sig},
+	}
+}
+
+func attest(t *testing.T, att attributes.Attestation, key crypto.PrivKey) attributes.Attestation {
+	t.Helper()
+
+	id, err := peer.IDFromPrivateKey(key)
+	require.NoError(t, err)
+
+	sig, err := attributes.Attest(att, key)
+	require.NoError(t, err)
+
+	att.Attestors = append(att.Attestors, attributes.Signature{Signer: id, Signature: sig})
+	return att
+}
@@ -49,7 +49,7 @@ type Telemetry interface {
 }
 
 type NodeOps interface {
-	Run(context.Context, func(context.Context, peer.ID, string, []byte) error) error
+	Run(context.Context, func(context.Context, peer.ID, string, []byte) error, func(context.Context) bls.NodeStatus) error
 }
 
 type core struct {
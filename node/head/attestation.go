@@ -0,0 +1,81 @@
+package head
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/models/request"
+	"github.com/blessnetwork/b7s/models/response"
+	"github.com/blessnetwork/b7s/node"
+	"github.com/blocklessnetwork/b7s-attributes/attributes"
+)
+
+// RequestAttestation asks the peer for its signed attributes. Returned attestation is verified - it must be signed by the peer, and signatures of all attestors must be valid.
+func (h *HeadNode) RequestAttestation(ctx context.Context, id peer.ID) (attributes.Attestation, error) {
+
+	req := request.Attestation{
+		RequestID: newRequestID(),
+	}
+
+	err := h.Send(ctx, id, &req)
+	if err != nil {
+		return attributes.Attestation{}, fmt.Errorf("could not send attestation request: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, attestationRequestTimeout)
+	defer cancel()
+
+	res, ok := h.attestationResponses.WaitFor(ctx, peerRequestKey(req.RequestID, id))
+	if !ok {
+		return attributes.Attestation{}, errors.New("peer did not respond to attestation request")
+	}
+
+	if res.Code != codes.OK || res.Attestation == nil {
+		return attributes.Attestation{}, fmt.Errorf("peer did not provide an attestation (code: %s)", res.Code)
+	}
+
+	att := *res.Attestation
+	if att.Signature == nil {
+		return attributes.Attestation{}, errors.New("peer attributes are not signed")
+	}
+
+	err = node.VerifyAttestation(att, id)
+	if err != nil {
+		return attributes.Attestation{}, fmt.Errorf("could not verify peer attestation: %w", err)
+	}
+
+	return att, nil
+}
+
+// verifyPeerAttributes checks that the attestation of the peer satisfies the requested attributes.
+func (h *HeadNode) verifyPeerAttributes(ctx context.Context, id peer.ID, want execute.Attributes) error {
+
+	att, err := h.RequestAttestation(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not get peer attestation: %w", err)
+	}
+
+	err = node.HaveAttributes(att, want)
+	if err != nil {
+		return fmt.Errorf("peer attestation does not match requested attributes: %w", err)
+	}
+
+	return nil
+}
+
+func (h *HeadNode) processAttestationResponse(ctx context.Context, from peer.ID, res response.Attestation) error {
+
+	h.Log().Debug().
+		Stringer("from", from).
+		Str("request", res.RequestID).
+		Msg("received attestation response")
+
+	h.attestationResponses.Set(peerRequestKey(res.RequestID, from), res)
+
+	return nil
+}
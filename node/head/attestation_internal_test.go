@@ -0,0 +1,83 @@
+package head
+
+import (
+	"context"
+	"testing"
+
+	"github.com/blocklessnetwork/b7s-attributes/attributes"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/models/request"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_RequestAttestation(t *testing.T) {
+
+	key, _, err := crypto.GenerateEd25519Key(nil)
+	require.NoError(t, err)
+
+	id, err := peer.IDFromPrivateKey(key)
+	require.NoError(t, err)
+
+	attrs := []attributes.Attribute{{Name: "gpu_memory_gb", Value: "24"}}
+	sig, err := attributes.SignAttributes(attrs, key)
+	require.NoError(t, err)
+
+	signed := attributes.Attestation{
+		Attributes: attrs,
+		Signature:  &attributes.Signature{Signer: id, Signature: sig},
+	}
+
+	// Create a head node whose peer responds with the given attestation.
+	newHead := func(t *testing.T, code codes.Code, att *attributes.Attestation) *HeadNode {
+
+		core := mocks.BaselineNodeCore(t)
+		head, err := New(core, mocks.BaselineStore(t))
+		require.NoError(t, err)
+
+		core.SendFunc = func(_ context.Context, to peer.ID, msg bls.Message) error {
+			req := msg.(*request.Attestation)
+			head.attestationResponses.Set(peerRequestKey(req.RequestID, to), *req.Response(code, att))
+			return nil
+		}
+
+		return head
+	}
+
+	t.Run("attestation is verified", func(t *testing.T) {
+
+		head := newHead(t, codes.OK, &signed)
+
+		att, err := head.RequestAttestation(context.Background(), id)
+		require.NoError(t, err)
+		require.Equal(t, signed, att)
+
+		want := execute.Attributes{
+			Predicates: []execute.AttributePredicate{{Name: "gpu_memory_gb", Operator: execute.AttributeAtLeast, Value: "16"}},
+		}
+		require.NoError(t, head.verifyPeerAttributes(context.Background(), id, want))
+
+		want.Predicates[0].Value = "32"
+		require.Error(t, head.verifyPeerAttributes(context.Background(), id, want))
+	})
+	t.Run("attributes signed by another node", func(t *testing.T) {
+		head := newHead(t, codes.OK, &signed)
+		_, err := head.RequestAttestation(context.Background(), mocks.GenericPeerID)
+		require.Error(t, err)
+	})
+	t.Run("unsigned attributes", func(t *testing.T) {
+		head := newHead(t, codes.OK, &attributes.Attestation{Attributes: attrs})
+		_, err := head.RequestAttestation(context.Background(), id)
+		require.Error(t, err)
+	})
+	t.Run("peer has no attributes", func(t *testing.T) {
+		head := newHead(t, codes.NotFound, nil)
+		_, err := head.RequestAttestation(context.Background(), id)
+		require.Error(t, err)
+	})
+}
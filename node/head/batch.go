@@ -0,0 +1,295 @@
+package head
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/armon/go-metrics"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"go.opentelemetry.io/otel/trace"
+
+	cons "github.com/blessnetwork/b7s/consensus"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/models/request"
+	"github.com/blessnetwork/b7s/telemetry/tracing"
+)
+
+// batch holds the state of a batch execution.
+type batch struct {
+	ids      []string
+	requests []request.Execute
+	records  []bls.ExecutionRecord
+
+	limit chan struct{} // limit bounds the number of requests executed at the same time.
+}
+
+// batchGroup is a set of requests from a batch that share a roll call and, if consensus is required, a cluster.
+type batchGroup struct {
+	consensus cons.Type
+	items     []int // Indices of the requests in the batch.
+}
+
+// batchGroupKey identifies requests that can share a roll call - requests for the same function, with the same consensus and roll call settings.
+type batchGroupKey struct {
+	functionID    string
+	consensus     cons.Type
+	nodeCount     int
+	timeout       int
+	peerSelection string
+	attributes    string
+}
+
+// ExecuteFunctionBatch executes multiple requests at once. Requests that can share a roll call and a cluster do so, instead of
+// each request doing its own. Client can lower the number of requests executed at the same time, but cannot exceed the configured limit.
+// Returned are the execution records, in the order of the requests. Failed requests have their records too, with the error code set.
+func (h *HeadNode) ExecuteFunctionBatch(ctx context.Context, reqs []execute.Request, subgroup string, parallelism uint) []bls.ExecutionRecord {
+
+	if parallelism == 0 || parallelism > h.cfg.BatchParallelism {
+		parallelism = h.cfg.BatchParallelism
+	}
+
+	b := batch{
+		ids:      make([]string, len(reqs)),
+		requests: make([]request.Execute, len(reqs)),
+		records:  make([]bls.ExecutionRecord, len(reqs)),
+		limit:    make(chan struct{}, parallelism),
+	}
+
+	for i, req := range reqs {
+		b.ids[i] = newRequestID()
+		b.requests[i] = request.Execute{
+			Request: req,
+			Topic:   subgroup,
+		}
+
+		// Start tracking the executions right away so they can be looked up and cancelled.
+		h.executions.start(b.ids[i], req.Client)
+	}
+
+	groups := h.groupBatch(b.requests)
+
+	h.Log().Info().
+		Int("requests", len(reqs)).
+		Int("groups", len(groups)).
+		Uint("parallelism", parallelism).
+		Msg("processing batch execution request")
+
+	var wg sync.WaitGroup
+	for _, group := range groups {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			h.executeBatchGroup(ctx, &b, group)
+		}()
+	}
+
+	wg.Wait()
+
+	return b.records
+}
+
+// executeBatchGroup does a single roll call for requests in the group, and forms a single cluster if consensus is required.
+// Requests are checked against the limits before that, so requests over a limit do not take part in the roll call.
+// Remaining requests are then executed by the chosen peers.
+func (h *HeadNode) executeBatchGroup(ctx context.Context, b *batch, group batchGroup) {
+
+	var (
+		first     = b.requests[group.items[0]]
+		clusterID = newRequestID()
+		items     = make([]int, 0, len(group.items))
+		releases  = make(map[int]func(), len(group.items))
+	)
+
+	for _, i := range group.items {
+
+		release, err := h.acquireLimits(ctx, b.ids[i], b.requests[i].Request)
+		if err != nil {
+			b.records[i] = h.saveExecutionResult(ctx, b.ids[i], b.requests[i].Request, codes.TooManyRequests, nil, execute.Cluster{}, err)
+			continue
+		}
+
+		items = append(items, i)
+		releases[i] = release
+	}
+
+	if len(items) == 0 {
+		return
+	}
+
+	log := h.Log().With().
+		Str("cluster", clusterID).
+		Str("function", first.FunctionID).
+		Int("requests", len(items)).
+		Logger()
+
+	for _, i := range items {
+		h.executions.publish(bls.ExecutionEvent{
+			RequestID: b.ids[i],
+			Type:      bls.ExecutionEventRollCallStarted,
+		})
+	}
+
+	// Roll call and cluster formation count against the parallelism limit too.
+	b.limit <- struct{}{}
+	peers, err := h.prepareBatchGroup(ctx, clusterID, first, group.consensus)
+	<-b.limit
+
+	if err != nil {
+		log.Error().Err(err).Msg("could not prepare peers for batch execution")
+
+		code := codes.Error
+		if errors.Is(err, bls.ErrRollCallTimeout) {
+			code = codes.Timeout
+		}
+
+		for _, i := range items {
+			releases[i]()
+			b.records[i] = h.saveExecutionResult(ctx, b.ids[i], b.requests[i].Request, code, nil, execute.Cluster{}, err)
+		}
+
+		return
+	}
+
+	if consensusRequired(group.consensus) {
+		// Cluster is disbanded once all of the requests are done.
+		defer h.disbandCluster(clusterID, peers)
+
+		// Cancelling a request should not tear down the cluster while other requests still use it.
+		for _, i := range items {
+			h.executions.setCluster(b.ids[i], clusterID)
+		}
+	} else {
+		// Work orders are not tied to a cluster.
+		clusterID = ""
+	}
+
+	var wg sync.WaitGroup
+	for _, i := range items {
+
+		b.limit <- struct{}{}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-b.limit }()
+
+			b.records[i] = h.executeBatchItem(ctx, b.ids[i], clusterID, b.requests[i], group.consensus, peers, releases[i])
+		}()
+	}
+
+	wg.Wait()
+
+	log.Info().Msg("batch group executed")
+}
+
+// prepareBatchGroup does the roll call for a group of batch requests, and forms the cluster if consensus is required.
+func (h *HeadNode) prepareBatchGroup(ctx context.Context, clusterID string, req request.Execute, consensus cons.Type) ([]peer.ID, error) {
+
+	peers, err := h.executeRollCall(ctx, clusterID, req, consensus, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not roll call peers (cluster: %s): %w", clusterID, err)
+	}
+
+	if consensusRequired(consensus) {
+		err = h.formCluster(ctx, clusterID, peers, consensus)
+		if err != nil {
+			return nil, fmt.Errorf("could not form cluster (cluster: %s): %w", clusterID, err)
+		}
+	}
+
+	return peers, nil
+}
+
+// executeBatchItem executes a single request from a batch, using the peers chosen for its group. Limits acquired for the request are released once it is done.
+func (h *HeadNode) executeBatchItem(
+	ctx context.Context,
+	requestID string,
+	clusterID string,
+	req request.Execute,
+	consensus cons.Type,
+	peers []peer.ID,
+	release func(),
+) bls.ExecutionRecord {
+
+	h.Metrics().IncrCounterWithLabels(executionsMetric, 1,
+		[]metrics.Label{
+			{Name: "function", Value: req.FunctionID},
+			{Name: "consensus", Value: req.Config.ConsensusAlgorithm},
+		})
+
+	ctx, span := h.Tracer().Start(ctx, spanExecute,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(tracing.ExecutionAttributes(requestID, req.Request)...))
+	defer span.End()
+
+	h.executions.addPeers(requestID, peers...)
+	h.executions.set(requestID, bls.ExecutionPhaseClusterFormed)
+	h.executions.publish(bls.ExecutionEvent{
+		RequestID: requestID,
+		Type:      bls.ExecutionEventClusterFormed,
+		Peers:     peers,
+	})
+
+	code, results, cluster, err := h.cancellable(ctx, requestID, func(ctx context.Context) (codes.Code, execute.ResultMap, execute.Cluster, error) {
+		return h.executeWorkOrder(ctx, requestID, clusterID, req, consensus, peers)
+	})
+
+	release()
+
+	if err != nil {
+		h.Log().Error().Str("request", requestID).Err(err).Msg("execution failed")
+	}
+
+	return h.saveExecutionResult(ctx, requestID, req.Request, code, results, cluster, err)
+}
+
+// groupBatch groups the requests that can share a roll call. Groups, and requests within them, keep the order of the batch.
+func (h *HeadNode) groupBatch(requests []request.Execute) []batchGroup {
+
+	var (
+		groups []batchGroup
+		index  = make(map[batchGroupKey]int)
+	)
+
+	for i, req := range requests {
+
+		consensus, err := cons.Parse(req.Config.ConsensusAlgorithm)
+		if err != nil {
+			consensus = h.cfg.DefaultConsensus
+		}
+
+		key := newBatchGroupKey(req.Request, consensus)
+
+		n, ok := index[key]
+		if !ok {
+			groups = append(groups, batchGroup{consensus: consensus})
+			n = len(groups) - 1
+			index[key] = n
+		}
+
+		groups[n].items = append(groups[n].items, i)
+	}
+
+	return groups
+}
+
+func newBatchGroupKey(req execute.Request, consensus cons.Type) batchGroupKey {
+
+	key := batchGroupKey{
+		functionID:    req.FunctionID,
+		consensus:     consensus,
+		nodeCount:     req.Config.NodeCount,
+		timeout:       req.Config.Timeout,
+		peerSelection: req.Config.PeerSelection,
+	}
+
+	if req.Config.Attributes != nil {
+		key.attributes = fmt.Sprint(*req.Config.Attributes)
+	}
+
+	return key
+}
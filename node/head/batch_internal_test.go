@@ -0,0 +1,163 @@
+package head
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/consensus"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/models/request"
+	"github.com/blessnetwork/b7s/models/response"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_GroupBatch(t *testing.T) {
+
+	newRequest := func(functionID string, consensus string, nodeCount int) request.Execute {
+		req := mocks.GenericExecutionRequest
+		req.FunctionID = functionID
+		req.Config.ConsensusAlgorithm = consensus
+		req.Config.NodeCount = nodeCount
+
+		return request.Execute{Request: req}
+	}
+
+	requests := []request.Execute{
+		newRequest("function-1", "", 1),
+		newRequest("function-2", "", 1),
+		newRequest("function-1", "", 1),
+		newRequest("function-1", "raft", 3),
+		newRequest("function-1", "", 2),
+		newRequest("function-1", "raft", 3),
+	}
+
+	head := createHeadNode(t)
+
+	groups := head.groupBatch(requests)
+	require.Equal(t, []batchGroup{
+		{items: []int{0, 2}},
+		{items: []int{1}},
+		{consensus: consensus.Raft, items: []int{3, 5}},
+		{items: []int{4}},
+	}, groups)
+}
+
+func TestHead_ExecuteFunctionBatch(t *testing.T) {
+
+	var (
+		workerID = mocks.GenericPeerID
+		result   = execute.NodeResult{
+			Result: mocks.GenericExecutionResult,
+		}
+
+		lock       sync.Mutex
+		rollCalls  int
+		workOrders []string
+	)
+
+	newRequest := func(functionID string) execute.Request {
+		req := mocks.GenericExecutionRequest
+		req.FunctionID = functionID
+		req.Config.ConsensusAlgorithm = ""
+		return req
+	}
+
+	requests := []execute.Request{
+		newRequest("function-1"),
+		newRequest("function-2"),
+		newRequest("function-1"),
+		newRequest("function-1"),
+	}
+
+	head := createHeadNode(t)
+
+	core := mocks.BaselineNodeCore(t)
+	core.ConnectedFunc = func(peer.ID) bool {
+		return true
+	}
+	core.PublishToTopicFunc = func(_ context.Context, _ string, msg bls.Message) error {
+		lock.Lock()
+		defer lock.Unlock()
+
+		rc, ok := any(msg).(*request.RollCall)
+		require.True(t, ok)
+
+		rollCalls++
+
+		head.rollCall.add(rc.RequestID, rollCallResponse{
+			From: workerID,
+			RollCall: response.RollCall{
+				Code:       codes.Accepted,
+				FunctionID: rc.FunctionID,
+				RequestID:  rc.RequestID,
+			},
+		})
+
+		return nil
+	}
+	core.SendToManyFunc = func(_ context.Context, peers []peer.ID, msg bls.Message, _ bool) error {
+		lock.Lock()
+		defer lock.Unlock()
+
+		wo, ok := any(msg).(*request.WorkOrder)
+		require.True(t, ok)
+
+		// No consensus so work orders are not tied to a cluster.
+		require.Empty(t, wo.ClusterID)
+
+		workOrders = append(workOrders, wo.RequestID)
+		for _, id := range peers {
+			head.workOrderResponses.Set(peerRequestKey(wo.RequestID, id), result)
+		}
+
+		return nil
+	}
+	head.Core = core
+
+	records := head.ExecuteFunctionBatch(context.Background(), requests, "", 2)
+	require.Len(t, records, len(requests))
+
+	// One roll call per function, but one work order per request.
+	require.Equal(t, 2, rollCalls)
+	require.Len(t, workOrders, len(requests))
+
+	for i, record := range records {
+		require.Equal(t, codes.OK, record.Code)
+		require.Equal(t, requests[i].FunctionID, record.FunctionID)
+		require.Equal(t, execute.ResultMap{workerID: result}, record.Results)
+		require.Contains(t, workOrders, record.RequestID)
+	}
+}
+
+func TestHead_ExecuteFunctionBatchOverLimit(t *testing.T) {
+
+	req := mocks.GenericExecutionRequest
+	req.Client = "dummy-client"
+
+	head, err := New(mocks.BaselineNodeCore(t), mocks.BaselineStore(t), ClientConcurrency(1))
+	require.NoError(t, err)
+
+	core := mocks.BaselineNodeCore(t)
+	core.PublishToTopicFunc = func(context.Context, string, bls.Message) error {
+		require.FailNow(t, "unexpected roll call for requests over the limit")
+		return nil
+	}
+	head.Core = core
+
+	// Client already has an execution running.
+	_, err = head.limits.acquire(context.Background(), req.Client, req.FunctionID)
+	require.NoError(t, err)
+
+	records := head.ExecuteFunctionBatch(context.Background(), []execute.Request{req, req}, "", 2)
+	require.Len(t, records, 2)
+
+	for _, record := range records {
+		require.Equal(t, codes.TooManyRequests, record.Code)
+	}
+}
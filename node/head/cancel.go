@@ -0,0 +1,51 @@
+package head
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/request"
+)
+
+// CancelExecution aborts an execution in progress. Workers chosen to execute the request are asked to stop the execution
+// and leave the consensus cluster, if one was formed. A cluster shared by requests from a batch is left only once none of
+// its requests are in progress. Execution result is recorded with the `codes.Cancelled` code.
+func (h *HeadNode) CancelExecution(ctx context.Context, id string) error {
+
+	peers, ok := h.executions.peers(id)
+	if !ok {
+		return fmt.Errorf("execution not in progress (request: %s): %w", id, bls.ErrNotFound)
+	}
+
+	log := h.Log().With().Str("request", id).Logger()
+
+	// Let the workers know before we stop waiting on them, so the cancellation reaches them before the cluster disband request.
+	if len(peers) > 0 {
+
+		msg := request.CancelExecution{
+			RequestID: id,
+		}
+
+		// Other requests may still be executing on a shared cluster.
+		cluster, last := h.executions.sharedCluster(id)
+		if last {
+			msg.ClusterID = cluster
+		}
+
+		err := h.SendToMany(ctx, peers, &msg, false)
+		if err != nil {
+			// Proceed with the cancellation - workers will not be waited on anyway.
+			log.Warn().Err(err).Msg("could not send cancellation request to all workers")
+		}
+	}
+
+	ok = h.executions.cancel(id)
+	if !ok {
+		return fmt.Errorf("execution not in progress (request: %s): %w", id, bls.ErrNotFound)
+	}
+
+	log.Info().Strs("peers", bls.PeerIDsToStr(peers)).Msg("execution cancelled")
+
+	return nil
+}
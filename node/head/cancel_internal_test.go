@@ -0,0 +1,95 @@
+package head
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/request"
+	"github.com/blessnetwork/b7s/models/response"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_CancelExecution(t *testing.T) {
+
+	var (
+		requestID = fmt.Sprintf("request-id-%v", rand.Int())
+		req       = mocks.GenericExecutionRequest
+		workerID  = mocks.GenericPeerID
+
+		workOrderSent = make(chan struct{})
+		cancelSent    = make(chan []peer.ID, 1)
+	)
+
+	head := createHeadNode(t)
+
+	// Nothing to cancel yet.
+	require.ErrorIs(t, head.CancelExecution(context.Background(), requestID), bls.ErrNotFound)
+
+	head.rollCall.create(requestID)
+	head.rollCall.add(requestID, rollCallResponse{
+		From: workerID,
+		RollCall: response.RollCall{
+			Code:       codes.Accepted,
+			FunctionID: req.FunctionID,
+			RequestID:  requestID,
+		},
+	})
+
+	core := mocks.BaselineNodeCore(t)
+	core.ConnectedFunc = func(peer.ID) bool {
+		return true
+	}
+	// Worker never responds to the work order.
+	core.SendToManyFunc = func(_ context.Context, peers []peer.ID, msg bls.Message, _ bool) error {
+
+		switch m := any(msg).(type) {
+		case *request.WorkOrder:
+			close(workOrderSent)
+
+		case *request.CancelExecution:
+			require.Equal(t, requestID, m.RequestID)
+			cancelSent <- peers
+
+		default:
+			require.FailNow(t, "unexpected message", "type: %s", msg.Type())
+		}
+
+		return nil
+	}
+	head.Core = core
+
+	type result struct {
+		code codes.Code
+		err  error
+	}
+
+	done := make(chan result, 1)
+	go func() {
+		code, _, _, err := head.execute(context.Background(), requestID, request.Execute{Request: req})
+		done <- result{code: code, err: err}
+	}()
+
+	<-workOrderSent
+
+	err := head.CancelExecution(context.Background(), requestID)
+	require.NoError(t, err)
+
+	require.Equal(t, []peer.ID{workerID}, <-cancelSent)
+
+	select {
+	case res := <-done:
+		require.ErrorIs(t, res.err, bls.ErrExecutionCancelled)
+		require.Equal(t, codes.Cancelled, res.code)
+
+	case <-time.After(DefaultExecutionTimeout / 2):
+		t.Fatal("execution was not cancelled")
+	}
+}
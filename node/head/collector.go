@@ -0,0 +1,85 @@
+package head
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blessnetwork/b7s/models/bls"
+)
+
+// responseCollector collects the responses peers send to a published request, for as long as the request is tracked.
+type responseCollector[T any] struct {
+	sync.Mutex
+
+	m map[string]map[peer.ID]T
+}
+
+func newResponseCollector[T any]() *responseCollector[T] {
+
+	c := responseCollector[T]{
+		m: make(map[string]map[peer.ID]T),
+	}
+
+	return &c
+}
+
+// create starts collecting responses for the request.
+func (c *responseCollector[T]) create(requestID string) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.m[requestID] = make(map[peer.ID]T)
+}
+
+// add records the response of the peer. Responses for requests that are not tracked are ignored.
+func (c *responseCollector[T]) add(requestID string, from peer.ID, res T) bool {
+	c.Lock()
+	defer c.Unlock()
+
+	responses, ok := c.m[requestID]
+	if !ok {
+		return false
+	}
+
+	responses[from] = res
+	return true
+}
+
+// remove stops collecting responses for the request and returns the responses received.
+func (c *responseCollector[T]) remove(requestID string) map[peer.ID]T {
+	c.Lock()
+	defer c.Unlock()
+
+	responses := c.m[requestID]
+	delete(c.m, requestID)
+
+	return responses
+}
+
+// publishAndCollect publishes the request to the topic and returns the responses received until the timeout expires.
+func publishAndCollect[T any](ctx context.Context, h *HeadNode, collector *responseCollector[T], requestID string, topic string, msg bls.Message, timeout time.Duration) (map[peer.ID]T, error) {
+
+	collector.create(requestID)
+
+	err := h.PublishToTopic(ctx, topic, msg)
+	if err != nil {
+		collector.remove(requestID)
+		return nil, fmt.Errorf("could not publish message: %w", err)
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+		collector.remove(requestID)
+		return nil, ctx.Err()
+	}
+
+	return collector.remove(requestID), nil
+}
@@ -0,0 +1,92 @@
+package head
+
+import (
+	"slices"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+)
+
+// completionMet returns true if we have enough results to stop waiting for the rest of the peers, as determined by the completion policy.
+// Waiting for all peers is never satisfied early - it ends when all peers responded or the execution timed out.
+func completionMet(policy execute.CompletionPolicy, threshold float64, total int, results execute.ResultMap) bool {
+
+	switch policy.Type {
+	case execute.CompletionThreshold:
+		if total == 0 {
+			return false
+		}
+		return float64(mostMatchingResults(results))/float64(total) >= threshold
+
+	case execute.CompletionFirstN:
+		return successfulResults(results) >= policy.Count
+
+	case execute.CompletionFirstMatchingK:
+		return mostMatchingResults(results) >= policy.Count
+
+	default:
+		return false
+	}
+}
+
+// latePeers returns peers that did not send a result.
+func latePeers(peers []peer.ID, results execute.ResultMap) []peer.ID {
+
+	var late []peer.ID
+	for _, id := range peers {
+		_, ok := results[id]
+		if !ok {
+			late = append(late, id)
+		}
+	}
+
+	return late
+}
+
+func successfulResults(results execute.ResultMap) int {
+
+	count := 0
+	for _, res := range results {
+		if res.Code == codes.OK {
+			count++
+		}
+	}
+
+	return count
+}
+
+// mostMatchingResults returns the number of peers that returned the most common successful result.
+func mostMatchingResults(results execute.ResultMap) int {
+
+	seen := make(map[execute.RuntimeOutput]int)
+	most := 0
+	for _, res := range results {
+		if res.Code != codes.OK {
+			continue
+		}
+
+		seen[res.Result.Result]++
+		most = max(most, seen[res.Result.Result])
+	}
+
+	return most
+}
+
+// respondedPeers returns the peers, omitting the ones that were late.
+func respondedPeers(peers []peer.ID, late []peer.ID) []peer.ID {
+
+	if len(late) == 0 {
+		return peers
+	}
+
+	out := make([]peer.ID, 0, len(peers))
+	for _, id := range peers {
+		if !slices.Contains(late, id) {
+			out = append(out, id)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,154 @@
+package head
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/consensus/pbft"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_CompletionMet(t *testing.T) {
+
+	result := func(code codes.Code, stdout string) execute.NodeResult {
+		return execute.NodeResult{
+			Result: execute.Result{
+				Code:   code,
+				Result: execute.RuntimeOutput{Stdout: stdout},
+			},
+		}
+	}
+
+	results := execute.ResultMap{
+		"peer-1": result(codes.OK, "a"),
+		"peer-2": result(codes.OK, "a"),
+		"peer-3": result(codes.OK, "b"),
+		"peer-4": result(codes.Error, ""),
+	}
+
+	tests := []struct {
+		name      string
+		policy    execute.CompletionPolicy
+		threshold float64
+		total     int
+		met       bool
+	}{
+		{name: "wait all is never met early", policy: execute.CompletionPolicy{}, total: 4},
+		{name: "explicit wait all is never met early", policy: execute.CompletionPolicy{Type: execute.CompletionWaitAll}, total: 4},
+		{name: "threshold met", policy: execute.CompletionPolicy{Type: execute.CompletionThreshold}, threshold: 0.4, total: 5, met: true},
+		{name: "threshold not met", policy: execute.CompletionPolicy{Type: execute.CompletionThreshold}, threshold: 0.6, total: 5},
+		{name: "first n met", policy: execute.CompletionPolicy{Type: execute.CompletionFirstN, Count: 3}, total: 5, met: true},
+		{name: "first n not met - failures do not count", policy: execute.CompletionPolicy{Type: execute.CompletionFirstN, Count: 4}, total: 5},
+		{name: "first matching k met", policy: execute.CompletionPolicy{Type: execute.CompletionFirstMatchingK, Count: 2}, total: 5, met: true},
+		{name: "first matching k not met", policy: execute.CompletionPolicy{Type: execute.CompletionFirstMatchingK, Count: 3}, total: 5},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.met, completionMet(test.policy, test.threshold, test.total, results))
+		})
+	}
+}
+
+func TestHead_GatherExecutionResultsCompletion(t *testing.T) {
+
+	var (
+		requestID = fmt.Sprintf("request-id-%v", rand.Int())
+		peers     = []peer.ID{"peer-1", "peer-2", "peer-3"}
+		res       = execute.NodeResult{
+			Result: execute.Result{
+				Code:   codes.OK,
+				Result: execute.RuntimeOutput{Stdout: "ok"},
+			},
+		}
+		policy = execute.CompletionPolicy{
+			Type:  execute.CompletionFirstMatchingK,
+			Count: 2,
+		}
+	)
+
+	head, err := New(mocks.BaselineNodeCore(t), mocks.BaselineStore(t))
+	require.NoError(t, err)
+
+	// Two of the three peers respond.
+	for _, id := range peers[:2] {
+		head.workOrderResponses.Set(peerRequestKey(requestID, id), res)
+	}
+
+	start := time.Now()
+	results := head.gatherExecutionResults(context.Background(), requestID, peers, policy, 0)
+
+	// We should not have waited for the execution timeout.
+	require.Less(t, time.Since(start), DefaultExecutionTimeout/2)
+
+	require.Len(t, results, 2)
+	require.Equal(t, []peer.ID{peers[2]}, latePeers(peers, results))
+	require.Equal(t, peers[:2], respondedPeers(peers, latePeers(peers, results)))
+}
+
+func TestHead_GatherExecutionResultsPBFT(t *testing.T) {
+
+	var (
+		requestID = fmt.Sprintf("request-id-%v", rand.Int())
+		timestamp = time.Now().UTC()
+		result    = execute.Result{
+			Code:   codes.OK,
+			Result: execute.RuntimeOutput{Stdout: "ok"},
+		}
+
+		keys  []crypto.PrivKey
+		peers []peer.ID
+	)
+
+	// Four replicas tolerate one faulty replica, so two matching results are needed.
+	for i := 0; i < 4; i++ {
+		key, _, err := crypto.GenerateEd25519Key(nil)
+		require.NoError(t, err)
+
+		id, err := peer.IDFromPrivateKey(key)
+		require.NoError(t, err)
+
+		keys = append(keys, key)
+		peers = append(peers, id)
+	}
+
+	head, err := New(mocks.BaselineNodeCore(t), mocks.BaselineStore(t))
+	require.NoError(t, err)
+
+	// Two of the four replicas respond.
+	for i, id := range peers[:2] {
+
+		receipt, err := execute.NewReceipt(requestID, mocks.GenericExecutionRequest, id, result)
+		require.NoError(t, err)
+		require.NoError(t, receipt.Sign(keys[i]))
+
+		res := execute.NodeResult{
+			Result: result,
+			PBFT: execute.PBFTResultInfo{
+				RequestTimestamp: timestamp,
+				Replica:          id,
+			},
+			Receipt: &receipt,
+		}
+		require.NoError(t, res.Sign(keys[i]))
+
+		head.workOrderResponses.Set(peerRequestKey(requestID, id), res)
+	}
+
+	results := head.gatherExecutionResultsPBFT(context.Background(), requestID, peers)
+	require.Len(t, results, 2)
+
+	// Signed results are preserved, so they can be certified.
+	cert := pbft.NewResultCertificate(requestID, peers, results)
+	require.NotNil(t, cert)
+	require.NoError(t, cert.Verify(peers))
+}
@@ -1,9 +1,14 @@
 package head
 
 import (
+	"errors"
+	"fmt"
+	"net/netip"
 	"time"
 
+	"github.com/blessnetwork/b7s/config"
 	"github.com/blessnetwork/b7s/consensus"
+	"github.com/blessnetwork/b7s/node/head/selection"
 )
 
 // Option can be used to set Node configuration options.
@@ -15,6 +20,18 @@ var DefaultConfig = Config{
 	ExecutionTimeout:        DefaultExecutionTimeout,
 	ClusterFormationTimeout: DefaultClusterFormationTimeout,
 	DefaultConsensus:        DefaultConsensusAlgorithm,
+	DefaultPeerSelection:    DefaultPeerSelectionStrategy,
+	PeerSelectionWindow:     DefaultPeerSelectionWindow,
+	ExecutionResultTTL:      config.DefaultExecutionResultTTL,
+	ExecutionResultLimit:    config.DefaultExecutionResultLimit,
+	WebhookAttempts:         config.DefaultWebhookAttempts,
+	WebhookTimeout:          config.DefaultWebhookTimeout,
+	WebhookBackoff:          config.DefaultWebhookBackoff,
+	ReputationHalfLife:      config.DefaultReputationHalfLife,
+	MinimumReputation:       config.DefaultMinimumReputation,
+	BatchParallelism:        config.DefaultBatchParallelism,
+	PeerExpiry:              config.DefaultPeerExpiry,
+	AsyncExecutionLimit:     config.DefaultAsyncExecutionLimit,
 }
 
 // Config represents the Node configuration.
@@ -23,8 +40,194 @@ type Config struct {
 	ExecutionTimeout        time.Duration  // How long does the head node wait for worker nodes to send their execution results.
 	ClusterFormationTimeout time.Duration  // How long do we wait for the nodes to form a cluster for an execution.
 	DefaultConsensus        consensus.Type // Default consensus algorithm to use.
+	DefaultPeerSelection    selection.Type // Default strategy for choosing peers that will execute the request.
+	PeerSelectionWindow     time.Duration  // How long do we collect roll call responses before choosing peers. Not used for first-come selection.
+	ExecutionResultTTL      time.Duration  // How long do we keep execution results around. Zero means results do not expire.
+	ExecutionResultLimit    uint           // How many execution results do we keep at most. Zero means there is no limit.
+	WebhookAttempts         uint           // How many times do we try to deliver the execution result to a webhook.
+	WebhookTimeout          time.Duration  // How long do we wait for the webhook to respond.
+	WebhookBackoff          time.Duration  // How long do we wait before retrying webhook delivery. Doubles after each failed attempt.
+	WebhookAllowedNetworks  []netip.Prefix // Non-public networks webhooks may be delivered to. By default, only public addresses are allowed.
+	ReputationHalfLife      time.Duration  // How long until the recorded peer behavior counts half as much. Zero means the behavior is never forgotten.
+	MinimumReputation       float64        // Peers with reputation score below this are not chosen for execution. Zero means no peers are skipped.
+	BatchParallelism        uint           // How many requests from a batch are executed at the same time.
+	VerifyAttributes        bool           // Verify the attestation of peers reporting for roll calls with attribute requirements.
+	PeerExpiry              time.Duration  // How long since the last health ping until a peer is removed from the peer registry. Zero means peers are never removed.
+	ClientRateLimit         float64        // How many requests per second a client can make. Zero means there is no limit.
+	ClientRateBurst         uint           // How many requests a client can make at once. Zero means the burst follows the rate limit.
+	FunctionRateLimit       float64        // How many requests per second can be made for a function. Zero means there is no limit.
+	FunctionRateBurst       uint           // How many requests can be made for a function at once. Zero means the burst follows the rate limit.
+	ClientConcurrency       uint           // How many requests of a client are executed at the same time. Zero means there is no limit.
+	FunctionConcurrency     uint           // How many requests for a function are executed at the same time. Zero means there is no limit.
+	ClientDailyQuota        UsageQuota     // How much resource usage a client can incur in a day.
+	AsyncExecutionLimit     uint           // How many asynchronous executions can run at the same time.
 }
 
 func (c Config) Valid() error {
+
+	if !c.DefaultPeerSelection.Valid() {
+		return fmt.Errorf("invalid peer selection strategy: %v", c.DefaultPeerSelection)
+	}
+
+	if c.MinimumReputation < 0 || c.MinimumReputation > 1 {
+		return fmt.Errorf("minimum reputation must be in the [0, 1] range: %v", c.MinimumReputation)
+	}
+
+	if c.WebhookAttempts == 0 {
+		return errors.New("webhook delivery attempts must be greater than zero")
+	}
+
+	if c.BatchParallelism == 0 {
+		return errors.New("batch parallelism must be greater than zero")
+	}
+
+	if c.AsyncExecutionLimit == 0 {
+		return errors.New("async execution limit must be greater than zero")
+	}
+
+	if c.ClientRateLimit < 0 || c.FunctionRateLimit < 0 {
+		return errors.New("rate limits cannot be negative")
+	}
+
+	if c.ClientDailyQuota.WallClockTime < 0 || c.ClientDailyQuota.CPUTime < 0 || c.ClientDailyQuota.MemoryKB < 0 {
+		return errors.New("usage quota cannot be negative")
+	}
+
 	return nil
 }
+
+// DefaultPeerSelection sets the strategy used for choosing peers when the execution request does not specify one.
+func DefaultPeerSelection(t selection.Type) Option {
+	return func(cfg *Config) {
+		cfg.DefaultPeerSelection = t
+	}
+}
+
+// PeerSelectionWindow sets how long the head node collects roll call responses before choosing peers.
+func PeerSelectionWindow(d time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.PeerSelectionWindow = d
+	}
+}
+
+// ExecutionResultTTL sets how long the head node keeps the execution results.
+func ExecutionResultTTL(d time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.ExecutionResultTTL = d
+	}
+}
+
+// ExecutionResultLimit sets the maximum number of execution results the head node keeps.
+func ExecutionResultLimit(n uint) Option {
+	return func(cfg *Config) {
+		cfg.ExecutionResultLimit = n
+	}
+}
+
+// WebhookAttempts sets how many times the head node tries to deliver the execution result to a webhook.
+func WebhookAttempts(n uint) Option {
+	return func(cfg *Config) {
+		cfg.WebhookAttempts = n
+	}
+}
+
+// WebhookTimeout sets how long the head node waits for the webhook to respond.
+func WebhookTimeout(d time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.WebhookTimeout = d
+	}
+}
+
+// WebhookBackoff sets how long the head node waits before retrying webhook delivery.
+func WebhookBackoff(d time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.WebhookBackoff = d
+	}
+}
+
+// WebhookAllowedNetworks sets the non-public networks that webhooks may be delivered to.
+func WebhookAllowedNetworks(networks ...netip.Prefix) Option {
+	return func(cfg *Config) {
+		cfg.WebhookAllowedNetworks = networks
+	}
+}
+
+// ReputationHalfLife sets how long until the recorded peer behavior counts half as much.
+func ReputationHalfLife(d time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.ReputationHalfLife = d
+	}
+}
+
+// MinimumReputation sets the reputation score peers need to have in order to be chosen for execution.
+func MinimumReputation(score float64) Option {
+	return func(cfg *Config) {
+		cfg.MinimumReputation = score
+	}
+}
+
+// BatchParallelism sets how many requests from a batch are executed at the same time.
+func BatchParallelism(n uint) Option {
+	return func(cfg *Config) {
+		cfg.BatchParallelism = n
+	}
+}
+
+// VerifyAttributes sets whether the head node verifies the attestation of peers reporting for roll calls with attribute requirements,
+// instead of trusting the peers to check the attributes themselves.
+func VerifyAttributes(b bool) Option {
+	return func(cfg *Config) {
+		cfg.VerifyAttributes = b
+	}
+}
+
+// PeerExpiry sets how long since the last health ping until a peer is removed from the peer registry.
+func PeerExpiry(d time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.PeerExpiry = d
+	}
+}
+
+// ClientRateLimit sets how many requests per second a client can make, and how many requests it can make at once.
+func ClientRateLimit(rate float64, burst uint) Option {
+	return func(cfg *Config) {
+		cfg.ClientRateLimit = rate
+		cfg.ClientRateBurst = burst
+	}
+}
+
+// FunctionRateLimit sets how many requests per second can be made for a function, and how many can be made at once.
+func FunctionRateLimit(rate float64, burst uint) Option {
+	return func(cfg *Config) {
+		cfg.FunctionRateLimit = rate
+		cfg.FunctionRateBurst = burst
+	}
+}
+
+// ClientConcurrency sets how many requests of a client are executed at the same time.
+func ClientConcurrency(n uint) Option {
+	return func(cfg *Config) {
+		cfg.ClientConcurrency = n
+	}
+}
+
+// FunctionConcurrency sets how many requests for a function are executed at the same time.
+func FunctionConcurrency(n uint) Option {
+	return func(cfg *Config) {
+		cfg.FunctionConcurrency = n
+	}
+}
+
+// ClientDailyQuota sets how much resource usage a client can incur in a day.
+func ClientDailyQuota(quota UsageQuota) Option {
+	return func(cfg *Config) {
+		cfg.ClientDailyQuota = quota
+	}
+}
+
+// AsyncExecutionLimit sets how many asynchronous executions can run at the same time.
+func AsyncExecutionLimit(n uint) Option {
+	return func(cfg *Config) {
+		cfg.AsyncExecutionLimit = n
+	}
+}
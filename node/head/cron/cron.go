@@ -0,0 +1,262 @@
+// Package cron parses cron expressions used for scheduled executions and determines when they should run next.
+// Supported are standard five field expressions (minute, hour, day of month, month, day of week) and the common descriptors like `@hourly`.
+// Schedules are evaluated in UTC.
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed cron expression.
+type Schedule struct {
+	minute bitset
+	hour   bitset
+	dom    bitset
+	month  bitset
+	dow    bitset
+
+	// If both day of month and day of week are restricted, a day matching either of them matches.
+	anyDay bool
+}
+
+// How far ahead do we look for the next run. Expressions like `0 0 30 2 *` never run.
+const searchLimitYears = 5
+
+type bitset uint64
+
+func (b bitset) has(n int) bool {
+	return b&(1<<uint(n)) != 0
+}
+
+type field struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	minuteField = field{name: "minute", min: 0, max: 59}
+	hourField   = field{name: "hour", min: 0, max: 23}
+	domField    = field{name: "day of month", min: 1, max: 31}
+	monthField  = field{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// Both 0 and 7 are Sunday.
+	dowField = field{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Parse parses a cron expression.
+func Parse(expr string) (Schedule, error) {
+
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "@") {
+		spec, ok := descriptors[strings.ToLower(expr)]
+		if !ok {
+			return Schedule{}, fmt.Errorf("unknown descriptor: %v", expr)
+		}
+
+		expr = spec
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return Schedule{}, fmt.Errorf("expected 5 fields, got %d", len(fields))
+	}
+
+	var (
+		schedule Schedule
+		err      error
+	)
+
+	schedule.minute, err = parseField(fields[0], minuteField)
+	if err != nil {
+		return Schedule{}, err
+	}
+	schedule.hour, err = parseField(fields[1], hourField)
+	if err != nil {
+		return Schedule{}, err
+	}
+	schedule.dom, err = parseField(fields[2], domField)
+	if err != nil {
+		return Schedule{}, err
+	}
+	schedule.month, err = parseField(fields[3], monthField)
+	if err != nil {
+		return Schedule{}, err
+	}
+	schedule.dow, err = parseField(fields[4], dowField)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	// Sunday can be specified as 7 too.
+	if schedule.dow.has(7) {
+		schedule.dow |= 1
+	}
+
+	schedule.anyDay = !isWildcard(fields[2]) && !isWildcard(fields[4])
+
+	return schedule, nil
+}
+
+// Next returns the first time after the given time when the schedule should run.
+// If the schedule does not run in the next few years, zero time is returned.
+func (s Schedule) Next(after time.Time) time.Time {
+
+	t := after.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Year() + searchLimitYears
+
+	for t.Year() <= limit {
+
+		if !s.month.has(int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if !s.hour.has(t.Hour()) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if !s.minute.has(t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s Schedule) dayMatches(t time.Time) bool {
+
+	dom := s.dom.has(t.Day())
+	dow := s.dow.has(int(t.Weekday()))
+
+	if s.anyDay {
+		return dom || dow
+	}
+
+	return dom && dow
+}
+
+func isWildcard(expr string) bool {
+	return expr == "*" || expr == "?"
+}
+
+// parseField parses a comma-separated list of values, ranges and steps - e.g. `1,5-10,*/15`.
+func parseField(expr string, f field) (bitset, error) {
+
+	var set bitset
+	for _, part := range strings.Split(expr, ",") {
+		bits, err := parseRange(part, f)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s field (%s): %w", f.name, expr, err)
+		}
+
+		set |= bits
+	}
+
+	return set, nil
+}
+
+func parseRange(expr string, f field) (bitset, error) {
+
+	step := 1
+	rng, stepExpr, hasStep := strings.Cut(expr, "/")
+	if hasStep {
+		var err error
+		step, err = strconv.Atoi(stepExpr)
+		if err != nil || step <= 0 {
+			return 0, fmt.Errorf("invalid step: %v", stepExpr)
+		}
+	}
+
+	var start, end int
+	switch {
+	case isWildcard(rng):
+		start, end = f.min, f.max
+
+	case strings.Contains(rng, "-"):
+		lo, hi, _ := strings.Cut(rng, "-")
+
+		var err error
+		start, err = f.value(lo)
+		if err != nil {
+			return 0, err
+		}
+		end, err = f.value(hi)
+		if err != nil {
+			return 0, err
+		}
+
+		if start > end {
+			return 0, fmt.Errorf("range start is after range end: %v", rng)
+		}
+
+	default:
+		value, err := f.value(rng)
+		if err != nil {
+			return 0, err
+		}
+
+		start, end = value, value
+		// A single value with a step (`5/15`) means starting from that value.
+		if hasStep {
+			end = f.max
+		}
+	}
+
+	var set bitset
+	for n := start; n <= end; n += step {
+		set |= 1 << uint(n)
+	}
+
+	return set, nil
+}
+
+func (f field) value(expr string) (int, error) {
+
+	if expr == "" {
+		return 0, errors.New("empty value")
+	}
+
+	n, ok := f.names[strings.ToLower(expr)]
+	if !ok {
+		var err error
+		n, err = strconv.Atoi(expr)
+		if err != nil {
+			return 0, fmt.Errorf("invalid value: %v", expr)
+		}
+	}
+
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("value out of range [%d, %d]: %d", f.min, f.max, n)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,84 @@
+package cron_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/node/head/cron"
+)
+
+func TestCron_Parse(t *testing.T) {
+
+	valid := []string{
+		"* * * * *",
+		"*/5 * * * *",
+		"0 12 * * mon-fri",
+		"15,45 0-6/2 1 jan,jul *",
+		"0 0 * * 7",
+		"5/10 * * * *",
+		"@hourly",
+		"@Daily",
+	}
+
+	for _, expr := range valid {
+		_, err := cron.Parse(expr)
+		require.NoError(t, err, expr)
+	}
+
+	invalid := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"10-5 * * * *",
+		"a * * * *",
+		"1,,2 * * * *",
+		"@fortnightly",
+	}
+
+	for _, expr := range invalid {
+		_, err := cron.Parse(expr)
+		require.Error(t, err, expr)
+	}
+}
+
+func TestCron_Next(t *testing.T) {
+
+	// Monday.
+	start := time.Date(2024, time.January, 1, 10, 7, 30, 0, time.UTC)
+
+	tests := []struct {
+		expr string
+		next time.Time
+	}{
+		{expr: "* * * * *", next: time.Date(2024, time.January, 1, 10, 8, 0, 0, time.UTC)},
+		{expr: "*/15 * * * *", next: time.Date(2024, time.January, 1, 10, 15, 0, 0, time.UTC)},
+		{expr: "@hourly", next: time.Date(2024, time.January, 1, 11, 0, 0, 0, time.UTC)},
+		{expr: "@daily", next: time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		{expr: "30 9 * * *", next: time.Date(2024, time.January, 2, 9, 30, 0, 0, time.UTC)},
+		{expr: "0 0 * * sun", next: time.Date(2024, time.January, 7, 0, 0, 0, 0, time.UTC)},
+		{expr: "0 0 * * 7", next: time.Date(2024, time.January, 7, 0, 0, 0, 0, time.UTC)},
+		{expr: "0 0 1 * *", next: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{expr: "0 0 29 2 *", next: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{expr: "0 0 31 * *", next: time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC)},
+		// Day of month and day of week both set - either one matches.
+		{expr: "0 0 15 * fri", next: time.Date(2024, time.January, 5, 0, 0, 0, 0, time.UTC)},
+		{expr: "0 0 30 2 *", next: time.Time{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			schedule, err := cron.Parse(test.expr)
+			require.NoError(t, err)
+
+			require.Equal(t, test.next, schedule.Next(start))
+		})
+	}
+}
@@ -48,11 +48,11 @@ func (h *HeadNode) processExecute(ctx context.Context, from peer.ID, req request
 
 	log.Info().Stringer("code", code).Msg("execution complete")
 
+	h.saveExecutionResult(ctx, requestID, req.Request, code, results, cluster, err)
+
 	res := req.Response(code, requestID).WithResults(results).WithCluster(cluster)
 	// Communicate the reason for failure in these cases.
-	if errors.Is(err, bls.ErrRollCallTimeout) || errors.Is(err, bls.ErrExecutionNotEnoughNodes) {
-		res.ErrorMessage = err.Error()
-	}
+	res.ErrorMessage = executionErrorMessage(err)
 
 	// Send the response, whatever it may be (success or failure).
 	err = h.Send(ctx, from, res)
@@ -63,10 +63,53 @@ func (h *HeadNode) processExecute(ctx context.Context, from peer.ID, req request
 	return nil
 }
 
-// headExecute is called on the head node. The head node will publish a roll call and delegate an execution request to chosen nodes.
+// execute is called on the head node. The head node will publish a roll call and delegate an execution request to chosen nodes.
 // The returned map contains execution results, mapped to the peer IDs of peers who reported them.
+// If the execution is cancelled using `CancelExecution`, the returned code is `codes.Cancelled`.
 func (h *HeadNode) execute(ctx context.Context, requestID string, req request.Execute) (codes.Code, execute.ResultMap, execute.Cluster, error) {
 
+	release, err := h.acquireLimits(ctx, requestID, req.Request)
+	if err != nil {
+		return codes.TooManyRequests, nil, execute.Cluster{}, err
+	}
+
+	return h.executeAcquired(ctx, requestID, req, release)
+}
+
+// executeAcquired runs the execution for which the limits were already acquired. Limits are released once the execution is done.
+func (h *HeadNode) executeAcquired(ctx context.Context, requestID string, req request.Execute, release func()) (codes.Code, execute.ResultMap, execute.Cluster, error) {
+
+	code, results, cluster, err := h.cancellable(ctx, requestID, func(ctx context.Context) (codes.Code, execute.ResultMap, execute.Cluster, error) {
+		return h.executeRequest(ctx, requestID, req)
+	})
+
+	release()
+
+	return code, results, cluster, err
+}
+
+// cancellable runs the execution function so that it can be aborted using `CancelExecution`.
+func (h *HeadNode) cancellable(
+	ctx context.Context,
+	requestID string,
+	fn func(context.Context) (codes.Code, execute.ResultMap, execute.Cluster, error),
+) (codes.Code, execute.ResultMap, execute.Cluster, error) {
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	h.executions.setCancelFunc(requestID, cancel)
+
+	code, results, cluster, err := fn(ctx)
+	if errors.Is(context.Cause(ctx), bls.ErrExecutionCancelled) {
+		return codes.Cancelled, results, cluster, bls.ErrExecutionCancelled
+	}
+
+	return code, results, cluster, err
+}
+
+func (h *HeadNode) executeRequest(ctx context.Context, requestID string, req request.Execute) (codes.Code, execute.ResultMap, execute.Cluster, error) {
+
 	h.Metrics().IncrCounterWithLabels(executionsMetric, 1,
 		[]metrics.Label{
 			{Name: "function", Value: req.FunctionID},
@@ -82,6 +125,7 @@ func (h *HeadNode) execute(ctx context.Context, requestID string, req request.Ex
 	log := h.Log().With().
 		Str("request", requestID).
 		Str("function", req.FunctionID).
+		Str("client", req.Client).
 		Int("node_count", req.Config.NodeCount).
 		Logger()
 
@@ -103,7 +147,9 @@ func (h *HeadNode) execute(ctx context.Context, requestID string, req request.Ex
 	log.Info().Msg("processing execution request")
 
 	// Phase 1. - Issue roll call to nodes.
-	reportingPeers, err := h.executeRollCall(ctx, requestID, req, consensus)
+	h.executions.start(requestID, req.Client)
+
+	reportingPeers, err := h.executeRollCall(ctx, requestID, req, consensus, nil)
 	if err != nil {
 		code := codes.Error
 		if errors.Is(err, bls.ErrRollCallTimeout) {
@@ -113,9 +159,7 @@ func (h *HeadNode) execute(ctx context.Context, requestID string, req request.Ex
 		return code, nil, execute.Cluster{}, fmt.Errorf("could not roll call peers (request: %s): %w", requestID, err)
 	}
 
-	cluster := execute.Cluster{
-		Peers: reportingPeers,
-	}
+	h.executions.addPeers(requestID, reportingPeers...)
 
 	// Phase 2. - Request cluster formation, if we need consensus.
 	if consensusRequired(consensus) {
@@ -135,10 +179,40 @@ func (h *HeadNode) execute(ctx context.Context, requestID string, req request.Ex
 		defer h.disbandCluster(requestID, reportingPeers)
 	}
 
+	h.executions.set(requestID, bls.ExecutionPhaseClusterFormed)
+	h.executions.publish(bls.ExecutionEvent{
+		RequestID: requestID,
+		Type:      bls.ExecutionEventClusterFormed,
+		Peers:     reportingPeers,
+	})
+
 	// Phase 3. - Request execution.
+	return h.executeWorkOrder(ctx, requestID, "", req, consensus, reportingPeers)
+}
+
+// executeWorkOrder sends the work order to peers chosen to execute the request, and waits for their results.
+// If the request requires consensus, the cluster must already be formed. Cluster ID is set when the cluster is shared by multiple requests.
+func (h *HeadNode) executeWorkOrder(
+	ctx context.Context,
+	requestID string,
+	clusterID string,
+	req request.Execute,
+	consensus cons.Type,
+	reportingPeers []peer.ID,
+) (codes.Code, execute.ResultMap, execute.Cluster, error) {
+
+	log := h.Log().With().
+		Str("request", requestID).
+		Str("function", req.FunctionID).
+		Logger()
+
+	cluster := execute.Cluster{
+		Peers: reportingPeers,
+	}
 
 	// Send the work order to peers in the cluster. Non-leaders will drop the request.
 	workOrder := req.WorkOrder(requestID)
+	workOrder.ClusterID = clusterID
 
 	// If we're working with PBFT, sign the request.
 	if consensus == cons.PBFT {
@@ -148,7 +222,7 @@ func (h *HeadNode) execute(ctx context.Context, requestID string, req request.Ex
 		}
 	}
 
-	err = h.SendToMany(ctx,
+	err := h.SendToMany(ctx,
 		reportingPeers,
 		workOrder,
 		consensusRequired(consensus), // If we're using consensus, try to reach all peers.
@@ -157,6 +231,13 @@ func (h *HeadNode) execute(ctx context.Context, requestID string, req request.Ex
 		return codes.Error, nil, cluster, fmt.Errorf("could not send execution request to peers (function: %s, request: %s): %w", req.FunctionID, requestID, err)
 	}
 
+	h.executions.set(requestID, bls.ExecutionPhaseExecuting)
+	h.executions.publish(bls.ExecutionEvent{
+		RequestID: requestID,
+		Type:      bls.ExecutionEventWorkOrderSent,
+		Peers:     reportingPeers,
+	})
+
 	log.Debug().Msg("waiting for execution responses")
 
 	var results execute.ResultMap
@@ -165,6 +246,8 @@ func (h *HeadNode) execute(ctx context.Context, requestID string, req request.Ex
 
 		log.Info().Msg("received PBFT execution responses")
 
+		h.recordExecutionOutcome(ctx, consensus, reportingPeers, results)
+
 		retcode := codes.OK
 		// Use the return code from the execution as the return code.
 		for _, res := range results {
@@ -175,18 +258,35 @@ func (h *HeadNode) execute(ctx context.Context, requestID string, req request.Ex
 		return retcode, results, cluster, nil
 	}
 
-	results = h.gatherExecutionResults(ctx, requestID, reportingPeers)
+	threshold := determineThreshold(req.Request)
+	results = h.gatherExecutionResults(ctx, requestID, reportingPeers, req.Config.Completion, threshold)
 
 	log.Info().Int("cluster_size", len(reportingPeers)).Int("responded", len(results)).Msg("received execution responses")
 
+	// If we stopped waiting early, peers that did not respond yet are late - they did not fail.
+	completed := completionMet(req.Config.Completion, threshold, len(reportingPeers), results)
+	if completed {
+		cluster.Late = latePeers(reportingPeers, results)
+	}
+
+	h.recordExecutionOutcome(ctx, consensus, respondedPeers(reportingPeers, cluster.Late), results)
+
+	// Replacement workers cannot join an existing cluster.
+	if req.Config.Retry.Enabled() && !consensusRequired(consensus) && !completed && ctx.Err() == nil {
+		reportingPeers, results = h.replaceFailedWorkers(ctx, requestID, req, reportingPeers, results)
+		cluster.Peers = reportingPeers
+
+		log.Info().Int("cluster_size", len(reportingPeers)).Int("responded", len(results)).Msg("execution responses after replacing failed workers")
+	}
+
 	// How many results do we have, and how many do we expect.
 	respondRatio := float64(len(results)) / float64(len(reportingPeers))
-	threshold := determineThreshold(req.Request)
 
 	retcode := codes.OK
 	if respondRatio == 0 {
 		retcode = codes.NoContent
-	} else if respondRatio < threshold {
+	} else if respondRatio < threshold && !completed {
+		// If the completion policy was satisfied, we have the results the caller asked for.
 		log.Warn().Float64("expected", threshold).Float64("have", respondRatio).Msg("threshold condition not met")
 		retcode = codes.PartialContent
 	}
@@ -204,6 +304,14 @@ func (h *HeadNode) processWorkOrderResponse(ctx context.Context, from peer.ID, r
 	key := peerRequestKey(res.RequestID, from)
 	h.workOrderResponses.Set(key, res.Result)
 
+	result := res.Result
+	h.executions.publish(bls.ExecutionEvent{
+		RequestID: res.RequestID,
+		Type:      bls.ExecutionEventResultReceived,
+		Peer:      from,
+		Result:    &result,
+	})
+
 	return nil
 }
 
@@ -139,8 +139,55 @@ func TestHead_Execute(t *testing.T) {
 func createHeadNode(t *testing.T) *HeadNode {
 	t.Helper()
 
-	head, err := New(mocks.BaselineNodeCore(t))
+	head, err := New(mocks.BaselineNodeCore(t), mocks.BaselineStore(t))
 	require.NoError(t, err)
 
 	return head
 }
+
+func TestHead_ExecuteFunctionAsync(t *testing.T) {
+
+	rollCallStarted := make(chan struct{}, 1)
+
+	core := mocks.BaselineNodeCore(t)
+	// Roll call never completes on its own.
+	core.PublishToTopicFunc = func(ctx context.Context, _ string, _ bls.Message) error {
+		rollCallStarted <- struct{}{}
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	head, err := New(core, mocks.BaselineStore(t), AsyncExecutionLimit(1))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	requestID, err := head.ExecuteFunctionAsync(ctx, mocks.GenericExecutionRequest, "", bls.Webhook{})
+	require.NoError(t, err)
+	require.NotEmpty(t, requestID)
+
+	<-rollCallStarted
+
+	// Execution outlives the request that started it.
+	cancel()
+
+	status, err := head.ExecutionStatus(context.Background(), requestID)
+	require.NoError(t, err)
+	require.Equal(t, bls.ExecutionPhaseRollCall, status.Phase)
+
+	// Node is at its limit so further executions are rejected.
+	_, err = head.ExecuteFunctionAsync(context.Background(), mocks.GenericExecutionRequest, "", bls.Webhook{})
+	var limitErr *bls.LimitError
+	require.ErrorAs(t, err, &limitErr)
+	require.Equal(t, bls.LimitAsyncExecutions, limitErr.Limit)
+
+	// Stopping the node cancels the execution and frees up its slot.
+	head.stop()
+
+	require.Eventually(t, func() bool {
+		return len(head.asyncExecutions) == 0
+	}, time.Second, 10*time.Millisecond)
+
+	_, _, ok := head.executions.get(requestID)
+	require.False(t, ok)
+}
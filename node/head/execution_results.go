@@ -18,9 +18,9 @@ func (h *HeadNode) gatherExecutionResultsPBFT(ctx context.Context, requestID str
 	defer exCancel()
 
 	type aggregatedResult struct {
-		result   execute.Result
-		peers    []peer.ID
-		metadata map[peer.ID]any
+		peers []peer.ID
+		// Signed results of the peers, kept so they can be included in the result certificate.
+		results map[peer.ID]execute.NodeResult
 	}
 
 	var (
@@ -60,7 +60,8 @@ func (h *HeadNode) gatherExecutionResultsPBFT(ctx context.Context, requestID str
 
 			err = res.VerifySignature(pub)
 			if err != nil {
-				h.Log().Error().Err(err).Msg("could not verify signature of an execution response")
+				h.Log().Error().Err(err).Stringer("peer", sender).Msg("could not verify signature of an execution response")
+				h.reputation.RecordSignatureFailure(ctx, sender)
 				return
 			}
 
@@ -71,20 +72,19 @@ func (h *HeadNode) gatherExecutionResultsPBFT(ctx context.Context, requestID str
 			result, ok := results[reskey]
 			if !ok {
 				results[reskey] = aggregatedResult{
-					result: res.Result,
 					peers: []peer.ID{
 						sender,
 					},
-					metadata: map[peer.ID]any{
-						sender: res.Metadata,
+					results: map[peer.ID]execute.NodeResult{
+						sender: res,
 					},
 				}
 				return
 			}
 
-			// Record which peers have this result, and their metadata.
+			// Record which peers have this result, and their signed results.
 			result.peers = append(result.peers, sender)
-			result.metadata[sender] = res.Metadata
+			result.results[sender] = res
 
 			results[reskey] = result
 
@@ -93,10 +93,7 @@ func (h *HeadNode) gatherExecutionResultsPBFT(ctx context.Context, requestID str
 				exCancel()
 
 				for _, peer := range result.peers {
-					out[peer] = execute.NodeResult{
-						Result:   result.result,
-						Metadata: result.metadata[peer],
-					}
+					out[peer] = result.results[peer]
 				}
 			}
 		}(rp)
@@ -108,7 +105,8 @@ func (h *HeadNode) gatherExecutionResultsPBFT(ctx context.Context, requestID str
 }
 
 // gatherExecutionResults collects execution results from direct executions or raft clusters.
-func (h *HeadNode) gatherExecutionResults(ctx context.Context, requestID string, peers []peer.ID) execute.ResultMap {
+// We stop waiting for results once the completion policy is satisfied.
+func (h *HeadNode) gatherExecutionResults(ctx context.Context, requestID string, peers []peer.ID, policy execute.CompletionPolicy, threshold float64) execute.ResultMap {
 
 	// We're willing to wait for a limited amount of time.
 	exctx, exCancel := context.WithTimeout(ctx, h.cfg.ExecutionTimeout)
@@ -139,6 +137,11 @@ func (h *HeadNode) gatherExecutionResults(ctx context.Context, requestID string,
 			reslock.Lock()
 			defer reslock.Unlock()
 			results[peer] = res
+
+			if completionMet(policy, threshold, len(peers), results) {
+				h.Log().Info().Str("request", requestID).Str("policy", string(policy.Type)).Int("peers", len(peers)).Int("responded", len(results)).Msg("completion policy satisfied")
+				exCancel()
+			}
 		}(rp)
 	}
 
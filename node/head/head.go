@@ -3,28 +3,53 @@ package head
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"sync"
 
 	"github.com/armon/go-metrics"
 	"github.com/google/uuid"
 
 	"github.com/blessnetwork/b7s/info"
+	"github.com/blessnetwork/b7s/models/bls"
 	"github.com/blessnetwork/b7s/models/execute"
 	"github.com/blessnetwork/b7s/models/response"
 	"github.com/blessnetwork/b7s/node"
+	"github.com/blessnetwork/b7s/node/head/reputation"
+	"github.com/blessnetwork/b7s/node/head/selection"
 	"github.com/blessnetwork/b7s/node/internal/waitmap"
 )
 
 type HeadNode struct {
 	node.Core
 
-	cfg Config
+	cfg   Config
+	store bls.Store
 
-	rollCall           *rollCallQueue
-	consensusResponses *waitmap.WaitMap[string, response.FormCluster]
-	workOrderResponses *waitmap.WaitMap[string, execute.NodeResult]
+	rollCall             *rollCallQueue
+	consensusResponses   *waitmap.WaitMap[string, response.FormCluster]
+	workOrderResponses   *waitmap.WaitMap[string, execute.NodeResult]
+	attestationResponses *waitmap.WaitMap[string, response.Attestation]
+
+	executions *executionTracker
+	webhooks   *http.Client
+	selectors  map[selection.Type]selection.PeerSelector
+	reputation *reputation.Tracker
+	peers      *peerRegistry
+	limits     *limiter
+
+	functionListings *responseCollector[[]bls.InstalledFunction]
+	uninstalls       *responseCollector[response.UninstallFunction]
+	installs         *installTracker
+
+	scheduleLock sync.Mutex // Serializes updates to schedules.
+
+	// Work that outlives the request that started it runs until the node stops.
+	lifetime        context.Context
+	stop            context.CancelFunc
+	asyncExecutions chan struct{} // Limits the number of asynchronous executions running at the same time.
 }
 
-func New(core node.Core, options ...Option) (*HeadNode, error) {
+func New(core node.Core, store bls.Store, options ...Option) (*HeadNode, error) {
 
 	// Initialize config.
 	cfg := DefaultConfig
@@ -37,15 +62,38 @@ func New(core node.Core, options ...Option) (*HeadNode, error) {
 		return nil, fmt.Errorf("invalid configuration: %w", err)
 	}
 
+	reputation := reputation.NewTracker(*core.Log(), store, cfg.ReputationHalfLife)
+
+	lifetime, stop := context.WithCancel(context.Background())
+
 	head := &HeadNode{
-		Core: core,
-		cfg:  cfg,
+		Core:  core,
+		cfg:   cfg,
+		store: store,
 
-		rollCall:           newQueue(rollCallQueueBufferSize),
-		consensusResponses: waitmap.New[string, response.FormCluster](0),
-		workOrderResponses: waitmap.New[string, execute.NodeResult](executionResultCacheSize),
+		rollCall:             newQueue(rollCallQueueBufferSize),
+		consensusResponses:   waitmap.New[string, response.FormCluster](0),
+		workOrderResponses:   waitmap.New[string, execute.NodeResult](executionResultCacheSize),
+		attestationResponses: waitmap.New[string, response.Attestation](attestationResponseCacheSize),
+
+		executions: newExecutionTracker(),
+		webhooks:   newWebhookClient(cfg.WebhookTimeout, cfg.WebhookAllowedNetworks),
+		selectors:  newPeerSelectors(core.Host(), reputation),
+		reputation: reputation,
+		peers:      newPeerRegistry(cfg.PeerExpiry),
+
+		functionListings: newResponseCollector[[]bls.InstalledFunction](),
+		uninstalls:       newResponseCollector[response.UninstallFunction](),
+		installs:         newInstallTracker(installRecordLimit),
+
+		lifetime:        lifetime,
+		stop:            stop,
+		asyncExecutions: make(chan struct{}, cfg.AsyncExecutionLimit),
 	}
 
+	// Daily usage of clients is loaded from the usage ledger.
+	head.limits = newLimiter(cfg, head.clientUsageEntries)
+
 	head.Metrics().SetGaugeWithLabels(node.NodeInfoMetric, 1,
 		[]metrics.Label{
 			{Name: "id", Value: head.ID()},
@@ -57,7 +105,38 @@ func New(core node.Core, options ...Option) (*HeadNode, error) {
 }
 
 func (h *HeadNode) Run(ctx context.Context) error {
-	return h.Core.Run(ctx, h.process)
+
+	// Stop the background work started by requests once the node stops.
+	defer h.stop()
+
+	// Start the background removal of expired execution results.
+	go h.runResultPruneLoop(ctx)
+
+	// Resume webhook deliveries interrupted by a restart.
+	go h.resumeWebhookDeliveries(ctx)
+
+	// Start executions for schedules as they become due.
+	go h.runScheduler(ctx)
+
+	// Forget peers that stopped sending health pings.
+	if h.cfg.PeerExpiry > 0 {
+		go h.runPeerPruneLoop(ctx)
+	}
+
+	return h.Core.Run(ctx, h.process, h.healthStatus)
+}
+
+// background returns a context for work that outlives the request that started it. The context keeps the values
+// of the request context, but it is cancelled when the node stops instead of when the request is done.
+func (h *HeadNode) background(ctx context.Context) (context.Context, context.CancelFunc) {
+
+	ctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	stop := context.AfterFunc(h.lifetime, cancel)
+
+	return ctx, func() {
+		stop()
+		cancel()
+	}
 }
 
 func newRequestID() string {
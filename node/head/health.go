@@ -2,13 +2,25 @@ package head
 
 import (
 	"context"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
 
+	"github.com/blessnetwork/b7s/models/bls"
 	"github.com/blessnetwork/b7s/models/response"
 )
 
-func (h *HeadNode) processHealthCheck(ctx context.Context, from peer.ID, _ response.Health) error {
+func (h *HeadNode) processHealthCheck(ctx context.Context, from peer.ID, health response.Health) error {
 	h.Log().Trace().Stringer("from", from).Msg("peer health check received")
+
+	h.peers.update(from, health.NodeStatus, time.Now())
+
 	return nil
 }
+
+// healthStatus returns the status of the head node shared in health pings.
+func (h *HeadNode) healthStatus(context.Context) bls.NodeStatus {
+	return bls.NodeStatus{
+		Role: bls.HeadNode.String(),
+	}
+}
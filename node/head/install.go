@@ -2,18 +2,62 @@ package head
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
 
+	"github.com/blessnetwork/b7s/models/bls"
 	"github.com/blessnetwork/b7s/models/response"
 )
 
+// FunctionInstallStatus returns the record of the function installation, listing the confirmations of worker nodes received so far.
+func (h *HeadNode) FunctionInstallStatus(_ context.Context, id string) (bls.FunctionInstallRecord, error) {
+
+	record, ok := h.installs.get(id)
+	if !ok {
+		return bls.FunctionInstallRecord{}, bls.ErrNotFound
+	}
+
+	return record, nil
+}
+
+// WaitFunctionInstall waits until the given number of worker nodes confirm they have the function installed.
+// If the context is done first, the record is returned along with the context error.
+func (h *HeadNode) WaitFunctionInstall(ctx context.Context, id string, workers uint) (bls.FunctionInstallRecord, error) {
+
+	record, err := h.installs.wait(ctx, id, workers)
+	if err != nil {
+		return record, fmt.Errorf("could not wait for function install: %w", err)
+	}
+
+	return record, nil
+}
+
 func (h *HeadNode) processInstallFunctionResponse(ctx context.Context, from peer.ID, res response.InstallFunction) error {
 
-	h.Log().Trace().
-		Stringer("from", from).
-		Str("cid", res.CID).
-		Msg("function install response received")
+	log := h.Log().With().Stringer("from", from).Str("install", res.InstallID).Str("cid", res.CID).Logger()
+
+	status := bls.WorkerInstallStatus{
+		Peer:     from,
+		Status:   res.Status,
+		Code:     res.Code,
+		Message:  res.Message,
+		Received: time.Now().UTC(),
+	}
+
+	// Workers that do not report the status only send the response code.
+	if status.Status == "" {
+		status.Status = bls.InstallStatusFromCode(res.Code)
+	}
+
+	ok := h.installs.add(res.InstallID, status)
+	if !ok {
+		log.Trace().Msg("function install response received - install not tracked")
+		return nil
+	}
+
+	log.Debug().Str("status", string(status.Status)).Msg("function install response received")
 
 	return nil
 }
@@ -0,0 +1,114 @@
+package head
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/request"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_FunctionInstallTracking(t *testing.T) {
+
+	var (
+		core = mocks.BaselineNodeCore(t)
+		ctx  = context.Background()
+		cid  = mocks.GenericFunctionRecord.CID
+	)
+
+	head, err := New(core, mocks.BaselineStore(t))
+	require.NoError(t, err)
+
+	var published request.InstallFunction
+	core.PublishToTopicFunc = func(_ context.Context, _ string, msg bls.Message) error {
+		published = *msg.(*request.InstallFunction)
+		return nil
+	}
+
+	id, err := head.PublishFunctionInstall(ctx, "", cid, "")
+	require.NoError(t, err)
+	require.NotEmpty(t, id)
+	require.Equal(t, id, published.InstallID)
+
+	record, err := head.FunctionInstallStatus(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, cid, record.CID)
+	require.Equal(t, bls.DefaultTopic, record.Topic)
+	require.Empty(t, record.Workers)
+
+	// Wait for two workers in the background while they respond.
+	var (
+		wg     sync.WaitGroup
+		waited bls.FunctionInstallRecord
+		werr   error
+	)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+
+		waited, werr = head.WaitFunctionInstall(ctx, id, 2)
+	}()
+
+	success := published.Response(codes.Accepted)
+
+	checksum := published.Response(codes.Error)
+	checksum.Status = bls.FunctionChecksumMismatch
+
+	require.NoError(t, head.processInstallFunctionResponse(ctx, mocks.GenericPeerIDs[0], *success))
+	require.NoError(t, head.processInstallFunctionResponse(ctx, mocks.GenericPeerIDs[1], *checksum))
+
+	// Legacy response without a status - only the code is reported.
+	legacy := published.Response(codes.Accepted)
+	legacy.Status = ""
+	require.NoError(t, head.processInstallFunctionResponse(ctx, mocks.GenericPeerIDs[2], *legacy))
+
+	wg.Wait()
+	require.NoError(t, werr)
+	require.Equal(t, uint(2), waited.Installed())
+
+	record, err = head.FunctionInstallStatus(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, record.Workers, 3)
+
+	statuses := make(map[string]bls.FunctionInstallStatus)
+	for _, worker := range record.Workers {
+		statuses[worker.Peer.String()] = worker.Status
+	}
+	require.Equal(t, bls.FunctionInstalled, statuses[mocks.GenericPeerIDs[0].String()])
+	require.Equal(t, bls.FunctionChecksumMismatch, statuses[mocks.GenericPeerIDs[1].String()])
+	require.Equal(t, bls.FunctionInstalled, statuses[mocks.GenericPeerIDs[2].String()])
+
+	// A repeated confirmation from a worker replaces the earlier one.
+	require.NoError(t, head.processInstallFunctionResponse(ctx, mocks.GenericPeerIDs[1], *success))
+	record, err = head.FunctionInstallStatus(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, record.Workers, 3)
+	require.Equal(t, uint(3), record.Installed())
+
+	// Waiting for more workers than will respond times out.
+	tctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+
+	record, err = head.WaitFunctionInstall(tctx, id, 4)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, uint(3), record.Installed())
+
+	// Unknown installs.
+	_, err = head.FunctionInstallStatus(ctx, "unknown")
+	require.ErrorIs(t, err, bls.ErrNotFound)
+
+	_, err = head.WaitFunctionInstall(ctx, "unknown", 1)
+	require.ErrorIs(t, err, bls.ErrNotFound)
+
+	unknown := request.InstallFunction{InstallID: "unknown", CID: cid}
+	require.NoError(t, head.processInstallFunctionResponse(ctx, mocks.GenericPeerIDs[0], *unknown.Response(codes.Accepted)))
+}
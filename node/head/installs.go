@@ -0,0 +1,129 @@
+package head
+
+import (
+	"context"
+	"math"
+	"slices"
+	"sync"
+
+	"github.com/hashicorp/golang-lru/simplelru"
+
+	"github.com/blessnetwork/b7s/models/bls"
+)
+
+// installTracker keeps the records of function installations published by the head node.
+type installTracker struct {
+	sync.Mutex
+
+	cache *simplelru.LRU
+}
+
+type trackedInstall struct {
+	record  bls.FunctionInstallRecord
+	updated chan struct{} // Closed and replaced whenever a worker confirmation is recorded.
+}
+
+func newInstallTracker(size int) *installTracker {
+
+	if size <= 0 {
+		size = math.MaxInt
+	}
+
+	// Only possible cause of an error is providing an invalid size value.
+	cache, _ := simplelru.NewLRU(size, nil)
+
+	t := installTracker{
+		cache: cache,
+	}
+
+	return &t
+}
+
+// create starts tracking the installation.
+func (t *installTracker) create(record bls.FunctionInstallRecord) {
+	t.Lock()
+	defer t.Unlock()
+
+	t.cache.Add(record.ID, &trackedInstall{
+		record:  record,
+		updated: make(chan struct{}),
+	})
+}
+
+// add records the confirmation of the worker, replacing any it sent earlier. Confirmations for installations that are not tracked are ignored.
+func (t *installTracker) add(id string, status bls.WorkerInstallStatus) bool {
+	t.Lock()
+	defer t.Unlock()
+
+	install, ok := t.install(id)
+	if !ok {
+		return false
+	}
+
+	install.record.Workers = slices.DeleteFunc(install.record.Workers, func(w bls.WorkerInstallStatus) bool {
+		return w.Peer == status.Peer
+	})
+	install.record.Workers = append(install.record.Workers, status)
+
+	close(install.updated)
+	install.updated = make(chan struct{})
+
+	return true
+}
+
+// get returns the record of the installation.
+func (t *installTracker) get(id string) (bls.FunctionInstallRecord, bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	install, ok := t.install(id)
+	if !ok {
+		return bls.FunctionInstallRecord{}, false
+	}
+
+	return install.snapshot(), true
+}
+
+// wait blocks until the given number of workers confirm they have the function installed, or the context is done.
+// It returns the latest record of the installation.
+func (t *installTracker) wait(ctx context.Context, id string, workers uint) (bls.FunctionInstallRecord, error) {
+
+	for {
+		t.Lock()
+		install, ok := t.install(id)
+		if !ok {
+			t.Unlock()
+			return bls.FunctionInstallRecord{}, bls.ErrNotFound
+		}
+
+		record, updated := install.snapshot(), install.updated
+		t.Unlock()
+
+		if record.Installed() >= workers {
+			return record, nil
+		}
+
+		select {
+		case <-updated:
+		case <-ctx.Done():
+			return record, ctx.Err()
+		}
+	}
+}
+
+// install returns the tracked installation. Caller should hold the lock.
+func (t *installTracker) install(id string) (*trackedInstall, bool) {
+
+	v, ok := t.cache.Get(id)
+	if !ok {
+		return nil, false
+	}
+
+	return v.(*trackedInstall), true
+}
+
+func (i *trackedInstall) snapshot() bls.FunctionInstallRecord {
+	record := i.record
+	record.Workers = slices.Clone(i.record.Workers)
+	return record
+}
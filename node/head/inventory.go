@@ -0,0 +1,69 @@
+package head
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/request"
+	"github.com/blessnetwork/b7s/models/response"
+)
+
+// FunctionInventory asks the workers in the subgroup which functions they have installed.
+// Responses are collected until the timeout expires, so workers that are slow to respond are not listed.
+func (h *HeadNode) FunctionInventory(ctx context.Context, subgroup string) ([]bls.WorkerFunctions, error) {
+
+	req := request.ListFunctions{
+		RequestID: newRequestID(),
+	}
+
+	if subgroup == "" {
+		subgroup = bls.DefaultTopic
+	}
+
+	h.Log().Debug().Str("subgroup", subgroup).Str("request", req.RequestID).Msg("publishing list functions request")
+
+	listings, err := publishAndCollect(ctx, h, h.functionListings, req.RequestID, subgroup, &req, functionInventoryTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("could not collect installed functions: %w", err)
+	}
+
+	out := make([]bls.WorkerFunctions, 0, len(listings))
+	for id, functions := range listings {
+		out = append(out, bls.WorkerFunctions{
+			Peer:      id,
+			Functions: functions,
+		})
+	}
+
+	slices.SortFunc(out, func(a, b bls.WorkerFunctions) int {
+		return strings.Compare(a.Peer.String(), b.Peer.String())
+	})
+
+	return out, nil
+}
+
+func (h *HeadNode) processListFunctionsResponse(ctx context.Context, from peer.ID, res response.ListFunctions) error {
+
+	log := h.Log().With().Stringer("from", from).Str("request", res.RequestID).Logger()
+
+	if res.Code != codes.OK {
+		log.Warn().Stringer("code", res.Code).Msg("peer could not list installed functions")
+		return nil
+	}
+
+	ok := h.functionListings.add(res.RequestID, from, res.Functions)
+	if !ok {
+		log.Debug().Msg("ignoring list functions response - not collecting responses for request")
+		return nil
+	}
+
+	log.Debug().Int("functions", len(res.Functions)).Msg("received list functions response")
+
+	return nil
+}
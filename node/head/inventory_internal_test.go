@@ -0,0 +1,55 @@
+package head
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/request"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_FunctionInventory(t *testing.T) {
+
+	var (
+		core = mocks.BaselineNodeCore(t)
+		ctx  = context.Background()
+	)
+
+	head, err := New(core, mocks.BaselineStore(t))
+	require.NoError(t, err)
+
+	functions := []bls.InstalledFunction{{CID: mocks.GenericFunctionRecord.CID, Version: "1.0.0"}}
+
+	var topic string
+	core.PublishToTopicFunc = func(_ context.Context, subgroup string, msg bls.Message) error {
+		topic = subgroup
+
+		req := msg.(*request.ListFunctions)
+
+		// Two workers respond, one of them failing to list its functions.
+		err := head.processListFunctionsResponse(ctx, mocks.GenericPeerIDs[0], *req.Response(codes.OK, functions))
+		require.NoError(t, err)
+		err = head.processListFunctionsResponse(ctx, mocks.GenericPeerIDs[1], *req.Response(codes.Error, nil))
+		require.NoError(t, err)
+
+		return nil
+	}
+
+	workers, err := head.FunctionInventory(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, bls.DefaultTopic, topic)
+
+	require.Len(t, workers, 1)
+	require.Equal(t, mocks.GenericPeerIDs[0], workers[0].Peer)
+	require.Equal(t, functions, workers[0].Functions)
+
+	// Responses arriving after the inventory was collected are ignored.
+	late := request.ListFunctions{RequestID: "late"}
+	err = head.processListFunctionsResponse(ctx, mocks.GenericPeerIDs[2], *late.Response(codes.OK, functions))
+	require.NoError(t, err)
+	require.Empty(t, head.functionListings.m)
+}
@@ -0,0 +1,388 @@
+package head
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"golang.org/x/time/rate"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/execute"
+)
+
+// UsageQuota sets how much resource usage a client can incur in a day. Zero values mean there is no quota for that resource.
+type UsageQuota struct {
+	WallClockTime time.Duration // Total wall clock time of executions.
+	CPUTime       time.Duration // Total CPU time (user and system) of executions.
+	MemoryKB      int64         // Total of the peak memory usage of executions.
+}
+
+// exceeded returns true if the usage reached the quota for any of the resources.
+func (q UsageQuota) exceeded(usage execute.Usage) bool {
+
+	if q.WallClockTime > 0 && usage.WallClockTime >= q.WallClockTime {
+		return true
+	}
+
+	if q.CPUTime > 0 && usage.CPUUserTime+usage.CPUSysTime >= q.CPUTime {
+		return true
+	}
+
+	if q.MemoryKB > 0 && usage.MemoryMaxKB >= q.MemoryKB {
+		return true
+	}
+
+	return false
+}
+
+// limiter protects the head node and workers from noisy clients. It enforces rate limits and concurrent execution caps
+// per client and per function, as well as the daily usage quota per client. Requests not attributed to a client are only
+// subject to function limits.
+//
+// Daily usage of a client is loaded from the usage ledger when first needed, so it survives restarts, and then kept up to date
+// as usage entries are added to the ledger. The ledger is read without holding the lock, so only requests of the client whose
+// usage is being loaded wait for it. Entries for idle clients and functions are periodically evicted.
+type limiter struct {
+	sync.Mutex
+
+	cfg    Config
+	now    func() time.Time
+	ledger func(ctx context.Context, client string, since time.Time) ([]execute.UsageEntry, error)
+
+	clientRates    map[string]*rate.Limiter
+	functionRates  map[string]*rate.Limiter
+	clientActive   map[string]uint
+	functionActive map[string]uint
+	usage          map[string]*dailyUsage
+	lastPruned     time.Time
+}
+
+// dailyUsage is the resource usage of a client, accumulated since the start of the day.
+type dailyUsage struct {
+	day      time.Time
+	usage    execute.Usage
+	lastUsed time.Time
+
+	// Closed once the usage is loaded from the ledger. Entries charged before that are kept aside,
+	// as the ledger may or may not include them.
+	loaded  chan struct{}
+	pending []execute.UsageEntry
+}
+
+// newLimiter creates a new limiter. Ledger returns the usage entries of the client recorded since the given time.
+func newLimiter(cfg Config, ledger func(ctx context.Context, client string, since time.Time) ([]execute.UsageEntry, error)) *limiter {
+
+	l := limiter{
+		cfg:    cfg,
+		now:    time.Now,
+		ledger: ledger,
+
+		clientRates:    make(map[string]*rate.Limiter),
+		functionRates:  make(map[string]*rate.Limiter),
+		clientActive:   make(map[string]uint),
+		functionActive: make(map[string]uint),
+		usage:          make(map[string]*dailyUsage),
+	}
+
+	return &l
+}
+
+// acquire checks if the request can be executed. If it can, the returned function must be called once the execution is done.
+func (l *limiter) acquire(ctx context.Context, client string, function string) (func(), error) {
+
+	if client != "" && l.cfg.ClientDailyQuota != (UsageQuota{}) {
+		exceeded, err := l.quotaExceeded(ctx, client)
+		if err != nil {
+			return nil, fmt.Errorf("could not check client quota: %w", err)
+		}
+		if exceeded {
+			return nil, &bls.LimitError{Limit: bls.LimitClientQuota, RetryAfter: untilNextDay(l.now())}
+		}
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	now := l.now()
+
+	if now.Sub(l.lastPruned) >= limiterPruneInterval {
+		l.prune(now)
+		l.lastPruned = now
+	}
+
+	// Check concurrency caps before rate limits, so rejected requests do not use up tokens.
+	if client != "" && l.cfg.ClientConcurrency > 0 && l.clientActive[client] >= l.cfg.ClientConcurrency {
+		return nil, &bls.LimitError{Limit: bls.LimitClientConcurrency, RetryAfter: concurrencyLimitRetryAfter}
+	}
+
+	if l.cfg.FunctionConcurrency > 0 && l.functionActive[function] >= l.cfg.FunctionConcurrency {
+		return nil, &bls.LimitError{Limit: bls.LimitFunctionConcurrency, RetryAfter: concurrencyLimitRetryAfter}
+	}
+
+	var clientReservation *rate.Reservation
+	if client != "" && l.cfg.ClientRateLimit > 0 {
+		clientReservation = reserve(l.clientRates, client, l.cfg.ClientRateLimit, l.cfg.ClientRateBurst, now)
+		if delay := clientReservation.DelayFrom(now); delay > 0 {
+			clientReservation.CancelAt(now)
+			return nil, &bls.LimitError{Limit: bls.LimitClientRate, RetryAfter: delay}
+		}
+	}
+
+	if l.cfg.FunctionRateLimit > 0 {
+		reservation := reserve(l.functionRates, function, l.cfg.FunctionRateLimit, l.cfg.FunctionRateBurst, now)
+		if delay := reservation.DelayFrom(now); delay > 0 {
+			reservation.CancelAt(now)
+			// Request was not accepted so the client should get its token back.
+			if clientReservation != nil {
+				clientReservation.CancelAt(now)
+			}
+			return nil, &bls.LimitError{Limit: bls.LimitFunctionRate, RetryAfter: delay}
+		}
+	}
+
+	l.clientActive[client]++
+	l.functionActive[function]++
+
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			l.release(client, function)
+		})
+	}
+
+	return release, nil
+}
+
+func (l *limiter) release(client string, function string) {
+	l.Lock()
+	defer l.Unlock()
+
+	decrement(l.clientActive, client)
+	decrement(l.functionActive, function)
+}
+
+// charge adds the usage entries to the daily usage of the client. Entries must be added to the ledger before they are charged,
+// so that usage not kept in memory is included when it is loaded from the ledger.
+func (l *limiter) charge(client string, entries []execute.UsageEntry) {
+	l.Lock()
+	defer l.Unlock()
+
+	usage, ok := l.usage[client]
+	if !ok || !usage.day.Equal(l.now().UTC().Truncate(24*time.Hour)) {
+		return
+	}
+
+	select {
+	case <-usage.loaded:
+		for _, entry := range entries {
+			usage.usage = addUsage(usage.usage, entry.Usage)
+		}
+	default:
+		usage.pending = append(usage.pending, entries...)
+	}
+}
+
+// quotaExceeded returns true if the client used up its daily quota. Usage is reset at the start of each day (UTC).
+// If the usage of the client is not known, it is loaded from the ledger. Requests of the client wait for the usage to be loaded.
+func (l *limiter) quotaExceeded(ctx context.Context, client string) (bool, error) {
+
+	for {
+		l.Lock()
+
+		now := l.now()
+		day := now.UTC().Truncate(24 * time.Hour)
+
+		usage, ok := l.usage[client]
+		if ok && usage.day.Equal(day) {
+			select {
+			case <-usage.loaded:
+				usage.lastUsed = now
+				exceeded := l.cfg.ClientDailyQuota.exceeded(usage.usage)
+				l.Unlock()
+				return exceeded, nil
+			default:
+			}
+
+			// Usage is being loaded by another request.
+			loaded := usage.loaded
+			l.Unlock()
+
+			select {
+			case <-loaded:
+				continue
+			case <-ctx.Done():
+				return false, ctx.Err()
+			}
+		}
+
+		usage = &dailyUsage{
+			day:      day,
+			lastUsed: now,
+			loaded:   make(chan struct{}),
+		}
+		l.usage[client] = usage
+
+		l.Unlock()
+
+		var (
+			entries []execute.UsageEntry
+			err     error
+		)
+		if l.ledger != nil {
+			entries, err = l.ledger(ctx, client, day)
+		}
+
+		l.Lock()
+		defer l.Unlock()
+
+		defer close(usage.loaded)
+
+		if err != nil {
+			// Usage will be loaded again by the next request. Until then, the client is not held to the quota,
+			// unless the request itself is cancelled.
+			if l.usage[client] == usage {
+				delete(l.usage, client)
+			}
+			return false, ctx.Err()
+		}
+
+		usage.usage = ledgerUsage(entries, usage.pending)
+		usage.pending = nil
+
+		return l.cfg.ClientDailyQuota.exceeded(usage.usage), nil
+	}
+}
+
+// prune evicts entries for clients and functions that are idle. Rate limiters are evicted once their tokens are replenished,
+// as they are then no different from new ones. Daily usage is evicted when unused for a while, as it can be loaded from the ledger again.
+func (l *limiter) prune(now time.Time) {
+
+	for _, limiters := range []map[string]*rate.Limiter{l.clientRates, l.functionRates} {
+		for key, limiter := range limiters {
+			if limiter.TokensAt(now) >= float64(limiter.Burst()) {
+				delete(limiters, key)
+			}
+		}
+	}
+
+	day := now.UTC().Truncate(24 * time.Hour)
+	for client, usage := range l.usage {
+		if !usage.day.Equal(day) || now.Sub(usage.lastUsed) >= limiterUsageIdleTimeout {
+			delete(l.usage, client)
+		}
+	}
+}
+
+// ledgerUsage returns the total usage of the ledger entries and of the pending entries not included in the ledger.
+func ledgerUsage(ledger []execute.UsageEntry, pending []execute.UsageEntry) execute.Usage {
+
+	type entryKey struct {
+		requestID string
+		worker    peer.ID
+	}
+
+	var usage execute.Usage
+	seen := make(map[entryKey]struct{}, len(ledger))
+	for _, entry := range ledger {
+		seen[entryKey{entry.RequestID, entry.Worker}] = struct{}{}
+		usage = addUsage(usage, entry.Usage)
+	}
+
+	for _, entry := range pending {
+		if _, ok := seen[entryKey{entry.RequestID, entry.Worker}]; ok {
+			continue
+		}
+		usage = addUsage(usage, entry.Usage)
+	}
+
+	return usage
+}
+
+func addUsage(a execute.Usage, b execute.Usage) execute.Usage {
+
+	a.WallClockTime += b.WallClockTime
+	a.CPUUserTime += b.CPUUserTime
+	a.CPUSysTime += b.CPUSysTime
+	a.MemoryMaxKB += b.MemoryMaxKB
+
+	return a
+}
+
+func reserve(limiters map[string]*rate.Limiter, key string, limit float64, burst uint, now time.Time) *rate.Reservation {
+
+	limiter, ok := limiters[key]
+	if !ok {
+		// If not set, allow the number of requests expected in a second to be made at once.
+		if burst == 0 {
+			burst = uint(math.Max(1, math.Ceil(limit)))
+		}
+
+		limiter = rate.NewLimiter(rate.Limit(limit), int(burst))
+		limiters[key] = limiter
+	}
+
+	return limiter.ReserveN(now, 1)
+}
+
+func decrement(m map[string]uint, key string) {
+
+	if m[key] <= 1 {
+		delete(m, key)
+		return
+	}
+
+	m[key]--
+}
+
+// untilNextDay returns the time until the start of the next day (UTC).
+func untilNextDay(now time.Time) time.Duration {
+	return now.UTC().Truncate(24 * time.Hour).Add(24 * time.Hour).Sub(now)
+}
+
+// clientUsageEntries returns the usage entries recorded in the usage ledger for the client since the given time.
+func (h *HeadNode) clientUsageEntries(ctx context.Context, client string, since time.Time) ([]execute.UsageEntry, error) {
+
+	entries, err := h.UsageEntries(ctx, bls.UsageFilter{From: since, Client: client})
+	if err != nil {
+		h.Log().Error().Err(err).Str("client", client).Msg("could not load client usage from the usage ledger")
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// acquireLimits checks the request against the client and function limits. If the request is over a limit, the returned error is a `bls.LimitError`.
+func (h *HeadNode) acquireLimits(ctx context.Context, requestID string, req execute.Request) (func(), error) {
+
+	release, err := h.limits.acquire(ctx, req.Client, req.FunctionID)
+	if err != nil {
+		h.Log().Warn().
+			Err(err).
+			Str("request", requestID).
+			Str("function", req.FunctionID).
+			Str("client", req.Client).
+			Msg("rejecting execution request over the limit")
+
+		limit := ""
+		var limitErr *bls.LimitError
+		if errors.As(err, &limitErr) {
+			limit = limitErr.Limit
+		}
+
+		h.Metrics().IncrCounterWithLabels(rejectedExecutionsMetric, 1,
+			[]metrics.Label{
+				{Name: "function", Value: req.FunctionID},
+				{Name: "limit", Value: limit},
+			})
+
+		return nil, err
+	}
+
+	return release, nil
+}
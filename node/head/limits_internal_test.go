@@ -0,0 +1,375 @@
+package head
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/models/request"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestLimiter(t *testing.T) {
+
+	const (
+		client   = "dummy-client"
+		function = "dummy-function"
+	)
+
+	var (
+		start = time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+		now   time.Time
+	)
+
+	newTestLimiter := func(options ...Option) *limiter {
+		now = start
+
+		cfg := DefaultConfig
+		for _, option := range options {
+			option(&cfg)
+		}
+
+		l := newLimiter(cfg, nil)
+		l.now = func() time.Time { return now }
+		return l
+	}
+
+	requireLimit := func(t *testing.T, err error, limit string) *bls.LimitError {
+		t.Helper()
+
+		require.ErrorIs(t, err, bls.ErrLimitExceeded)
+
+		var limitErr *bls.LimitError
+		require.True(t, errors.As(err, &limitErr))
+		require.Equal(t, limit, limitErr.Limit)
+		require.Positive(t, limitErr.RetryAfter)
+
+		return limitErr
+	}
+
+	t.Run("no limits by default", func(t *testing.T) {
+
+		l := newTestLimiter()
+
+		for i := 0; i < 100; i++ {
+			_, err := l.acquire(context.Background(), client, function)
+			require.NoError(t, err)
+		}
+	})
+	t.Run("client rate limit", func(t *testing.T) {
+
+		l := newTestLimiter(ClientRateLimit(1, 2))
+
+		for i := 0; i < 2; i++ {
+			_, err := l.acquire(context.Background(), client, function)
+			require.NoError(t, err)
+		}
+
+		_, err := l.acquire(context.Background(), client, function)
+		limitErr := requireLimit(t, err, bls.LimitClientRate)
+		require.Equal(t, time.Second, limitErr.RetryAfter)
+
+		// Other clients have their own limits.
+		_, err = l.acquire(context.Background(), "other-client", function)
+		require.NoError(t, err)
+
+		// Tokens are replenished over time.
+		now = now.Add(time.Second)
+		_, err = l.acquire(context.Background(), client, function)
+		require.NoError(t, err)
+	})
+	t.Run("function rate limit", func(t *testing.T) {
+
+		l := newTestLimiter(ClientRateLimit(1, 1), FunctionRateLimit(1, 1))
+
+		_, err := l.acquire(context.Background(), "other-client", function)
+		require.NoError(t, err)
+
+		_, err = l.acquire(context.Background(), client, function)
+		requireLimit(t, err, bls.LimitFunctionRate)
+
+		// Client did not use up its token on the rejected request.
+		_, err = l.acquire(context.Background(), client, "other-function")
+		require.NoError(t, err)
+	})
+	t.Run("requests without a client are only subject to function limits", func(t *testing.T) {
+
+		l := newTestLimiter(ClientRateLimit(1, 1), ClientConcurrency(1), FunctionConcurrency(2))
+
+		for i := 0; i < 2; i++ {
+			_, err := l.acquire(context.Background(), "", function)
+			require.NoError(t, err)
+		}
+
+		_, err := l.acquire(context.Background(), "", function)
+		requireLimit(t, err, bls.LimitFunctionConcurrency)
+	})
+	t.Run("concurrency limit", func(t *testing.T) {
+
+		l := newTestLimiter(ClientConcurrency(1))
+
+		release, err := l.acquire(context.Background(), client, function)
+		require.NoError(t, err)
+
+		_, err = l.acquire(context.Background(), client, function)
+		limitErr := requireLimit(t, err, bls.LimitClientConcurrency)
+		require.Equal(t, concurrencyLimitRetryAfter, limitErr.RetryAfter)
+
+		// Releasing more than once has no effect.
+		release()
+		release()
+		require.Empty(t, l.clientActive)
+
+		_, err = l.acquire(context.Background(), client, function)
+		require.NoError(t, err)
+	})
+	t.Run("daily quota", func(t *testing.T) {
+
+		l := newTestLimiter(ClientDailyQuota(UsageQuota{CPUTime: time.Minute}))
+
+		usage := execute.Usage{
+			WallClockTime: time.Minute,
+			CPUUserTime:   20 * time.Second,
+			CPUSysTime:    10 * time.Second,
+		}
+		entries := []execute.UsageEntry{
+			{RequestID: "dummy-request", Worker: mocks.GenericPeerIDs[0], Usage: usage},
+			{RequestID: "dummy-request", Worker: mocks.GenericPeerIDs[1], Usage: usage},
+		}
+
+		release, err := l.acquire(context.Background(), client, function)
+		require.NoError(t, err)
+		release()
+		l.charge(client, entries)
+
+		// Client is charged for the usage on all workers.
+		expected := execute.Usage{
+			WallClockTime: 2 * time.Minute,
+			CPUUserTime:   40 * time.Second,
+			CPUSysTime:    20 * time.Second,
+		}
+		require.Equal(t, expected, l.usage[client].usage)
+
+		_, err = l.acquire(context.Background(), client, function)
+		limitErr := requireLimit(t, err, bls.LimitClientQuota)
+		require.Equal(t, 12*time.Hour, limitErr.RetryAfter)
+
+		// Quota is reset on the next day.
+		now = now.Add(limitErr.RetryAfter)
+		_, err = l.acquire(context.Background(), client, function)
+		require.NoError(t, err)
+	})
+	t.Run("daily memory quota", func(t *testing.T) {
+
+		l := newTestLimiter(ClientDailyQuota(UsageQuota{MemoryKB: 2048}))
+
+		for i := 0; i < 2; i++ {
+			release, err := l.acquire(context.Background(), client, function)
+			require.NoError(t, err)
+			release()
+
+			l.charge(client, []execute.UsageEntry{
+				{RequestID: fmt.Sprint(i), Worker: mocks.GenericPeerID, Usage: execute.Usage{MemoryMaxKB: 1024}},
+			})
+		}
+
+		_, err := l.acquire(context.Background(), client, function)
+		requireLimit(t, err, bls.LimitClientQuota)
+	})
+	t.Run("usage is not tracked without a quota", func(t *testing.T) {
+
+		l := newTestLimiter()
+		l.ledger = func(context.Context, string, time.Time) ([]execute.UsageEntry, error) {
+			require.FailNow(t, "unexpected usage ledger read")
+			return nil, nil
+		}
+
+		_, err := l.acquire(context.Background(), client, function)
+		require.NoError(t, err)
+
+		l.charge(client, []execute.UsageEntry{mocks.GenericUsageEntry})
+		require.Empty(t, l.usage)
+	})
+	t.Run("daily usage is loaded from the ledger", func(t *testing.T) {
+
+		l := newTestLimiter(ClientDailyQuota(UsageQuota{CPUTime: time.Minute}))
+
+		var since time.Time
+		l.ledger = func(_ context.Context, c string, s time.Time) ([]execute.UsageEntry, error) {
+			require.Equal(t, client, c)
+			since = s
+			return []execute.UsageEntry{{Usage: execute.Usage{CPUUserTime: time.Minute}}}, nil
+		}
+
+		_, err := l.acquire(context.Background(), client, function)
+		requireLimit(t, err, bls.LimitClientQuota)
+		require.Equal(t, start.Truncate(24*time.Hour), since)
+	})
+	t.Run("requests do not wait for the ledger of other clients", func(t *testing.T) {
+
+		l := newTestLimiter(ClientDailyQuota(UsageQuota{CPUTime: time.Minute}))
+
+		var (
+			loading = make(chan struct{})
+			unblock = make(chan struct{})
+			done    = make(chan error)
+		)
+		l.ledger = func(_ context.Context, c string, _ time.Time) ([]execute.UsageEntry, error) {
+			if c == client {
+				close(loading)
+				<-unblock
+			}
+			return nil, nil
+		}
+
+		go func() {
+			_, err := l.acquire(context.Background(), client, function)
+			done <- err
+		}()
+		<-loading
+
+		_, err := l.acquire(context.Background(), "other-client", function)
+		require.NoError(t, err)
+
+		// Requests of the same client wait for the usage to be loaded.
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = l.acquire(ctx, client, function)
+		require.ErrorIs(t, err, context.Canceled)
+
+		// Usage charged while the ledger is read is not lost.
+		l.charge(client, []execute.UsageEntry{
+			{RequestID: "dummy-request", Worker: mocks.GenericPeerID, Usage: execute.Usage{CPUUserTime: time.Minute}},
+		})
+
+		close(unblock)
+		requireLimit(t, <-done, bls.LimitClientQuota)
+	})
+	t.Run("usage charged while the ledger is read is not counted twice", func(t *testing.T) {
+
+		l := newTestLimiter(ClientDailyQuota(UsageQuota{CPUTime: 90 * time.Second}))
+
+		entry := execute.UsageEntry{RequestID: "dummy-request", Worker: mocks.GenericPeerID, Usage: execute.Usage{CPUUserTime: time.Minute}}
+
+		l.ledger = func(context.Context, string, time.Time) ([]execute.UsageEntry, error) {
+			// Entry was added to the ledger and charged while the ledger was read.
+			l.charge(client, []execute.UsageEntry{entry})
+			return []execute.UsageEntry{entry}, nil
+		}
+
+		_, err := l.acquire(context.Background(), client, function)
+		require.NoError(t, err)
+		require.Equal(t, entry.Usage, l.usage[client].usage)
+	})
+	t.Run("ledger failure", func(t *testing.T) {
+
+		l := newTestLimiter(ClientDailyQuota(UsageQuota{CPUTime: time.Minute}))
+
+		loads := 0
+		l.ledger = func(context.Context, string, time.Time) ([]execute.UsageEntry, error) {
+			loads++
+			return nil, mocks.GenericError
+		}
+
+		// Client is not held to the quota when its usage cannot be loaded, and loading is retried on the next request.
+		for i := 0; i < 2; i++ {
+			_, err := l.acquire(context.Background(), client, function)
+			require.NoError(t, err)
+		}
+
+		require.Equal(t, 2, loads)
+		require.Empty(t, l.usage)
+	})
+	t.Run("idle entries are evicted", func(t *testing.T) {
+
+		l := newTestLimiter(ClientRateLimit(1, 1), FunctionRateLimit(1, 1), ClientDailyQuota(UsageQuota{CPUTime: time.Minute}))
+
+		loads := 0
+		l.ledger = func(context.Context, string, time.Time) ([]execute.UsageEntry, error) {
+			loads++
+			return nil, nil
+		}
+
+		release, err := l.acquire(context.Background(), client, function)
+		require.NoError(t, err)
+		release()
+
+		require.Len(t, l.clientRates, 1)
+		require.Len(t, l.functionRates, 1)
+		require.Len(t, l.usage, 1)
+
+		// Rate limiters are evicted once replenished, usage once idle for a while.
+		now = now.Add(limiterUsageIdleTimeout)
+		_, err = l.acquire(context.Background(), "other-client", "other-function")
+		require.NoError(t, err)
+
+		require.NotContains(t, l.clientRates, client)
+		require.NotContains(t, l.functionRates, function)
+		require.NotContains(t, l.usage, client)
+
+		// Evicted usage is loaded from the ledger again.
+		_, err = l.acquire(context.Background(), client, function)
+		require.NoError(t, err)
+		require.Equal(t, 3, loads)
+	})
+}
+
+func TestHead_ExecuteOverLimit(t *testing.T) {
+
+	req := mocks.GenericExecutionRequest
+	req.Client = "dummy-client"
+
+	head, err := New(mocks.BaselineNodeCore(t), mocks.BaselineStore(t), ClientConcurrency(1))
+	require.NoError(t, err)
+
+	core := mocks.BaselineNodeCore(t)
+	core.PublishToTopicFunc = func(context.Context, string, bls.Message) error {
+		require.FailNow(t, "unexpected roll call for a request over the limit")
+		return nil
+	}
+	head.Core = core
+
+	// Client already has an execution running.
+	_, err = head.limits.acquire(context.Background(), req.Client, req.FunctionID)
+	require.NoError(t, err)
+
+	code, results, _, err := head.execute(context.Background(), mocks.GenericUUID.String(), request.Execute{Request: req})
+	require.ErrorIs(t, err, bls.ErrLimitExceeded)
+	require.Equal(t, codes.TooManyRequests, code)
+	require.Empty(t, results)
+}
+
+func TestHead_ExecuteAsyncOverLimit(t *testing.T) {
+
+	req := mocks.GenericExecutionRequest
+	req.Client = "dummy-client"
+
+	core := mocks.BaselineNodeCore(t)
+	core.PublishToTopicFunc = func(context.Context, string, bls.Message) error {
+		require.FailNow(t, "unexpected roll call for a request over the limit")
+		return nil
+	}
+
+	head, err := New(core, mocks.BaselineStore(t), ClientConcurrency(1), AsyncExecutionLimit(1))
+	require.NoError(t, err)
+
+	// Client already has an execution running.
+	_, err = head.limits.acquire(context.Background(), req.Client, req.FunctionID)
+	require.NoError(t, err)
+
+	// Request is rejected before it is accepted, and does not take up an asynchronous execution slot.
+	_, err = head.ExecuteFunctionAsync(context.Background(), req, "", bls.Webhook{})
+	var limitErr *bls.LimitError
+	require.ErrorAs(t, err, &limitErr)
+	require.Equal(t, bls.LimitClientConcurrency, limitErr.Limit)
+	require.Positive(t, limitErr.RetryAfter)
+
+	require.Empty(t, head.asyncExecutions)
+}
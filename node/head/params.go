@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/blessnetwork/b7s/consensus"
+	"github.com/blessnetwork/b7s/node/head/selection"
 )
 
 const (
@@ -11,12 +12,48 @@ const (
 	DefaultExecutionTimeout        = 20 * time.Second
 	DefaultClusterFormationTimeout = 10 * time.Second
 	DefaultConsensusAlgorithm      = consensus.Raft
+	DefaultPeerSelectionStrategy   = selection.FirstCome
+	DefaultPeerSelectionWindow     = 500 * time.Millisecond
 
-	rollCallQueueBufferSize  = 1000
-	executionResultCacheSize = 1000
+	rollCallQueueBufferSize      = 1000
+	executionResultCacheSize     = 1000
+	executionEventBufferSize     = 100
+	attestationResponseCacheSize = 100
+	installRecordLimit           = 1000
 
 	defaultExecutionThreshold = 0.6
 
 	// Timeout for the context used for sending disband request to cluster nodes.
 	consensusClusterSendTimeout = 10 * time.Second
+
+	// How often do we remove expired execution results.
+	executionResultPruneInterval = 10 * time.Minute
+
+	// How often do we check for schedules that are due. Schedules have a resolution of one minute.
+	scheduleCheckInterval = 10 * time.Second
+
+	// Upper limit for the wait between webhook delivery attempts.
+	webhookMaxBackoff = 1 * time.Minute
+	webhookUserAgent  = "b7s-head-node"
+
+	// How long do we wait for peers to respond to ping when measuring latency.
+	peerPingTimeout = 2 * time.Second
+
+	// How long do we wait for peers to share their attestation.
+	attestationRequestTimeout = 2 * time.Second
+
+	// How long do we collect responses from workers listing their installed functions.
+	functionInventoryTimeout = 2 * time.Second
+
+	// How long do clients rejected for running too many executions at once are asked to wait before retrying.
+	concurrencyLimitRetryAfter = 1 * time.Second
+
+	// How often do we evict idle entries from the client and function limits.
+	limiterPruneInterval = 1 * time.Minute
+
+	// How long is the daily usage of an idle client kept in memory.
+	limiterUsageIdleTimeout = 10 * time.Minute
+
+	// How long do we collect confirmations from workers uninstalling a function.
+	functionUninstallTimeout = 5 * time.Second
 )
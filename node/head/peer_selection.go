@@ -0,0 +1,67 @@
+package head
+
+import (
+	"context"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/protocol/ping"
+	"github.com/rs/zerolog"
+
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/node/head/selection"
+)
+
+func newPeerSelectors(host host.Host, scores selection.ScoreSource) map[selection.Type]selection.PeerSelector {
+
+	selectors := map[selection.Type]selection.PeerSelector{
+		selection.FirstCome:     selection.NewFirstCome(),
+		selection.Random:        selection.NewRandom(),
+		selection.LeastLoaded:   selection.NewLeastLoaded(),
+		selection.LowestLatency: selection.NewLowestLatency(&hostPinger{host: host}, peerPingTimeout),
+		selection.Reputation:    selection.NewReputationWeighted(scores),
+	}
+
+	return selectors
+}
+
+// peerSelection determines the peer selection strategy for the request.
+func (h *HeadNode) peerSelection(log zerolog.Logger, req execute.Request) selection.Type {
+
+	strategy, err := selection.Parse(req.Config.PeerSelection)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("value", req.Config.PeerSelection).
+			Stringer("default", h.cfg.DefaultPeerSelection).
+			Msg("could not parse peer selection strategy from the user request, using default")
+
+		return h.cfg.DefaultPeerSelection
+	}
+
+	if strategy == 0 {
+		return h.cfg.DefaultPeerSelection
+	}
+
+	return strategy
+}
+
+// hostPinger measures round trip time to peers using the libp2p ping protocol.
+type hostPinger struct {
+	host host.Host
+}
+
+func (p *hostPinger) Ping(ctx context.Context, id peer.ID) (time.Duration, error) {
+
+	// Ping keeps pinging the peer until the context is cancelled - we only need one result.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	res := <-ping.Ping(ctx, p.host, id)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+
+	return res.RTT, nil
+}
@@ -0,0 +1,176 @@
+package head
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/models/request"
+	"github.com/blessnetwork/b7s/node/aggregate"
+)
+
+// ExecutePipeline executes the steps of a pipeline. A step is executed once all of the steps it depends on are done,
+// with their outputs mapped to its input. Steps that do not depend on each other are executed at the same time.
+// Each step is a separate execution, with its own request ID and persisted result. A step that does not complete with
+// `codes.OK` stops the pipeline - this includes `codes.PartialContent`, as without enough results the step output cannot be
+// trusted to the degree the request asked for. Steps depending on it are skipped. The pipeline record is persisted too,
+// so it can be retrieved later using the pipeline ID.
+func (h *HeadNode) ExecutePipeline(ctx context.Context, pipeline execute.Pipeline, subgroup string) (bls.PipelineRecord, error) {
+
+	err := pipeline.Valid()
+	if err != nil {
+		return bls.PipelineRecord{}, fmt.Errorf("invalid pipeline: %w", err)
+	}
+
+	steps, err := pipeline.Order()
+	if err != nil {
+		return bls.PipelineRecord{}, fmt.Errorf("invalid pipeline: %w", err)
+	}
+
+	pipelineID := newRequestID()
+
+	log := h.Log().With().Str("pipeline", pipelineID).Logger()
+	log.Info().Int("steps", len(steps)).Msg("processing pipeline execution request")
+
+	var (
+		lock    sync.Mutex
+		wg      sync.WaitGroup
+		outputs = make(map[string]string, len(steps))
+		skipped = make(map[string]struct{})
+		record  = bls.PipelineRecord{
+			PipelineID: pipelineID,
+			Code:       codes.OK,
+			Client:     steps[0].Request.Client,
+			Steps:      make(map[string]bls.ExecutionRecord, len(steps)),
+		}
+
+		// Closed once the step is done, successfully or not.
+		done = make(map[string]chan struct{}, len(steps))
+	)
+
+	for _, step := range steps {
+		done[step.ID] = make(chan struct{})
+	}
+
+	for _, step := range steps {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(done[step.ID])
+
+			for _, dep := range step.Dependencies() {
+				<-done[dep]
+			}
+
+			lock.Lock()
+			inputs, ok := pipelineInputs(step, outputs)
+			if !ok {
+				record.Code = codes.Error
+				skipped[step.ID] = struct{}{}
+				lock.Unlock()
+
+				log.Warn().Str("step", step.ID).Msg("skipping pipeline step as a step it depends on failed")
+				return
+			}
+			lock.Unlock()
+
+			res := h.executePipelineStep(ctx, step.WithInputs(inputs), subgroup)
+
+			log.Info().Str("step", step.ID).Str("request", res.RequestID).Stringer("code", res.Code).Msg("pipeline step executed")
+
+			lock.Lock()
+			defer lock.Unlock()
+
+			record.Steps[step.ID] = res
+
+			output, ok := pipelineOutput(res)
+			if !ok {
+				record.Code = codes.Error
+				return
+			}
+
+			outputs[step.ID] = output
+		}()
+	}
+
+	wg.Wait()
+
+	for _, step := range steps {
+		if _, ok := skipped[step.ID]; ok {
+			record.Skipped = append(record.Skipped, step.ID)
+		}
+	}
+
+	record.CreatedAt = time.Now().UTC()
+
+	err = h.store.SavePipelineResult(ctx, record)
+	if err != nil {
+		log.Error().Err(err).Msg("could not save pipeline result")
+	}
+
+	log.Info().Stringer("code", record.Code).Msg("pipeline execution complete")
+
+	return record, nil
+}
+
+func (h *HeadNode) executePipelineStep(ctx context.Context, req execute.Request, subgroup string) bls.ExecutionRecord {
+
+	requestID := newRequestID()
+
+	h.executions.start(requestID, req.Client)
+
+	code, results, cluster, err := h.execute(ctx, requestID, request.Execute{Request: req, Topic: subgroup})
+	if err != nil {
+		h.Log().Error().Str("request", requestID).Err(err).Msg("execution failed")
+	}
+
+	return h.saveExecutionResult(ctx, requestID, req, code, results, cluster, err)
+}
+
+// pipelineInputs returns the outputs of the steps the given step depends on. If any of these steps failed, returned boolean is false.
+func pipelineInputs(step execute.PipelineStep, outputs map[string]string) (map[string]string, bool) {
+
+	inputs := make(map[string]string)
+	for _, dep := range step.Dependencies() {
+		output, ok := outputs[dep]
+		if !ok {
+			return nil, false
+		}
+
+		inputs[dep] = output
+	}
+
+	return inputs, true
+}
+
+// pipelineOutput returns the output of a pipeline step - the standard output of the most frequent successful result.
+// Steps that did not complete with `codes.OK`, including those with only partial results, have no output.
+func pipelineOutput(record bls.ExecutionRecord) (string, bool) {
+
+	if record.Code != codes.OK {
+		return "", false
+	}
+
+	// If the request specified how results should be aggregated, use the aggregated value.
+	if record.Aggregation != nil {
+		return record.Aggregation.Value, record.Aggregation.Error == ""
+	}
+
+	successful := make(execute.ResultMap, len(record.Results))
+	for id, res := range record.Results {
+		if res.Code == codes.OK {
+			successful[id] = res
+		}
+	}
+
+	aggregated := aggregate.Aggregate(successful)
+	if len(aggregated) == 0 {
+		return "", false
+	}
+
+	return aggregated[0].Result.Stdout, true
+}
@@ -0,0 +1,182 @@
+package head
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/models/request"
+	"github.com/blessnetwork/b7s/models/response"
+	"github.com/blessnetwork/b7s/store"
+	"github.com/blessnetwork/b7s/store/codec"
+	"github.com/blessnetwork/b7s/testing/helpers"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_ExecutePipeline(t *testing.T) {
+
+	const broken = "broken"
+
+	var (
+		workerID = mocks.GenericPeerID
+		lock     sync.Mutex
+	)
+
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	head, err := New(mocks.BaselineNodeCore(t), store.New(db, codec.NewJSONCodec()))
+	require.NoError(t, err)
+
+	// Worker outputs the function ID, along with the inputs it received. Function `broken` always fails.
+	core := mocks.BaselineNodeCore(t)
+	core.ConnectedFunc = func(peer.ID) bool {
+		return true
+	}
+	core.PublishToTopicFunc = func(_ context.Context, _ string, msg bls.Message) error {
+		lock.Lock()
+		defer lock.Unlock()
+
+		rc, ok := any(msg).(*request.RollCall)
+		require.True(t, ok)
+
+		head.rollCall.add(rc.RequestID, rollCallResponse{
+			From: workerID,
+			RollCall: response.RollCall{
+				Code:       codes.Accepted,
+				FunctionID: rc.FunctionID,
+				RequestID:  rc.RequestID,
+			},
+		})
+
+		return nil
+	}
+	core.SendToManyFunc = func(_ context.Context, peers []peer.ID, msg bls.Message, _ bool) error {
+		lock.Lock()
+		defer lock.Unlock()
+
+		wo, ok := any(msg).(*request.WorkOrder)
+		require.True(t, ok)
+
+		stdin := ""
+		if wo.Config.Stdin != nil {
+			stdin = *wo.Config.Stdin
+		}
+
+		result := execute.NodeResult{
+			Result: execute.Result{
+				Code: codes.OK,
+				Result: execute.RuntimeOutput{
+					Stdout: fmt.Sprintf("%s(%s%v%v)", wo.FunctionID, stdin, wo.Parameters, wo.Config.Environment),
+				},
+			},
+		}
+		if wo.FunctionID == broken {
+			result.Code = codes.Error
+		}
+
+		for _, id := range peers {
+			head.workOrderResponses.Set(peerRequestKey(wo.RequestID, id), result)
+		}
+
+		return nil
+	}
+	head.Core = core
+
+	step := func(id string, inputs ...execute.PipelineInput) execute.PipelineStep {
+		req := mocks.GenericExecutionRequest
+		req.FunctionID = id
+		req.Parameters = nil
+		req.Config.ConsensusAlgorithm = ""
+		req.Config.Environment = nil
+		req.Config.Stdin = nil
+
+		return execute.PipelineStep{
+			ID:      id,
+			Request: req,
+			Inputs:  inputs,
+		}
+	}
+
+	pipeline := execute.Pipeline{
+		Steps: []execute.PipelineStep{
+			step("report",
+				execute.PipelineInput{Step: "transform", Target: execute.InputParameter, Name: "data"},
+				execute.PipelineInput{Step: "fetch", Target: execute.InputEnvironment, Name: "RAW"},
+			),
+			step("transform", execute.PipelineInput{Step: "fetch", Target: execute.InputStdin}),
+			step("fetch"),
+			step(broken),
+			step("audit", execute.PipelineInput{Step: broken, Target: execute.InputStdin}),
+			step("archive", execute.PipelineInput{Step: "audit", Target: execute.InputStdin}),
+		},
+	}
+
+	record, err := head.ExecutePipeline(context.Background(), pipeline, "")
+	require.NoError(t, err)
+
+	require.NotEmpty(t, record.PipelineID)
+	require.Equal(t, codes.Error, record.Code)
+	require.Equal(t, []string{"audit", "archive"}, record.Skipped)
+	require.Len(t, record.Steps, 4)
+
+	output := func(id string) string {
+		t.Helper()
+
+		out, ok := pipelineOutput(record.Steps[id])
+		require.True(t, ok)
+		return out
+	}
+
+	require.Equal(t, "fetch([][])", output("fetch"))
+	require.Equal(t, "transform(fetch([][])[][])", output("transform"))
+	require.Equal(t, "report([{data transform(fetch([][])[][])}][{RAW fetch([][])}])", output("report"))
+
+	_, ok := pipelineOutput(record.Steps[broken])
+	require.False(t, ok)
+
+	// Each step is persisted as a separate execution.
+	for _, step := range record.Steps {
+		_, err := head.ExecutionResult(context.Background(), step.RequestID)
+		require.NoError(t, err)
+	}
+
+	// Pipeline is persisted too.
+	persisted, err := head.PipelineResult(context.Background(), record.PipelineID)
+	require.NoError(t, err)
+	require.Equal(t, record.Code, persisted.Code)
+	require.Equal(t, record.Skipped, persisted.Skipped)
+	require.Len(t, persisted.Steps, len(record.Steps))
+
+	_, err = head.PipelineResult(context.Background(), "dummy-pipeline-id")
+	require.ErrorIs(t, err, bls.ErrNotFound)
+}
+
+func TestPipelineOutput(t *testing.T) {
+
+	result := mocks.GenericExecutionResult
+	result.Code = codes.OK
+
+	record := bls.ExecutionRecord{
+		Code: codes.OK,
+		Results: execute.ResultMap{
+			mocks.GenericPeerID: {Result: result},
+		},
+	}
+
+	output, ok := pipelineOutput(record)
+	require.True(t, ok)
+	require.Equal(t, result.Result.Stdout, output)
+
+	// Partial results do not satisfy the request - pipeline does not proceed.
+	record.Code = codes.PartialContent
+	_, ok = pipelineOutput(record)
+	require.False(t, ok)
+}
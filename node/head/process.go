@@ -26,6 +26,12 @@ func (h *HeadNode) process(ctx context.Context, from peer.ID, msg string, payloa
 		return node.HandleMessage(ctx, from, payload, h.processWorkOrderResponse)
 	case bls.MessageFormClusterResponse:
 		return node.HandleMessage(ctx, from, payload, h.processFormClusterResponse)
+	case bls.MessageAttestationResponse:
+		return node.HandleMessage(ctx, from, payload, h.processAttestationResponse)
+	case bls.MessageListFunctionsResponse:
+		return node.HandleMessage(ctx, from, payload, h.processListFunctionsResponse)
+	case bls.MessageUninstallFunctionResponse:
+		return node.HandleMessage(ctx, from, payload, h.processUninstallFunctionResponse)
 	}
 
 	return fmt.Errorf("unsupported message: %s", msg)
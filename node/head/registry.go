@@ -0,0 +1,103 @@
+package head
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blessnetwork/b7s/models/bls"
+)
+
+// peerRegistry keeps track of the nodes in the network, based on the health pings they publish.
+// Nodes that were not seen for longer than the expiry period are no longer listed.
+type peerRegistry struct {
+	sync.RWMutex
+
+	expiry time.Duration
+	m      map[peer.ID]bls.PeerInfo
+}
+
+func newPeerRegistry(expiry time.Duration) *peerRegistry {
+
+	r := peerRegistry{
+		expiry: expiry,
+		m:      make(map[peer.ID]bls.PeerInfo),
+	}
+
+	return &r
+}
+
+// update records the status the peer reported.
+func (r *peerRegistry) update(id peer.ID, status bls.NodeStatus, now time.Time) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.m[id] = bls.PeerInfo{
+		ID:         id,
+		NodeStatus: status,
+		LastSeen:   now.UTC(),
+	}
+}
+
+// list returns the peers that have not expired, ordered by their ID.
+func (r *peerRegistry) list(now time.Time) []bls.PeerInfo {
+	r.RLock()
+	defer r.RUnlock()
+
+	peers := make([]bls.PeerInfo, 0, len(r.m))
+	for _, info := range r.m {
+		if r.expired(info, now) {
+			continue
+		}
+
+		peers = append(peers, info)
+	}
+
+	slices.SortFunc(peers, func(a, b bls.PeerInfo) int {
+		return strings.Compare(a.ID.String(), b.ID.String())
+	})
+
+	return peers
+}
+
+// prune removes the peers that have expired.
+func (r *peerRegistry) prune(now time.Time) {
+	r.Lock()
+	defer r.Unlock()
+
+	for id, info := range r.m {
+		if r.expired(info, now) {
+			delete(r.m, id)
+		}
+	}
+}
+
+func (r *peerRegistry) expired(info bls.PeerInfo, now time.Time) bool {
+	return r.expiry > 0 && now.Sub(info.LastSeen) > r.expiry
+}
+
+// runPeerPruneLoop periodically removes peers that have not been seen for a while.
+func (h *HeadNode) runPeerPruneLoop(ctx context.Context) {
+
+	ticker := time.NewTicker(h.cfg.PeerExpiry)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			h.peers.prune(time.Now())
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Peers returns the nodes known to the head node, as last reported in their health pings.
+func (h *HeadNode) Peers(_ context.Context) ([]bls.PeerInfo, error) {
+	return h.peers.list(time.Now()), nil
+}
@@ -0,0 +1,72 @@
+package head
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/response"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestPeerRegistry(t *testing.T) {
+
+	var (
+		expiry = time.Minute
+		now    = time.Now()
+		peers  = mocks.GenericPeerIDs[:2]
+	)
+
+	registry := newPeerRegistry(expiry)
+	require.Empty(t, registry.list(now))
+
+	status := bls.NodeStatus{
+		Role:      bls.WorkerNodeLabel,
+		Load:      &bls.NodeLoad{ActiveExecutions: 1},
+		Functions: []string{mocks.GenericString},
+	}
+
+	registry.update(peers[0], status, now.Add(-2*expiry))
+	registry.update(peers[1], status, now)
+
+	// Peer that was not seen for longer than the expiry is not listed.
+	listed := registry.list(now)
+	require.Len(t, listed, 1)
+	require.Equal(t, peers[1], listed[0].ID)
+	require.Equal(t, status, listed[0].NodeStatus)
+
+	// Peer reappears once it sends a health ping again.
+	registry.update(peers[0], status, now)
+	require.Len(t, registry.list(now), 2)
+
+	registry.prune(now.Add(2 * expiry))
+	require.Empty(t, registry.m)
+}
+
+func TestHead_ProcessHealthCheck(t *testing.T) {
+
+	head := createHeadNode(t)
+
+	health := response.Health{
+		Code: 200,
+		NodeStatus: bls.NodeStatus{
+			Version: "dummy-version",
+			Role:    bls.WorkerNodeLabel,
+			Load:    &bls.NodeLoad{ActiveExecutions: 2, Capacity: 10},
+		},
+	}
+
+	err := head.processHealthCheck(context.Background(), mocks.GenericPeerID, health)
+	require.NoError(t, err)
+
+	peers, err := head.Peers(context.Background())
+	require.NoError(t, err)
+	require.Len(t, peers, 1)
+
+	require.Equal(t, mocks.GenericPeerID, peers[0].ID)
+	require.Equal(t, health.NodeStatus, peers[0].NodeStatus)
+	require.WithinDuration(t, time.Now(), peers[0].LastSeen, time.Minute)
+}
@@ -0,0 +1,70 @@
+package head
+
+import (
+	"context"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	cons "github.com/blessnetwork/b7s/consensus"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/node/aggregate"
+)
+
+// recordExecutionOutcome updates the reputation of peers that were asked to execute the request.
+func (h *HeadNode) recordExecutionOutcome(ctx context.Context, consensus cons.Type, peers []peer.ID, results execute.ResultMap) {
+
+	for id, res := range results {
+		switch res.Code {
+		case codes.OK:
+			h.reputation.RecordSuccess(ctx, id)
+		case codes.Cancelled:
+			// Execution was cancelled by the user - this tells us nothing about the peer.
+		default:
+			h.reputation.RecordFailure(ctx, id)
+		}
+	}
+
+	// With Raft only the cluster leader responds, and with PBFT we stop waiting once we have enough matching results,
+	// so a missing result does not mean the peer timed out. Also, if the request was cancelled it's not the peers fault.
+	if !consensusRequired(consensus) && ctx.Err() == nil {
+		for _, id := range peers {
+			_, ok := results[id]
+			if !ok {
+				h.reputation.RecordTimeout(ctx, id)
+			}
+		}
+	}
+
+	// PBFT only returns results that matched.
+	if consensus == cons.PBFT {
+		return
+	}
+
+	// Compare successful results only - failures are already accounted for.
+	successful := make(execute.ResultMap, len(results))
+	for id, res := range results {
+		if res.Code == codes.OK {
+			successful[id] = res
+		}
+	}
+
+	aggregated := aggregate.Aggregate(successful)
+
+	// No disagreement, or no clear majority to disagree with.
+	if len(aggregated) < 2 || aggregated[0].Frequency <= 50 {
+		return
+	}
+
+	for _, minority := range aggregated[1:] {
+		for _, id := range minority.Peers {
+			h.reputation.RecordDisagreement(ctx, id)
+		}
+	}
+}
+
+// PeerReputations returns the reputation of worker nodes known to the head node.
+func (h *HeadNode) PeerReputations(ctx context.Context) ([]bls.PeerReputation, error) {
+	return h.reputation.Reputations(ctx)
+}
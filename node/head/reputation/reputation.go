@@ -0,0 +1,176 @@
+// Package reputation keeps track of how worker nodes behaved when executing requests for the head node.
+package reputation
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/rs/zerolog"
+
+	"github.com/blessnetwork/b7s/models/bls"
+)
+
+// Tracker records peer behavior and derives reputation scores from it.
+// Reputation is persisted in the store, with an in-memory cache of peers seen so far.
+type Tracker struct {
+	log      zerolog.Logger
+	store    bls.PeerReputationStore
+	halfLife time.Duration
+
+	lock  sync.Mutex
+	peers map[peer.ID]bls.PeerReputation
+}
+
+// NewTracker creates a new reputation tracker. Counters are halved every `halfLife`. Non-positive half-life disables decay.
+func NewTracker(log zerolog.Logger, store bls.PeerReputationStore, halfLife time.Duration) *Tracker {
+
+	t := Tracker{
+		log:      log.With().Str("component", "reputation").Logger(),
+		store:    store,
+		halfLife: halfLife,
+		peers:    make(map[peer.ID]bls.PeerReputation),
+	}
+
+	return &t
+}
+
+// RecordRollCall records that the peer reported for a roll call.
+func (t *Tracker) RecordRollCall(ctx context.Context, id peer.ID) {
+	t.update(ctx, id, func(r *bls.PeerReputation) { r.RollCalls++ })
+}
+
+// RecordSuccess records that the peer successfully executed a work order.
+func (t *Tracker) RecordSuccess(ctx context.Context, id peer.ID) {
+	t.update(ctx, id, func(r *bls.PeerReputation) { r.Successes++ })
+}
+
+// RecordTimeout records that the peer did not respond to a work order in time.
+func (t *Tracker) RecordTimeout(ctx context.Context, id peer.ID) {
+	t.update(ctx, id, func(r *bls.PeerReputation) { r.Timeouts++ })
+}
+
+// RecordFailure records that the peer responded to a work order with a non-OK code.
+func (t *Tracker) RecordFailure(ctx context.Context, id peer.ID) {
+	t.update(ctx, id, func(r *bls.PeerReputation) { r.Failures++ })
+}
+
+// RecordDisagreement records that the peer returned a result different from the majority.
+func (t *Tracker) RecordDisagreement(ctx context.Context, id peer.ID) {
+	t.update(ctx, id, func(r *bls.PeerReputation) { r.Disagreements++ })
+}
+
+// RecordSignatureFailure records that the peer returned a result with an invalid signature.
+func (t *Tracker) RecordSignatureFailure(ctx context.Context, id peer.ID) {
+	t.update(ctx, id, func(r *bls.PeerReputation) { r.SignatureFailures++ })
+}
+
+// Score returns the current reputation score of the peer, in the (0, 1] range.
+func (t *Tracker) Score(id peer.ID) float64 {
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	rep, err := t.get(context.Background(), id)
+	if err != nil {
+		t.log.Warn().Err(err).Stringer("peer", id).Msg("could not retrieve peer reputation, using default")
+	}
+
+	return rep.Score
+}
+
+// Reputation returns the current reputation of the peer.
+func (t *Tracker) Reputation(ctx context.Context, id peer.ID) (bls.PeerReputation, error) {
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.get(ctx, id)
+}
+
+// Reputations returns the current reputation of all known peers, best scoring peers first.
+func (t *Tracker) Reputations(ctx context.Context) ([]bls.PeerReputation, error) {
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	records, err := t.store.RetrievePeerReputations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve peer reputations: %w", err)
+	}
+
+	now := time.Now().UTC()
+	for i, rec := range records {
+
+		// Cached records may be more recent if we failed to persist an update.
+		cached, ok := t.peers[rec.Peer]
+		if ok {
+			rec = cached
+		}
+
+		records[i] = rec.Decay(t.halfLife, now)
+	}
+
+	slices.SortStableFunc(records, func(a, b bls.PeerReputation) int {
+		return cmp.Or(
+			cmp.Compare(b.Score, a.Score),
+			strings.Compare(a.Peer.String(), b.Peer.String()),
+		)
+	})
+
+	return records, nil
+}
+
+func (t *Tracker) update(ctx context.Context, id peer.ID, fn func(*bls.PeerReputation)) {
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	rep, err := t.get(ctx, id)
+	if err != nil {
+		t.log.Warn().Err(err).Stringer("peer", id).Msg("could not retrieve peer reputation, starting anew")
+	}
+
+	fn(&rep)
+	rep.Update(time.Now().UTC())
+
+	t.peers[id] = rep
+
+	err = t.store.SavePeerReputation(ctx, rep)
+	if err != nil {
+		t.log.Error().Err(err).Stringer("peer", id).Msg("could not save peer reputation")
+	}
+}
+
+// get returns the peer reputation, decayed to the current moment. Must be called with the lock held.
+// In case of an error, reputation for an unknown peer is returned.
+func (t *Tracker) get(ctx context.Context, id peer.ID) (bls.PeerReputation, error) {
+
+	now := time.Now().UTC()
+
+	rep, ok := t.peers[id]
+	if ok {
+		return rep.Decay(t.halfLife, now), nil
+	}
+
+	rep, err := t.store.RetrievePeerReputation(ctx, id)
+	if err != nil {
+		if errors.Is(err, bls.ErrNotFound) {
+			rep = bls.NewPeerReputation(id)
+			t.peers[id] = rep
+			return rep, nil
+		}
+
+		return bls.NewPeerReputation(id), fmt.Errorf("could not retrieve peer reputation: %w", err)
+	}
+
+	t.peers[id] = rep
+
+	return rep.Decay(t.halfLife, now), nil
+}
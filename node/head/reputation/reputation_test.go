@@ -0,0 +1,86 @@
+package reputation_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/node/head/reputation"
+	"github.com/blessnetwork/b7s/store"
+	"github.com/blessnetwork/b7s/store/codec"
+	"github.com/blessnetwork/b7s/testing/helpers"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestTracker(t *testing.T) {
+
+	var (
+		ctx  = context.Background()
+		good = helpers.RandPeerID(t)
+		bad  = helpers.RandPeerID(t)
+	)
+
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	store := store.New(db, codec.NewJSONCodec())
+	tracker := reputation.NewTracker(mocks.NoopLogger, store, time.Hour)
+
+	// Unknown peers start with a clean slate.
+	require.Equal(t, 1.0, tracker.Score(good))
+
+	tracker.RecordRollCall(ctx, good)
+	tracker.RecordSuccess(ctx, good)
+
+	tracker.RecordRollCall(ctx, bad)
+	tracker.RecordTimeout(ctx, bad)
+	tracker.RecordFailure(ctx, bad)
+	tracker.RecordDisagreement(ctx, bad)
+	tracker.RecordSignatureFailure(ctx, bad)
+
+	require.Greater(t, tracker.Score(good), tracker.Score(bad))
+
+	rep, err := tracker.Reputation(ctx, bad)
+	require.NoError(t, err)
+	require.InDelta(t, 1.0, rep.RollCalls, 0.01)
+	require.InDelta(t, 1.0, rep.Timeouts, 0.01)
+	require.InDelta(t, 1.0, rep.Failures, 0.01)
+	require.InDelta(t, 1.0, rep.Disagreements, 0.01)
+	require.InDelta(t, 1.0, rep.SignatureFailures, 0.01)
+
+	// Best peers are listed first.
+	reputations, err := tracker.Reputations(ctx)
+	require.NoError(t, err)
+	require.Len(t, reputations, 2)
+	require.Equal(t, good, reputations[0].Peer)
+	require.Equal(t, bad, reputations[1].Peer)
+
+	// Reputation is persisted, so a new tracker picks up where the last one stopped.
+	restarted := reputation.NewTracker(mocks.NoopLogger, store, time.Hour)
+	require.InDelta(t, tracker.Score(bad), restarted.Score(bad), 0.01)
+}
+
+func TestTracker_StoreFailure(t *testing.T) {
+
+	id := helpers.RandPeerID(t)
+
+	store := mocks.BaselineStore(t)
+	store.RetrievePeerReputationFunc = func(context.Context, peer.ID) (bls.PeerReputation, error) {
+		return bls.PeerReputation{}, mocks.GenericError
+	}
+	store.SavePeerReputationFunc = func(context.Context, bls.PeerReputation) error {
+		return mocks.GenericError
+	}
+
+	tracker := reputation.NewTracker(mocks.NoopLogger, store, time.Hour)
+
+	// Store failures should not affect tracking.
+	require.Equal(t, 1.0, tracker.Score(id))
+
+	tracker.RecordTimeout(context.Background(), id)
+	require.Less(t, tracker.Score(id), 1.0)
+}
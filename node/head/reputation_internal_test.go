@@ -0,0 +1,84 @@
+package head
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	cons "github.com/blessnetwork/b7s/consensus"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/store"
+	"github.com/blessnetwork/b7s/store/codec"
+	"github.com/blessnetwork/b7s/testing/helpers"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_RecordExecutionOutcome(t *testing.T) {
+
+	var (
+		ctx = context.Background()
+
+		majority1 = helpers.RandPeerID(t)
+		majority2 = helpers.RandPeerID(t)
+		minority  = helpers.RandPeerID(t)
+		failed    = helpers.RandPeerID(t)
+		silent    = helpers.RandPeerID(t)
+
+		peers = []peer.ID{majority1, majority2, minority, failed, silent}
+	)
+
+	result := func(code codes.Code, stdout string) execute.NodeResult {
+		return execute.NodeResult{
+			Result: execute.Result{
+				Code:   code,
+				Result: execute.RuntimeOutput{Stdout: stdout},
+			},
+		}
+	}
+
+	results := execute.ResultMap{
+		majority1: result(codes.OK, "majority"),
+		majority2: result(codes.OK, "majority"),
+		minority:  result(codes.OK, "minority"),
+		failed:    result(codes.Error, ""),
+	}
+
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	head, err := New(mocks.BaselineNodeCore(t), store.New(db, codec.NewJSONCodec()))
+	require.NoError(t, err)
+
+	head.recordExecutionOutcome(ctx, cons.Type(0), peers, results)
+
+	for _, id := range []peer.ID{majority1, majority2} {
+		rep, err := head.reputation.Reputation(ctx, id)
+		require.NoError(t, err)
+		require.InDelta(t, 1.0, rep.Successes, 0.01)
+		require.Zero(t, rep.Disagreements)
+	}
+
+	rep, err := head.reputation.Reputation(ctx, minority)
+	require.NoError(t, err)
+	require.InDelta(t, 1.0, rep.Successes, 0.01)
+	require.InDelta(t, 1.0, rep.Disagreements, 0.01)
+
+	rep, err = head.reputation.Reputation(ctx, failed)
+	require.NoError(t, err)
+	require.InDelta(t, 1.0, rep.Failures, 0.01)
+	require.Zero(t, rep.Disagreements)
+
+	rep, err = head.reputation.Reputation(ctx, silent)
+	require.NoError(t, err)
+	require.InDelta(t, 1.0, rep.Timeouts, 0.01)
+
+	// With Raft only the leader responds - other peers did not time out.
+	head.recordExecutionOutcome(ctx, cons.Raft, peers, execute.ResultMap{majority1: result(codes.OK, "majority")})
+
+	rep, err = head.reputation.Reputation(ctx, silent)
+	require.NoError(t, err)
+	require.InDelta(t, 1.0, rep.Timeouts, 0.01)
+}
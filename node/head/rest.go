@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"fmt"
+	"time"
 
 	"github.com/blessnetwork/b7s/models/bls"
 	"github.com/blessnetwork/b7s/models/codes"
@@ -12,33 +13,201 @@ import (
 )
 
 // ExecuteFunction can be used to start function execution. At the moment this is used by the API server to start execution on the head node.
-func (h *HeadNode) ExecuteFunction(ctx context.Context, req execute.Request, subgroup string) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+// If a webhook is specified, the execution result is delivered to it once the execution is done.
+func (h *HeadNode) ExecuteFunction(ctx context.Context, req execute.Request, subgroup string, webhook bls.Webhook) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
 
 	requestID := newRequestID()
 
-	code, results, cluster, err := h.execute(ctx, requestID, request.Execute{Request: req})
+	code, results, cluster, err := h.executeFunction(ctx, requestID, req, subgroup, webhook)
+
+	return code, requestID, results, cluster, err
+}
+
+// ExecuteFunctionAsync starts function execution in the background and returns the request ID immediately.
+// Progress of the execution can be tracked using `ExecutionStatus`. If too many asynchronous executions are
+// already running, or the request is over the client or function limits, the request is rejected with a `bls.LimitError`.
+func (h *HeadNode) ExecuteFunctionAsync(ctx context.Context, req execute.Request, subgroup string, webhook bls.Webhook) (string, error) {
+
+	select {
+	case h.asyncExecutions <- struct{}{}:
+	default:
+		return "", &bls.LimitError{Limit: bls.LimitAsyncExecutions, RetryAfter: concurrencyLimitRetryAfter}
+	}
+
+	requestID := newRequestID()
+
+	// Check the limits before accepting the request, so clients learn right away when to retry.
+	release, err := h.acquireLimits(ctx, requestID, req)
+	if err != nil {
+		<-h.asyncExecutions
+		return "", err
+	}
+
+	// Start tracking the execution right away so status lookups do not race the background execution.
+	h.executions.start(requestID, req.Client)
+
+	// Execution outlives the request that started it, but not the node.
+	ctx, cancel := h.background(ctx)
+
+	go func() {
+		defer func() { <-h.asyncExecutions }()
+		defer cancel()
+
+		h.executeAcquiredFunction(ctx, requestID, req, subgroup, webhook, release)
+	}()
+
+	return requestID, nil
+}
+
+// executeFunction executes the request and persists the result. If a webhook is specified, the result is delivered to it.
+func (h *HeadNode) executeFunction(ctx context.Context, requestID string, req execute.Request, subgroup string, webhook bls.Webhook) (codes.Code, execute.ResultMap, execute.Cluster, error) {
+
+	release, err := h.acquireLimits(ctx, requestID, req)
+	if err != nil {
+		return h.completeFunction(ctx, requestID, req, webhook, codes.TooManyRequests, nil, execute.Cluster{}, err)
+	}
+
+	return h.executeAcquiredFunction(ctx, requestID, req, subgroup, webhook, release)
+}
+
+// executeAcquiredFunction is like `executeFunction`, for requests for which the limits were already acquired.
+func (h *HeadNode) executeAcquiredFunction(
+	ctx context.Context,
+	requestID string,
+	req execute.Request,
+	subgroup string,
+	webhook bls.Webhook,
+	release func(),
+) (codes.Code, execute.ResultMap, execute.Cluster, error) {
+
+	code, results, cluster, err := h.executeAcquired(ctx, requestID, request.Execute{Request: req, Topic: subgroup}, release)
+	return h.completeFunction(ctx, requestID, req, webhook, code, results, cluster, err)
+}
+
+// completeFunction persists the execution result and starts the webhook delivery, if a webhook is specified.
+func (h *HeadNode) completeFunction(
+	ctx context.Context,
+	requestID string,
+	req execute.Request,
+	webhook bls.Webhook,
+	code codes.Code,
+	results execute.ResultMap,
+	cluster execute.Cluster,
+	err error,
+) (codes.Code, execute.ResultMap, execute.Cluster, error) {
+
 	if err != nil {
 		h.Log().Error().Str("request", requestID).Err(err).Msg("execution failed")
 	}
 
-	return code, requestID, results, cluster, nil
+	record := h.saveExecutionResult(ctx, requestID, req, code, results, cluster, err)
+
+	if webhook.URL != "" {
+		h.startWebhookDelivery(ctx, record, webhook)
+	}
+
+	return code, results, cluster, err
+}
+
+// ExecutionResult fetches the execution result from the node store.
+func (h *HeadNode) ExecutionResult(ctx context.Context, id string) (bls.ExecutionRecord, error) {
+
+	record, err := h.store.RetrieveExecutionResult(ctx, id)
+	if err != nil {
+		return bls.ExecutionRecord{}, fmt.Errorf("could not retrieve execution result: %w", err)
+	}
+
+	// Results that expired but were not yet pruned are treated as missing.
+	if h.executionResultExpired(record) {
+		return bls.ExecutionRecord{}, bls.ErrNotFound
+	}
+
+	return record, nil
+}
+
+// PipelineResult fetches the pipeline result from the node store.
+func (h *HeadNode) PipelineResult(ctx context.Context, id string) (bls.PipelineRecord, error) {
+
+	record, err := h.store.RetrievePipelineResult(ctx, id)
+	if err != nil {
+		return bls.PipelineRecord{}, fmt.Errorf("could not retrieve pipeline result: %w", err)
+	}
+
+	// Pipeline results expire together with the execution results of their steps.
+	if h.cfg.ExecutionResultTTL > 0 && time.Since(record.CreatedAt) > h.cfg.ExecutionResultTTL {
+		return bls.PipelineRecord{}, bls.ErrNotFound
+	}
+
+	return record, nil
+}
+
+// ExecutionEvents returns a channel on which progress events of the execution are delivered, starting with the events that already happened.
+// The channel is closed once the execution is done or when the context is cancelled.
+func (h *HeadNode) ExecutionEvents(ctx context.Context, id string) (<-chan bls.ExecutionEvent, error) {
+
+	events, unsubscribe, ok := h.executions.subscribe(id)
+	if ok {
+		go func() {
+			<-ctx.Done()
+			unsubscribe()
+		}()
+
+		return events, nil
+	}
+
+	// Execution is not in progress - it's either done or unknown.
+	record, err := h.ExecutionResult(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	done := make(chan bls.ExecutionEvent, 1)
+	done <- newExecutionDoneEvent(record)
+	close(done)
+
+	return done, nil
 }
 
-// ExecutionResult fetches the execution result from the node cache.
-func (h *HeadNode) ExecutionResult(id string) (execute.ResultMap, bool) {
-	// TBD: Head node currently does not cache results.
-	return nil, false
+// ExecutionStatus returns the current phase of the execution. Once the execution is done, the status includes the execution result.
+func (h *HeadNode) ExecutionStatus(ctx context.Context, id string) (bls.ExecutionStatus, error) {
+
+	phase, client, ok := h.executions.get(id)
+	if ok {
+		status := bls.ExecutionStatus{
+			RequestID: id,
+			Phase:     phase,
+			Client:    client,
+		}
+
+		return status, nil
+	}
+
+	// Execution is not in progress - it's either done or unknown.
+	record, err := h.ExecutionResult(ctx, id)
+	if err != nil {
+		return bls.ExecutionStatus{}, err
+	}
+
+	status := bls.ExecutionStatus{
+		RequestID: id,
+		Phase:     bls.ExecutionPhaseDone,
+		Client:    record.Client,
+		Record:    &record,
+	}
+
+	return status, nil
 }
 
-// PublishFunctionInstall publishes a function install message.
-func (h *HeadNode) PublishFunctionInstall(ctx context.Context, uri string, cid string, subgroup string) error {
+// PublishFunctionInstall publishes a function install message. It returns the install ID, which can be used
+// to track the confirmations of worker nodes.
+func (h *HeadNode) PublishFunctionInstall(ctx context.Context, uri string, cid string, subgroup string) (string, error) {
 
 	var req request.InstallFunction
 	if uri != "" {
 		var err error
 		req, err = createInstallMessageFromURI(uri)
 		if err != nil {
-			return fmt.Errorf("could not create install message from URI: %W", err)
+			return "", fmt.Errorf("could not create install message from URI: %w", err)
 		}
 	} else {
 		req = createInstallMessageFromCID(cid)
@@ -48,14 +217,26 @@ func (h *HeadNode) PublishFunctionInstall(ctx context.Context, uri string, cid s
 		subgroup = bls.DefaultTopic
 	}
 
-	h.Log().Debug().Str("subgroup", subgroup).Str("url", req.ManifestURL).Str("cid", req.CID).Msg("publishing function install message")
+	req.InstallID = newRequestID()
+
+	h.Log().Debug().Str("subgroup", subgroup).Str("install", req.InstallID).Str("url", req.ManifestURL).Str("cid", req.CID).Msg("publishing function install message")
+
+	// Start tracking the installation before publishing, so we don't miss any confirmations.
+	h.installs.create(bls.FunctionInstallRecord{
+		ID:          req.InstallID,
+		CID:         req.CID,
+		ManifestURL: req.ManifestURL,
+		Topic:       subgroup,
+		Started:     time.Now().UTC(),
+		Workers:     []bls.WorkerInstallStatus{},
+	})
 
 	err := h.PublishToTopic(ctx, subgroup, &req)
 	if err != nil {
-		return fmt.Errorf("could not publish message: %w", err)
+		return "", fmt.Errorf("could not publish message: %w", err)
 	}
 
-	return nil
+	return req.InstallID, nil
 }
 
 // createInstallMessageFromURI creates a MsgInstallFunction from the given URI.
@@ -0,0 +1,170 @@
+package head
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/blessnetwork/b7s/consensus/pbft"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/node/aggregate"
+)
+
+// saveExecutionResult persists the outcome of an execution so it can be retrieved later using the request ID.
+func (h *HeadNode) saveExecutionResult(
+	ctx context.Context,
+	requestID string,
+	req execute.Request,
+	code codes.Code,
+	results execute.ResultMap,
+	cluster execute.Cluster,
+	execErr error,
+) bls.ExecutionRecord {
+
+	record := bls.ExecutionRecord{
+		RequestID:   requestID,
+		FunctionID:  req.FunctionID,
+		Method:      req.Method,
+		Client:      req.Client,
+		Code:        code,
+		Results:     results,
+		Cluster:     cluster,
+		Aggregated:  aggregate.Aggregate(results),
+		Aggregation: aggregate.Apply(req.Config.ResultAggregation, results),
+		Message:     executionErrorMessage(execErr),
+		CreatedAt:   time.Now().UTC(),
+	}
+
+	err := h.store.SaveExecutionResult(ctx, record)
+	if err != nil {
+		h.Log().Error().Err(err).Str("request", requestID).Msg("could not save execution result")
+	}
+
+	h.recordUsage(ctx, requestID, req, results)
+
+	h.executions.publish(newExecutionDoneEvent(record))
+
+	// Execution is done - status is now determined by the stored result.
+	h.executions.remove(requestID)
+
+	return record
+}
+
+// runResultPruneLoop periodically removes execution results that have expired or are over the limit.
+func (h *HeadNode) runResultPruneLoop(ctx context.Context) {
+
+	err := h.pruneExecutionResults(ctx)
+	if err != nil {
+		h.Log().Error().Err(err).Msg("could not prune execution results")
+	}
+
+	ticker := time.NewTicker(executionResultPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			err := h.pruneExecutionResults(ctx)
+			if err != nil {
+				h.Log().Error().Err(err).Msg("could not prune execution results")
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (h *HeadNode) pruneExecutionResults(ctx context.Context) error {
+
+	var before time.Time
+	if h.cfg.ExecutionResultTTL > 0 {
+		before = time.Now().Add(-h.cfg.ExecutionResultTTL)
+	}
+
+	// Results are removed if they have expired, or if we're over the limit - in that case the oldest results go first.
+	remove, err := h.store.RetrieveStaleExecutionResults(ctx, before, h.cfg.ExecutionResultLimit)
+	if err != nil {
+		return fmt.Errorf("could not retrieve stale execution results: %w", err)
+	}
+
+	for _, id := range remove {
+		err = h.store.RemoveExecutionResult(ctx, id)
+		if err != nil {
+			return fmt.Errorf("could not remove execution result (request: %s): %w", id, err)
+		}
+
+		// Webhook delivery records go away together with the result.
+		err = h.store.RemoveWebhookDelivery(ctx, id)
+		if err != nil {
+			return fmt.Errorf("could not remove webhook delivery (request: %s): %w", id, err)
+		}
+	}
+
+	if len(remove) > 0 {
+		h.Log().Debug().Int("removed", len(remove)).Msg("pruned execution results")
+	}
+
+	// Pipeline results follow the same retention settings.
+	remove, err = h.store.RetrieveStalePipelineResults(ctx, before, h.cfg.ExecutionResultLimit)
+	if err != nil {
+		return fmt.Errorf("could not retrieve stale pipeline results: %w", err)
+	}
+
+	for _, id := range remove {
+		err = h.store.RemovePipelineResult(ctx, id)
+		if err != nil {
+			return fmt.Errorf("could not remove pipeline result (pipeline: %s): %w", id, err)
+		}
+	}
+
+	if len(remove) > 0 {
+		h.Log().Debug().Int("removed", len(remove)).Msg("pruned pipeline results")
+	}
+
+	return nil
+}
+
+func (h *HeadNode) executionResultExpired(record bls.ExecutionRecord) bool {
+
+	if h.cfg.ExecutionResultTTL == 0 {
+		return false
+	}
+
+	return time.Since(record.CreatedAt) > h.cfg.ExecutionResultTTL
+}
+
+// executionErrorMessage returns the error message we want to communicate to the user, if any.
+func executionErrorMessage(err error) string {
+
+	if errors.Is(err, bls.ErrRollCallTimeout) || errors.Is(err, bls.ErrExecutionNotEnoughNodes) || errors.Is(err, bls.ErrLimitExceeded) {
+		return err.Error()
+	}
+
+	return ""
+}
+
+func newExecutionDoneEvent(record bls.ExecutionRecord) bls.ExecutionEvent {
+
+	event := bls.ExecutionEvent{
+		RequestID:   record.RequestID,
+		Type:        bls.ExecutionEventDone,
+		Timestamp:   record.CreatedAt,
+		Code:        record.Code,
+		Message:     record.Message,
+		Results:     record.Aggregated,
+		Aggregation: record.Aggregation,
+		Receipts:    record.Results.Receipts(),
+	}
+
+	cert := pbft.NewResultCertificate(record.RequestID, record.Cluster.Peers, record.Results)
+	if cert != nil {
+		certificate := execute.ResultCertificate(*cert)
+		event.Certificate = &certificate
+	}
+
+	return event
+}
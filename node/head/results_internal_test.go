@@ -0,0 +1,286 @@
+package head
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/config"
+	"github.com/blessnetwork/b7s/consensus/pbft"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/store"
+	"github.com/blessnetwork/b7s/store/codec"
+	"github.com/blessnetwork/b7s/testing/helpers"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_ExecutionResult(t *testing.T) {
+
+	var (
+		ctx       = context.Background()
+		requestID = "dummy-request-id"
+		req       = mocks.GenericExecutionRequest
+		results   = mocks.GenericExecutionResultMap
+		cluster   = mocks.GenericExecutionRecord.Cluster
+	)
+
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	head, err := New(mocks.BaselineNodeCore(t), store.New(db, codec.NewJSONCodec()))
+	require.NoError(t, err)
+
+	t.Run("missing result", func(t *testing.T) {
+		_, err := head.ExecutionResult(ctx, requestID)
+		require.ErrorIs(t, err, bls.ErrNotFound)
+	})
+	t.Run("saved result", func(t *testing.T) {
+
+		head.saveExecutionResult(ctx, requestID, req, codes.OK, results, cluster, bls.ErrRollCallTimeout)
+
+		record, err := head.ExecutionResult(ctx, requestID)
+		require.NoError(t, err)
+
+		require.Equal(t, requestID, record.RequestID)
+		require.Equal(t, req.FunctionID, record.FunctionID)
+		require.Equal(t, req.Method, record.Method)
+		require.Equal(t, codes.OK, record.Code)
+		require.Equal(t, results, record.Results)
+		require.Equal(t, cluster, record.Cluster)
+		require.Len(t, record.Aggregated, 1)
+		require.Equal(t, bls.ErrRollCallTimeout.Error(), record.Message)
+	})
+	t.Run("expired result", func(t *testing.T) {
+
+		head.cfg.ExecutionResultTTL = time.Nanosecond
+		defer func() { head.cfg.ExecutionResultTTL = config.DefaultExecutionResultTTL }()
+
+		_, err := head.ExecutionResult(ctx, requestID)
+		require.ErrorIs(t, err, bls.ErrNotFound)
+	})
+}
+
+func TestHead_ExecutionStatus(t *testing.T) {
+
+	var (
+		ctx       = context.Background()
+		requestID = "dummy-request-id"
+		req       = mocks.GenericExecutionRequest
+		results   = mocks.GenericExecutionResultMap
+		cluster   = mocks.GenericExecutionRecord.Cluster
+	)
+
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	head, err := New(mocks.BaselineNodeCore(t), store.New(db, codec.NewJSONCodec()))
+	require.NoError(t, err)
+
+	t.Run("unknown request", func(t *testing.T) {
+		_, err := head.ExecutionStatus(ctx, requestID)
+		require.ErrorIs(t, err, bls.ErrNotFound)
+	})
+	t.Run("execution in progress", func(t *testing.T) {
+
+		head.executions.set(requestID, bls.ExecutionPhaseClusterFormed)
+
+		status, err := head.ExecutionStatus(ctx, requestID)
+		require.NoError(t, err)
+
+		require.Equal(t, requestID, status.RequestID)
+		require.Equal(t, bls.ExecutionPhaseClusterFormed, status.Phase)
+		require.Nil(t, status.Record)
+	})
+	t.Run("execution done", func(t *testing.T) {
+
+		head.saveExecutionResult(ctx, requestID, req, codes.OK, results, cluster, nil)
+
+		status, err := head.ExecutionStatus(ctx, requestID)
+		require.NoError(t, err)
+
+		require.Equal(t, requestID, status.RequestID)
+		require.Equal(t, bls.ExecutionPhaseDone, status.Phase)
+		require.NotNil(t, status.Record)
+		require.Equal(t, codes.OK, status.Record.Code)
+		require.Equal(t, results, status.Record.Results)
+	})
+}
+
+func TestHead_ExecutionEvents(t *testing.T) {
+
+	var (
+		requestID = "dummy-request-id"
+		req       = mocks.GenericExecutionRequest
+		results   = mocks.GenericExecutionResultMap
+		cluster   = mocks.GenericExecutionRecord.Cluster
+	)
+
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	head, err := New(mocks.BaselineNodeCore(t), store.New(db, codec.NewJSONCodec()))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	t.Run("unknown request", func(t *testing.T) {
+		_, err := head.ExecutionEvents(ctx, requestID)
+		require.ErrorIs(t, err, bls.ErrNotFound)
+	})
+	t.Run("execution in progress", func(t *testing.T) {
+
+		head.executions.set(requestID, bls.ExecutionPhaseRollCall)
+		head.executions.publish(bls.ExecutionEvent{RequestID: requestID, Type: bls.ExecutionEventRollCallStarted})
+
+		events, err := head.ExecutionEvents(ctx, requestID)
+		require.NoError(t, err)
+
+		head.saveExecutionResult(ctx, requestID, req, codes.OK, results, cluster, nil)
+
+		var received []bls.ExecutionEvent
+		for event := range events {
+			received = append(received, event)
+		}
+
+		require.Len(t, received, 2)
+		require.Equal(t, bls.ExecutionEventRollCallStarted, received[0].Type)
+		require.Equal(t, bls.ExecutionEventDone, received[1].Type)
+		require.Equal(t, codes.OK, received[1].Code)
+		require.Len(t, received[1].Results, 1)
+	})
+	t.Run("execution done", func(t *testing.T) {
+
+		events, err := head.ExecutionEvents(ctx, requestID)
+		require.NoError(t, err)
+
+		var received []bls.ExecutionEvent
+		for event := range events {
+			received = append(received, event)
+		}
+
+		require.Len(t, received, 1)
+		require.Equal(t, bls.ExecutionEventDone, received[0].Type)
+		require.Equal(t, codes.OK, received[0].Code)
+	})
+}
+
+func TestHead_ExecutionDoneEvent(t *testing.T) {
+
+	record := certifiedExecutionRecord(t)
+
+	event := newExecutionDoneEvent(record)
+	require.Equal(t, bls.ExecutionEventDone, event.Type)
+	require.Equal(t, record.Results.Receipts(), event.Receipts)
+	require.Len(t, event.Receipts, len(record.Cluster.Peers))
+
+	require.NotNil(t, event.Certificate)
+	require.NoError(t, pbft.ResultCertificate(*event.Certificate).Verify(record.Cluster.Peers))
+}
+
+// certifiedExecutionRecord creates a record of an execution by a PBFT cluster, with results and receipts signed by all replicas.
+func certifiedExecutionRecord(t *testing.T) bls.ExecutionRecord {
+	t.Helper()
+
+	var (
+		requestID = mocks.GenericUUID.String()
+		timestamp = time.Now().UTC()
+		result    = execute.Result{
+			Code:   codes.OK,
+			Result: execute.RuntimeOutput{Stdout: "ok"},
+		}
+	)
+
+	record := mocks.GenericExecutionRecord
+	record.RequestID = requestID
+	record.Cluster = execute.Cluster{}
+	record.Results = make(execute.ResultMap)
+
+	for i := 0; i < 4; i++ {
+		key, _, err := crypto.GenerateEd25519Key(nil)
+		require.NoError(t, err)
+
+		id, err := peer.IDFromPrivateKey(key)
+		require.NoError(t, err)
+
+		receipt, err := execute.NewReceipt(requestID, mocks.GenericExecutionRequest, id, result)
+		require.NoError(t, err)
+		require.NoError(t, receipt.Sign(key))
+
+		res := execute.NodeResult{
+			Result: result,
+			PBFT: execute.PBFTResultInfo{
+				RequestTimestamp: timestamp,
+				Replica:          id,
+			},
+			Receipt: &receipt,
+		}
+		require.NoError(t, res.Sign(key))
+
+		record.Cluster.Peers = append(record.Cluster.Peers, id)
+		record.Results[id] = res
+	}
+
+	return record
+}
+
+func TestHead_PruneExecutionResults(t *testing.T) {
+
+	var (
+		ctx   = context.Background()
+		now   = time.Now().UTC()
+		limit = 3
+	)
+
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	store := store.New(db, codec.NewJSONCodec())
+
+	head, err := New(mocks.BaselineNodeCore(t), store,
+		ExecutionResultTTL(time.Hour),
+		ExecutionResultLimit(uint(limit)),
+	)
+	require.NoError(t, err)
+
+	// Save two expired results, and more live results than the limit allows.
+	for i := 0; i < 2; i++ {
+		record := mocks.GenericExecutionRecord
+		record.RequestID = fmt.Sprintf("expired-%v", i)
+		record.CreatedAt = now.Add(-2 * time.Hour)
+
+		require.NoError(t, store.SaveExecutionResult(ctx, record))
+	}
+
+	for i := 0; i < 5; i++ {
+		record := mocks.GenericExecutionRecord
+		record.RequestID = fmt.Sprintf("live-%v", i)
+		record.CreatedAt = now.Add(-time.Duration(i) * time.Minute)
+
+		require.NoError(t, store.SaveExecutionResult(ctx, record))
+	}
+
+	err = head.pruneExecutionResults(ctx)
+	require.NoError(t, err)
+
+	records, err := store.RetrieveExecutionResults(ctx)
+	require.NoError(t, err)
+	require.Len(t, records, limit)
+
+	// Verify we kept the newest results.
+	kept := make(map[string]struct{})
+	for _, record := range records {
+		kept[record.RequestID] = struct{}{}
+	}
+
+	for i := 0; i < limit; i++ {
+		require.Contains(t, kept, fmt.Sprintf("live-%v", i))
+	}
+}
@@ -0,0 +1,121 @@
+package head
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	cons "github.com/blessnetwork/b7s/consensus"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/models/request"
+)
+
+// replaceFailedWorkers issues follow-up roll calls for workers that did not return a successful result, and dispatches the work order to replacement workers.
+// Results of the replacement workers are merged into the result map, taking the place of the results of workers they replaced.
+// Returned are the updated list of peers executing the request and their results.
+func (h *HeadNode) replaceFailedWorkers(ctx context.Context, requestID string, req request.Execute, peers []peer.ID, results execute.ResultMap) ([]peer.ID, execute.ResultMap) {
+
+	var (
+		policy = req.Config.Retry
+		log    = h.Log().With().Str("request", requestID).Str("function", req.FunctionID).Logger()
+
+		replaced = 0
+		tried    = make(map[peer.ID]struct{}, len(peers))
+	)
+
+	peers = slices.Clone(peers)
+	for _, id := range peers {
+		tried[id] = struct{}{}
+	}
+
+	for attempt := 1; attempt <= policy.MaxAttempts; attempt++ {
+
+		failed := failedWorkers(peers, results)
+		if len(failed) == 0 {
+			break
+		}
+
+		need := len(failed)
+		if policy.ReplacementBudget > 0 {
+			need = min(need, policy.ReplacementBudget-replaced)
+		}
+
+		if need <= 0 {
+			log.Info().Int("replaced", replaced).Msg("replacement budget exhausted, not replacing failed workers")
+			break
+		}
+
+		log.Info().
+			Int("attempt", attempt).
+			Int("failed", len(failed)).
+			Int("replacements", need).
+			Msg("requesting replacements for failed workers")
+
+		rc := req
+		rc.Config.NodeCount = need
+
+		replacements, err := h.executeRollCall(ctx, requestID, rc, cons.Type(0), tried)
+		if err != nil {
+			log.Warn().Err(err).Int("attempt", attempt).Msg("could not roll call replacement workers")
+			break
+		}
+
+		// Replacement workers take the place of the failed ones.
+		for i, replacement := range replacements {
+			failedPeer := failed[i]
+
+			peers[slices.Index(peers, failedPeer)] = replacement
+			delete(results, failedPeer)
+
+			tried[replacement] = struct{}{}
+		}
+
+		replaced += len(replacements)
+
+		h.executions.addPeers(requestID, replacements...)
+
+		err = h.SendToMany(ctx, replacements, req.WorkOrder(requestID), false)
+		if err != nil {
+			log.Warn().Err(err).Int("attempt", attempt).Msg("could not send execution request to replacement workers")
+			continue
+		}
+
+		h.executions.publish(bls.ExecutionEvent{
+			RequestID: requestID,
+			Type:      bls.ExecutionEventWorkOrderSent,
+			Peers:     replacements,
+		})
+
+		replacementResults := h.gatherExecutionResults(ctx, requestID, replacements, execute.CompletionPolicy{}, 0)
+
+		log.Info().
+			Int("attempt", attempt).
+			Int("replacements", len(replacements)).
+			Int("responded", len(replacementResults)).
+			Msg("received execution responses from replacement workers")
+
+		h.recordExecutionOutcome(ctx, cons.Type(0), replacements, replacementResults)
+
+		maps.Copy(results, replacementResults)
+	}
+
+	return peers, results
+}
+
+// failedWorkers returns the peers that did not return a successful result.
+func failedWorkers(peers []peer.ID, results execute.ResultMap) []peer.ID {
+
+	var failed []peer.ID
+	for _, id := range peers {
+		res, ok := results[id]
+		if !ok || res.Code != codes.OK {
+			failed = append(failed, id)
+		}
+	}
+
+	return failed
+}
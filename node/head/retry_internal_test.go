@@ -0,0 +1,160 @@
+package head
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/models/request"
+	"github.com/blessnetwork/b7s/models/response"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_ReplaceFailedWorkers(t *testing.T) {
+
+	var (
+		success = execute.NodeResult{
+			Result: execute.Result{Code: codes.OK, Result: execute.RuntimeOutput{Stdout: "ok"}},
+		}
+		failure = execute.NodeResult{
+			Result: execute.Result{Code: codes.Error},
+		}
+	)
+
+	// setup creates a head node where the given replacement peers report for the first roll call and respond to work orders successfully.
+	setup := func(t *testing.T, requestID string, functionID string, replacements []peer.ID) (*HeadNode, *[]peer.ID) {
+		t.Helper()
+
+		head, err := New(mocks.BaselineNodeCore(t), mocks.BaselineStore(t))
+		require.NoError(t, err)
+		head.cfg.RollCallTimeout = 200 * time.Millisecond
+
+		var (
+			lock       sync.Mutex
+			rollCalls  int
+			dispatched []peer.ID
+		)
+
+		core := mocks.BaselineNodeCore(t)
+		core.ConnectedFunc = func(peer.ID) bool {
+			return true
+		}
+		core.PublishToTopicFunc = func(_ context.Context, _ string, msg bls.Message) error {
+			lock.Lock()
+			defer lock.Unlock()
+
+			_, ok := any(msg).(*request.RollCall)
+			require.True(t, ok)
+
+			rollCalls++
+			if rollCalls > 1 {
+				return nil
+			}
+
+			for _, id := range replacements {
+				head.rollCall.add(requestID, rollCallResponse{
+					From: id,
+					RollCall: response.RollCall{
+						Code:       codes.Accepted,
+						FunctionID: functionID,
+						RequestID:  requestID,
+					},
+				})
+			}
+
+			return nil
+		}
+		core.SendToManyFunc = func(_ context.Context, peers []peer.ID, msg bls.Message, _ bool) error {
+			lock.Lock()
+			defer lock.Unlock()
+
+			_, ok := any(msg).(*request.WorkOrder)
+			require.True(t, ok)
+
+			for _, id := range peers {
+				dispatched = append(dispatched, id)
+				head.workOrderResponses.Set(peerRequestKey(requestID, id), success)
+			}
+
+			return nil
+		}
+		head.Core = core
+
+		return head, &dispatched
+	}
+
+	t.Run("failed workers are replaced", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			requestID    = fmt.Sprintf("request-id-%v", rand.Int())
+			req          = mocks.GenericExecutionRequest
+			good         = peer.ID("good-peer")
+			bad          = peer.ID("bad-peer")
+			silent       = peer.ID("silent-peer")
+			replacements = []peer.ID{"replacement-1", "replacement-2"}
+		)
+
+		req.Config.Retry = execute.RetryPolicy{MaxAttempts: 1}
+
+		head, dispatched := setup(t, requestID, req.FunctionID, replacements)
+
+		peers := []peer.ID{good, bad, silent}
+		results := execute.ResultMap{
+			good: success,
+			bad:  failure,
+		}
+
+		peers, results = head.replaceFailedWorkers(context.Background(), requestID, request.Execute{Request: req}, peers, results)
+
+		require.Equal(t, []peer.ID{good, replacements[0], replacements[1]}, peers)
+		require.Equal(t, replacements, *dispatched)
+		require.Equal(t, execute.ResultMap{
+			good:            success,
+			replacements[0]: success,
+			replacements[1]: success,
+		}, results)
+	})
+	t.Run("replacement budget is respected", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			requestID    = fmt.Sprintf("request-id-%v", rand.Int())
+			req          = mocks.GenericExecutionRequest
+			bad1         = peer.ID("bad-peer-1")
+			bad2         = peer.ID("bad-peer-2")
+			replacements = []peer.ID{"replacement-1", "replacement-2", "replacement-3"}
+		)
+
+		req.Config.Retry = execute.RetryPolicy{
+			MaxAttempts:       3,
+			ReplacementBudget: 1,
+		}
+
+		head, dispatched := setup(t, requestID, req.FunctionID, replacements)
+
+		peers := []peer.ID{bad1, bad2}
+		results := execute.ResultMap{
+			bad1: failure,
+			bad2: failure,
+		}
+
+		peers, results = head.replaceFailedWorkers(context.Background(), requestID, request.Execute{Request: req}, peers, results)
+
+		require.Equal(t, []peer.ID{replacements[0], bad2}, peers)
+		require.Equal(t, []peer.ID{replacements[0]}, *dispatched)
+		require.Equal(t, execute.ResultMap{
+			replacements[0]: success,
+			bad2:            failure,
+		}, results)
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/blessnetwork/b7s/models/codes"
 	"github.com/blessnetwork/b7s/models/request"
 	"github.com/blessnetwork/b7s/models/response"
+	"github.com/blessnetwork/b7s/node/head/selection"
 )
 
 func (h *HeadNode) executeRollCall(
@@ -22,6 +23,7 @@ func (h *HeadNode) executeRollCall(
 	requestID string,
 	req request.Execute,
 	consensus cons.Type,
+	exclude map[peer.ID]struct{},
 ) ([]peer.ID, error) {
 
 	// Create a logger with relevant context.
@@ -44,6 +46,11 @@ func (h *HeadNode) executeRollCall(
 
 	log.Info().Msg("roll call published")
 
+	h.executions.publish(bls.ExecutionEvent{
+		RequestID: requestID,
+		Type:      bls.ExecutionEventRollCallStarted,
+	})
+
 	// Limit for how long we wait for responses.
 	t := cmp.Or(
 		time.Duration(req.Config.Timeout)*time.Second,
@@ -53,9 +60,61 @@ func (h *HeadNode) executeRollCall(
 	defer cancel()
 
 	nodeCount := req.Config.NodeCount
+	// Node count should have been set by now, but if it wasn't we need at least one peer.
+	if nodeCount == 0 {
+		nodeCount = 1
+	}
+
+	strategy := h.peerSelection(log, req.Request)
+	log = log.With().Stringer("peer_selection", strategy).Logger()
+
+	// Unless peers are chosen on a first-come basis, collect responses for a while so we have a pool of peers to choose from.
+	var window <-chan time.Time
+	windowElapsed := strategy == selection.FirstCome
+	if !windowElapsed {
+		timer := time.NewTimer(h.cfg.PeerSelectionWindow)
+		defer timer.Stop()
+		window = timer.C
+	}
+
+	// -1 means we'll take any peers reporting
+	haveEnough := func(n int) bool {
+		if nodeCount == -1 {
+			return false
+		}
+		return n >= nodeCount
+	}
 
 	// Peers that have reported on roll call.
-	var reportingPeers []peer.ID
+	var candidates []selection.Candidate
+
+	accept := func(reply rollCallResponse, score float64) {
+
+		h.reputation.RecordRollCall(ctx, reply.From)
+
+		log.Info().Stringer("peer", reply.From).Float64("score", score).Msg("peer reported for roll call")
+
+		candidates = append(candidates, selection.Candidate{
+			ID:   reply.From,
+			Load: reply.Load,
+		})
+
+		h.executions.publish(bls.ExecutionEvent{
+			RequestID: requestID,
+			Type:      bls.ExecutionEventPeerReported,
+			Peer:      reply.From,
+		})
+	}
+
+	// Attributes are verified in the background, so a slow peer does not hold up responses from other peers.
+	// Verifications still in progress when the roll call ends are abandoned.
+	type verification struct {
+		reply rollCallResponse
+		score float64
+		err   error
+	}
+	verified := make(chan verification)
+
 rollCallResponseLoop:
 	for {
 		// Wait for responses from nodes who want to work on the request.
@@ -63,8 +122,7 @@ rollCallResponseLoop:
 		// Request timed out.
 		case <-tctx.Done():
 
-			// -1 means we'll take any peers reporting
-			if len(reportingPeers) >= 1 && nodeCount == -1 {
+			if (nodeCount == -1 && len(candidates) >= 1) || haveEnough(len(candidates)) {
 				log.Info().Msg("enough peers reported for roll call")
 				break rollCallResponseLoop
 			}
@@ -72,6 +130,33 @@ rollCallResponseLoop:
 			log.Warn().Msg("roll call timed out")
 			return nil, bls.ErrRollCallTimeout
 
+		case <-window:
+
+			windowElapsed = true
+			window = nil
+
+			if haveEnough(len(candidates)) {
+				log.Info().Msg("enough peers reported for roll call")
+				break rollCallResponseLoop
+			}
+
+		case v := <-verified:
+
+			if v.err != nil {
+				log.Info().
+					Err(v.err).
+					Stringer("peer", v.reply.From).
+					Msg("skipping roll call response from peer whose attributes could not be verified")
+				continue
+			}
+
+			accept(v.reply, v.score)
+
+			if windowElapsed && haveEnough(len(candidates)) {
+				log.Info().Msg("enough peers reported for roll call")
+				break rollCallResponseLoop
+			}
+
 		case reply := <-h.rollCall.responses(requestID):
 
 			// Check if this is the reply we want - shouldn't really happen.
@@ -92,18 +177,48 @@ rollCallResponseLoop:
 				continue
 			}
 
-			log.Info().Stringer("peer", reply.From).Msg("roll called peer chosen for execution")
+			// Skip peers already tried for this request, when looking for replacement workers.
+			_, excluded := exclude[reply.From]
+			if excluded {
+				log.Info().
+					Stringer("peer", reply.From).
+					Msg("skipping roll call response from peer already chosen for this request")
+				continue
+			}
+
+			score := h.reputation.Score(reply.From)
+			if score < h.cfg.MinimumReputation {
+				log.Info().
+					Stringer("peer", reply.From).
+					Float64("score", score).
+					Msg("skipping roll call response from peer with low reputation")
+				continue
+			}
+
+			if h.cfg.VerifyAttributes && req.Config.Attributes != nil {
+				go func() {
+					err := h.verifyPeerAttributes(tctx, reply.From, *req.Config.Attributes)
+					select {
+					case verified <- verification{reply: reply, score: score, err: err}:
+					case <-tctx.Done():
+					}
+				}()
+				continue
+			}
 
-			reportingPeers = append(reportingPeers, reply.From)
+			accept(reply, score)
 
-			// -1 means we'll take any peers reporting
-			if len(reportingPeers) >= nodeCount && nodeCount != -1 {
+			if windowElapsed && haveEnough(len(candidates)) {
 				log.Info().Msg("enough peers reported for roll call")
 				break rollCallResponseLoop
 			}
 		}
 	}
 
+	reportingPeers := h.selectors[strategy].Select(ctx, nodeCount, candidates)
+
+	log.Info().Strs("peers", bls.PeerIDsToStr(reportingPeers)).Msg("roll called peers chosen for execution")
+
 	if consensus == cons.PBFT && len(reportingPeers) < pbft.MinimumReplicaCount {
 		return nil, fmt.Errorf("not enough peers reported for PBFT consensus (have: %v, need: %v)", len(reportingPeers), pbft.MinimumReplicaCount)
 	}
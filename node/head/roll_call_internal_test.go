@@ -0,0 +1,202 @@
+package head
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/blocklessnetwork/b7s-attributes/attributes"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/consensus"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/models/request"
+	"github.com/blessnetwork/b7s/models/response"
+	"github.com/blessnetwork/b7s/node/head/selection"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_RollCallPeerSelection(t *testing.T) {
+
+	var (
+		requestID = fmt.Sprintf("request-id-%v", rand.Int())
+		req       = mocks.GenericExecutionRequest
+		loads     = []uint{4, 1, 7, 0}
+		peers     = make([]peer.ID, 0, len(loads))
+	)
+
+	req.Config.NodeCount = 2
+	req.Config.PeerSelection = selection.LeastLoaded.String()
+
+	head, err := New(mocks.BaselineNodeCore(t), mocks.BaselineStore(t),
+		PeerSelectionWindow(100*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	core := mocks.BaselineNodeCore(t)
+	core.ConnectedFunc = func(peer.ID) bool {
+		return true
+	}
+	head.Core = core
+
+	// Queue roll call responses in advance - the roll call will use the existing queue.
+	head.rollCall.create(requestID)
+	for i, load := range loads {
+
+		id := peer.ID(fmt.Sprintf("peer-%d", i))
+		peers = append(peers, id)
+
+		head.rollCall.add(requestID, rollCallResponse{
+			From: id,
+			RollCall: response.RollCall{
+				Code:       codes.Accepted,
+				FunctionID: req.FunctionID,
+				RequestID:  requestID,
+				Load:       &bls.NodeLoad{ActiveExecutions: load},
+			},
+		})
+	}
+
+	er := request.Execute{
+		Request: req,
+	}
+	selected, err := head.executeRollCall(context.Background(), requestID, er, consensus.Type(0), nil)
+	require.NoError(t, err)
+
+	// First-come selection would have chosen the first two peers, but we want the least loaded ones.
+	require.Equal(t, []peer.ID{peers[3], peers[1]}, selected)
+}
+
+func TestHead_RollCallSkipsLowReputation(t *testing.T) {
+
+	var (
+		requestID = fmt.Sprintf("request-id-%v", rand.Int())
+		req       = mocks.GenericExecutionRequest
+		bad       = peer.ID("bad-peer")
+		good      = peer.ID("good-peer")
+	)
+
+	req.Config.NodeCount = 1
+
+	store := mocks.BaselineStore(t)
+	store.RetrievePeerReputationFunc = func(_ context.Context, id peer.ID) (bls.PeerReputation, error) {
+		rep := bls.NewPeerReputation(id)
+		if id == bad {
+			rep.SignatureFailures = 10
+			rep.Update(time.Now())
+		}
+		return rep, nil
+	}
+
+	head, err := New(mocks.BaselineNodeCore(t), store, MinimumReputation(0.5))
+	require.NoError(t, err)
+
+	core := mocks.BaselineNodeCore(t)
+	core.ConnectedFunc = func(peer.ID) bool {
+		return true
+	}
+	head.Core = core
+
+	// Peer with bad reputation responds first.
+	head.rollCall.create(requestID)
+	for _, id := range []peer.ID{bad, good} {
+		head.rollCall.add(requestID, rollCallResponse{
+			From: id,
+			RollCall: response.RollCall{
+				Code:       codes.Accepted,
+				FunctionID: req.FunctionID,
+				RequestID:  requestID,
+			},
+		})
+	}
+
+	er := request.Execute{
+		Request: req,
+	}
+	selected, err := head.executeRollCall(context.Background(), requestID, er, consensus.Type(0), nil)
+	require.NoError(t, err)
+	require.Equal(t, []peer.ID{good}, selected)
+}
+
+func TestHead_RollCallVerifiesAttributesConcurrently(t *testing.T) {
+
+	var (
+		requestID = fmt.Sprintf("request-id-%v", rand.Int())
+		req       = mocks.GenericExecutionRequest
+		attrs     = []attributes.Attribute{{Name: "gpu_memory_gb", Value: "24"}}
+	)
+
+	newPeer := func() (peer.ID, attributes.Attestation) {
+		key, _, err := crypto.GenerateEd25519Key(nil)
+		require.NoError(t, err)
+
+		id, err := peer.IDFromPrivateKey(key)
+		require.NoError(t, err)
+
+		sig, err := attributes.SignAttributes(attrs, key)
+		require.NoError(t, err)
+
+		return id, attributes.Attestation{
+			Attributes: attrs,
+			Signature:  &attributes.Signature{Signer: id, Signature: sig},
+		}
+	}
+
+	var (
+		slow, _   = newPeer()
+		fast, att = newPeer()
+	)
+
+	req.Config.NodeCount = 1
+	req.Config.Attributes = &execute.Attributes{
+		Predicates: []execute.AttributePredicate{{Name: "gpu_memory_gb", Operator: execute.AttributeAtLeast, Value: "16"}},
+	}
+
+	head, err := New(mocks.BaselineNodeCore(t), mocks.BaselineStore(t), VerifyAttributes(true))
+	require.NoError(t, err)
+
+	// Slow peer never responds to the attestation request.
+	core := mocks.BaselineNodeCore(t)
+	core.ConnectedFunc = func(peer.ID) bool {
+		return true
+	}
+	core.SendFunc = func(_ context.Context, to peer.ID, msg bls.Message) error {
+		if to == fast {
+			req := msg.(*request.Attestation)
+			head.attestationResponses.Set(peerRequestKey(req.RequestID, to), *req.Response(codes.OK, &att))
+		}
+		return nil
+	}
+	head.Core = core
+
+	// Slow peer responds to the roll call first.
+	head.rollCall.create(requestID)
+	for _, id := range []peer.ID{slow, fast} {
+		head.rollCall.add(requestID, rollCallResponse{
+			From: id,
+			RollCall: response.RollCall{
+				Code:       codes.Accepted,
+				FunctionID: req.FunctionID,
+				RequestID:  requestID,
+			},
+		})
+	}
+
+	er := request.Execute{
+		Request: req,
+	}
+
+	start := time.Now()
+	selected, err := head.executeRollCall(context.Background(), requestID, er, consensus.Type(0), nil)
+	require.NoError(t, err)
+	require.Equal(t, []peer.ID{fast}, selected)
+
+	// Roll call did not wait for the slow peer.
+	require.Less(t, time.Since(start), attestationRequestTimeout)
+}
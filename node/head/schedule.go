@@ -0,0 +1,187 @@
+package head
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/node/head/cron"
+)
+
+// CreateSchedule creates a recurring execution. Execution request is executed each time the cron expression fires.
+// Results are kept in the result store and, if a webhook is specified, delivered to it.
+func (h *HeadNode) CreateSchedule(ctx context.Context, expr string, req execute.Request, subgroup string, webhook bls.Webhook) (bls.Schedule, error) {
+
+	spec, err := cron.Parse(expr)
+	if err != nil {
+		return bls.Schedule{}, fmt.Errorf("could not parse cron expression: %w", err)
+	}
+
+	now := time.Now().UTC()
+	next := spec.Next(now)
+	if next.IsZero() {
+		return bls.Schedule{}, fmt.Errorf("cron expression never fires: %v", expr)
+	}
+
+	schedule := bls.Schedule{
+		ID:        newRequestID(),
+		Cron:      expr,
+		Request:   req,
+		Topic:     subgroup,
+		Webhook:   webhook,
+		NextRun:   next,
+		CreatedAt: now,
+	}
+
+	h.scheduleLock.Lock()
+	defer h.scheduleLock.Unlock()
+
+	err = h.store.SaveSchedule(ctx, schedule)
+	if err != nil {
+		return bls.Schedule{}, fmt.Errorf("could not save schedule: %w", err)
+	}
+
+	h.Log().Info().Str("schedule", schedule.ID).Str("cron", expr).Time("next_run", next).Msg("schedule created")
+
+	return schedule, nil
+}
+
+// Schedules returns all schedules.
+func (h *HeadNode) Schedules(ctx context.Context) ([]bls.Schedule, error) {
+
+	schedules, err := h.store.RetrieveSchedules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve schedules: %w", err)
+	}
+
+	return schedules, nil
+}
+
+// PauseSchedule pauses or resumes a schedule. Paused schedules do not start executions.
+// Once resumed, schedule runs at the next time the cron expression fires - runs missed while paused are skipped.
+func (h *HeadNode) PauseSchedule(ctx context.Context, id string, paused bool) (bls.Schedule, error) {
+
+	h.scheduleLock.Lock()
+	defer h.scheduleLock.Unlock()
+
+	schedule, err := h.store.RetrieveSchedule(ctx, id)
+	if err != nil {
+		return bls.Schedule{}, fmt.Errorf("could not retrieve schedule: %w", err)
+	}
+
+	if schedule.Paused == paused {
+		return schedule, nil
+	}
+
+	schedule.Paused = paused
+	if !paused {
+		spec, err := cron.Parse(schedule.Cron)
+		if err != nil {
+			return bls.Schedule{}, fmt.Errorf("could not parse cron expression: %w", err)
+		}
+
+		schedule.NextRun = spec.Next(time.Now().UTC())
+	}
+
+	err = h.store.SaveSchedule(ctx, schedule)
+	if err != nil {
+		return bls.Schedule{}, fmt.Errorf("could not save schedule: %w", err)
+	}
+
+	h.Log().Info().Str("schedule", id).Bool("paused", paused).Msg("schedule updated")
+
+	return schedule, nil
+}
+
+// DeleteSchedule removes a schedule. Executions already started by the schedule are not affected.
+func (h *HeadNode) DeleteSchedule(ctx context.Context, id string) error {
+
+	h.scheduleLock.Lock()
+	defer h.scheduleLock.Unlock()
+
+	// Make sure the schedule exists so the caller knows if they used a wrong ID.
+	_, err := h.store.RetrieveSchedule(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not retrieve schedule: %w", err)
+	}
+
+	err = h.store.RemoveSchedule(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not remove schedule: %w", err)
+	}
+
+	h.Log().Info().Str("schedule", id).Msg("schedule deleted")
+
+	return nil
+}
+
+// runScheduler periodically starts executions for schedules that are due.
+func (h *HeadNode) runScheduler(ctx context.Context) {
+
+	ticker := time.NewTicker(scheduleCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			err := h.runDueSchedules(ctx, now.UTC())
+			if err != nil {
+				h.Log().Error().Err(err).Msg("could not run scheduled executions")
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// runDueSchedules starts executions for schedules that are due at the given time.
+// If the node was not running when a schedule was due, the execution is started once, regardless of how many runs were missed.
+func (h *HeadNode) runDueSchedules(ctx context.Context, now time.Time) error {
+
+	h.scheduleLock.Lock()
+	defer h.scheduleLock.Unlock()
+
+	schedules, err := h.store.RetrieveSchedules(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve schedules: %w", err)
+	}
+
+	var multiErr *multierror.Error
+	for _, schedule := range schedules {
+
+		if schedule.Paused || schedule.NextRun.After(now) {
+			continue
+		}
+
+		spec, err := cron.Parse(schedule.Cron)
+		if err != nil {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("could not parse cron expression (schedule: %s): %w", schedule.ID, err))
+			continue
+		}
+
+		requestID := newRequestID()
+
+		h.Log().Info().Str("schedule", schedule.ID).Str("request", requestID).Msg("starting scheduled execution")
+
+		// Start tracking the execution right away so status lookups do not race the background execution.
+		h.executions.start(requestID, schedule.Request.Client)
+
+		go h.executeFunction(ctx, requestID, schedule.Request, schedule.Topic, schedule.Webhook)
+
+		schedule.LastRun = now
+		schedule.LastRequestID = requestID
+		schedule.NextRun = spec.Next(now)
+
+		err = h.store.SaveSchedule(ctx, schedule)
+		if err != nil {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("could not save schedule (schedule: %s): %w", schedule.ID, err))
+		}
+	}
+
+	return multiErr.ErrorOrNil()
+}
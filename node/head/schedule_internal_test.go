@@ -0,0 +1,147 @@
+package head
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/request"
+	"github.com/blessnetwork/b7s/store"
+	"github.com/blessnetwork/b7s/store/codec"
+	"github.com/blessnetwork/b7s/testing/helpers"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_Schedules(t *testing.T) {
+
+	var (
+		ctx = context.Background()
+		req = mocks.GenericExecutionRequest
+	)
+
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	head, err := New(mocks.BaselineNodeCore(t), store.New(db, codec.NewJSONCodec()))
+	require.NoError(t, err)
+
+	_, err = head.CreateSchedule(ctx, "* * *", req, "", bls.Webhook{})
+	require.Error(t, err)
+
+	_, err = head.CreateSchedule(ctx, "0 0 30 2 *", req, "", bls.Webhook{})
+	require.Error(t, err)
+
+	schedule, err := head.CreateSchedule(ctx, "*/5 * * * *", req, "dummy-topic", bls.Webhook{})
+	require.NoError(t, err)
+
+	require.NotEmpty(t, schedule.ID)
+	require.True(t, schedule.NextRun.After(time.Now()))
+	require.Zero(t, schedule.NextRun.Minute()%5)
+
+	schedules, err := head.Schedules(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []bls.Schedule{schedule}, schedules)
+
+	paused, err := head.PauseSchedule(ctx, schedule.ID, true)
+	require.NoError(t, err)
+	require.True(t, paused.Paused)
+
+	resumed, err := head.PauseSchedule(ctx, schedule.ID, false)
+	require.NoError(t, err)
+	require.False(t, resumed.Paused)
+
+	_, err = head.PauseSchedule(ctx, "unknown-schedule", true)
+	require.ErrorIs(t, err, bls.ErrNotFound)
+
+	err = head.DeleteSchedule(ctx, schedule.ID)
+	require.NoError(t, err)
+
+	err = head.DeleteSchedule(ctx, schedule.ID)
+	require.ErrorIs(t, err, bls.ErrNotFound)
+
+	schedules, err = head.Schedules(ctx)
+	require.NoError(t, err)
+	require.Empty(t, schedules)
+}
+
+func TestHead_RunDueSchedules(t *testing.T) {
+
+	const topic = "dummy-topic"
+
+	var (
+		ctx = context.Background()
+		now = time.Now().UTC()
+	)
+
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	store := store.New(db, codec.NewJSONCodec())
+
+	head, err := New(mocks.BaselineNodeCore(t), store)
+	require.NoError(t, err)
+	head.cfg.RollCallTimeout = 100 * time.Millisecond
+
+	published := make(chan string, 10)
+
+	core := mocks.BaselineNodeCore(t)
+	core.PublishToTopicFunc = func(_ context.Context, subgroup string, msg bls.Message) error {
+		_, ok := any(msg).(*request.RollCall)
+		require.True(t, ok)
+
+		published <- subgroup
+		return nil
+	}
+	head.Core = core
+
+	due := mocks.GenericSchedule
+	due.ID = "due-schedule"
+	due.Topic = topic
+	due.NextRun = now.Add(-time.Hour)
+
+	paused := due
+	paused.ID = "paused-schedule"
+	paused.Paused = true
+
+	future := due
+	future.ID = "future-schedule"
+	future.NextRun = now.Add(time.Hour)
+
+	for _, schedule := range []bls.Schedule{due, paused, future} {
+		require.NoError(t, store.SaveSchedule(ctx, schedule))
+	}
+
+	err = head.runDueSchedules(ctx, now)
+	require.NoError(t, err)
+
+	// Only the due schedule started an execution, in the right subgroup.
+	select {
+	case subgroup := <-published:
+		require.Equal(t, topic, subgroup)
+	case <-time.After(time.Second):
+		t.Fatal("scheduled execution did not start")
+	}
+
+	updated, err := store.RetrieveSchedule(ctx, due.ID)
+	require.NoError(t, err)
+	require.Equal(t, now, updated.LastRun)
+	require.NotEmpty(t, updated.LastRequestID)
+	require.True(t, updated.NextRun.After(now))
+
+	for _, schedule := range []bls.Schedule{paused, future} {
+		retrieved, err := store.RetrieveSchedule(ctx, schedule.ID)
+		require.NoError(t, err)
+		require.Equal(t, schedule, retrieved)
+	}
+
+	// Wait for the scheduled execution to finish.
+	require.Eventually(t, func() bool {
+		_, err := head.ExecutionResult(ctx, updated.LastRequestID)
+		return err == nil
+	}, 2*time.Second, 10*time.Millisecond)
+
+	require.Empty(t, published)
+}
@@ -0,0 +1,101 @@
+// Package selection provides strategies the head node can use to choose which of the peers that reported for roll call will execute the request.
+package selection
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blessnetwork/b7s/models/bls"
+)
+
+// Type identifies peer selection strategies.
+type Type uint
+
+const (
+	FirstCome Type = iota + 1
+	Random
+	LeastLoaded
+	LowestLatency
+	Reputation
+)
+
+func (t Type) String() string {
+	switch t {
+	case FirstCome:
+		return "first-come"
+	case Random:
+		return "random"
+	case LeastLoaded:
+		return "least-loaded"
+	case LowestLatency:
+		return "lowest-latency"
+	case Reputation:
+		return "reputation"
+	default:
+		return fmt.Sprintf("unknown: %d", t)
+	}
+}
+
+func (t Type) Valid() bool {
+	switch t {
+	case FirstCome, Random, LeastLoaded, LowestLatency, Reputation:
+		return true
+	default:
+		return false
+	}
+}
+
+// Parse returns the peer selection strategy with the given name. Empty string yields zero value, meaning the default should be used.
+func Parse(s string) (Type, error) {
+
+	if s == "" {
+		return 0, nil
+	}
+
+	switch strings.ToLower(s) {
+	case "first-come":
+		return FirstCome, nil
+	case "random":
+		return Random, nil
+	case "least-loaded":
+		return LeastLoaded, nil
+	case "lowest-latency":
+		return LowestLatency, nil
+	case "reputation":
+		return Reputation, nil
+	}
+
+	return 0, fmt.Errorf("unknown peer selection value (%s)", s)
+}
+
+// Candidate is a peer that reported for roll call.
+type Candidate struct {
+	ID   peer.ID
+	Load *bls.NodeLoad // Load reported by the peer, if any.
+}
+
+// PeerSelector chooses the peers that will execute the request.
+type PeerSelector interface {
+	// Select returns at most `n` peers from the list of candidates. Candidates are given in the order in which they responded.
+	// Negative `n` means all candidates should be returned, in the order of preference.
+	Select(ctx context.Context, n int, candidates []Candidate) []peer.ID
+}
+
+// limit returns the number of peers that should be selected.
+func limit(n int, candidates []Candidate) int {
+	if n < 0 || n > len(candidates) {
+		return len(candidates)
+	}
+	return n
+}
+
+func peerIDs(candidates []Candidate) []peer.ID {
+	ids := make([]peer.ID, 0, len(candidates))
+	for _, c := range candidates {
+		ids = append(ids, c.ID)
+	}
+	return ids
+}
@@ -0,0 +1,224 @@
+package selection_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/node/head/selection"
+)
+
+func TestSelection_Parse(t *testing.T) {
+
+	tests := []struct {
+		value    string
+		expected selection.Type
+	}{
+		{value: "", expected: 0},
+		{value: "first-come", expected: selection.FirstCome},
+		{value: "random", expected: selection.Random},
+		{value: "Least-Loaded", expected: selection.LeastLoaded},
+		{value: "lowest-latency", expected: selection.LowestLatency},
+		{value: "reputation", expected: selection.Reputation},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			strategy, err := selection.Parse(test.value)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, strategy)
+		})
+	}
+
+	_, err := selection.Parse("fastest")
+	require.Error(t, err)
+
+	for _, strategy := range []selection.Type{selection.FirstCome, selection.Random, selection.LeastLoaded, selection.LowestLatency, selection.Reputation} {
+		parsed, err := selection.Parse(strategy.String())
+		require.NoError(t, err)
+		require.Equal(t, strategy, parsed)
+		require.True(t, strategy.Valid())
+	}
+
+	require.False(t, selection.Type(0).Valid())
+}
+
+func TestSelection_FirstCome(t *testing.T) {
+
+	candidates := generateCandidates(t, 4)
+	selector := selection.NewFirstCome()
+
+	selected := selector.Select(context.Background(), 2, candidates)
+	require.Equal(t, []peer.ID{candidates[0].ID, candidates[1].ID}, selected)
+
+	selected = selector.Select(context.Background(), -1, candidates)
+	require.Equal(t, ids(candidates), selected)
+
+	selected = selector.Select(context.Background(), 10, candidates)
+	require.Equal(t, ids(candidates), selected)
+}
+
+func TestSelection_Random(t *testing.T) {
+
+	candidates := generateCandidates(t, 10)
+	selector := selection.NewRandom()
+
+	selected := selector.Select(context.Background(), 5, candidates)
+	require.Len(t, selected, 5)
+	requireUnique(t, selected)
+	require.Subset(t, ids(candidates), selected)
+
+	selected = selector.Select(context.Background(), -1, candidates)
+	require.ElementsMatch(t, ids(candidates), selected)
+}
+
+func TestSelection_LeastLoaded(t *testing.T) {
+
+	candidates := generateCandidates(t, 4)
+	candidates[0].Load = &bls.NodeLoad{ActiveExecutions: 5}
+	candidates[1].Load = nil
+	candidates[2].Load = &bls.NodeLoad{ActiveExecutions: 0}
+	candidates[3].Load = &bls.NodeLoad{ActiveExecutions: 2}
+
+	selector := selection.NewLeastLoaded()
+
+	selected := selector.Select(context.Background(), 2, candidates)
+	require.Equal(t, []peer.ID{candidates[2].ID, candidates[3].ID}, selected)
+
+	// Peers that did not report load come last.
+	selected = selector.Select(context.Background(), -1, candidates)
+	require.Equal(t, []peer.ID{candidates[2].ID, candidates[3].ID, candidates[0].ID, candidates[1].ID}, selected)
+}
+
+func TestSelection_LeastLoaded_Capacity(t *testing.T) {
+
+	candidates := generateCandidates(t, 4)
+	candidates[0].Load = &bls.NodeLoad{ActiveExecutions: 4, Capacity: 10}
+	candidates[1].Load = &bls.NodeLoad{ActiveExecutions: 2, Capacity: 2}
+	candidates[2].Load = &bls.NodeLoad{ActiveExecutions: 4, Capacity: 10, FunctionInstalled: true}
+	candidates[3].Load = &bls.NodeLoad{ActiveExecutions: 4, Capacity: 10, FunctionInstalled: true, CPUUsage: 80}
+
+	selector := selection.NewLeastLoaded()
+
+	// Utilization takes precedence, then function installation, then CPU usage.
+	selected := selector.Select(context.Background(), -1, candidates)
+	require.Equal(t, []peer.ID{candidates[2].ID, candidates[3].ID, candidates[0].ID, candidates[1].ID}, selected)
+}
+
+func TestSelection_LowestLatency(t *testing.T) {
+
+	candidates := generateCandidates(t, 4)
+	rtt := map[peer.ID]time.Duration{
+		candidates[0].ID: 300 * time.Millisecond,
+		candidates[1].ID: 10 * time.Millisecond,
+		candidates[3].ID: 50 * time.Millisecond,
+	}
+
+	pinger := pingerFunc(func(_ context.Context, id peer.ID) (time.Duration, error) {
+		d, ok := rtt[id]
+		if !ok {
+			return 0, errors.New("peer unreachable")
+		}
+		return d, nil
+	})
+
+	selector := selection.NewLowestLatency(pinger, time.Second)
+
+	selected := selector.Select(context.Background(), 2, candidates)
+	require.Equal(t, []peer.ID{candidates[1].ID, candidates[3].ID}, selected)
+
+	// Unreachable peers come last.
+	selected = selector.Select(context.Background(), -1, candidates)
+	require.Equal(t, []peer.ID{candidates[1].ID, candidates[3].ID, candidates[0].ID, candidates[2].ID}, selected)
+}
+
+func TestSelection_ReputationWeighted(t *testing.T) {
+
+	candidates := generateCandidates(t, 5)
+
+	t.Run("selects unique peers", func(t *testing.T) {
+
+		selector := selection.NewReputationWeighted(scoreFunc(func(peer.ID) float64 { return 1 }))
+
+		selected := selector.Select(context.Background(), 3, candidates)
+		require.Len(t, selected, 3)
+		requireUnique(t, selected)
+		require.Subset(t, ids(candidates), selected)
+
+		selected = selector.Select(context.Background(), -1, candidates)
+		require.ElementsMatch(t, ids(candidates), selected)
+	})
+	t.Run("prefers peers with higher score", func(t *testing.T) {
+
+		preferred := candidates[3].ID
+		selector := selection.NewReputationWeighted(scoreFunc(func(id peer.ID) float64 {
+			if id == preferred {
+				return 1_000
+			}
+			return 0
+		}))
+
+		const rounds = 100
+
+		hits := 0
+		for range rounds {
+			selected := selector.Select(context.Background(), 1, candidates)
+			require.Len(t, selected, 1)
+			if selected[0] == preferred {
+				hits++
+			}
+		}
+
+		require.Greater(t, hits, rounds*9/10)
+	})
+}
+
+type pingerFunc func(context.Context, peer.ID) (time.Duration, error)
+
+func (f pingerFunc) Ping(ctx context.Context, id peer.ID) (time.Duration, error) {
+	return f(ctx, id)
+}
+
+type scoreFunc func(peer.ID) float64
+
+func (f scoreFunc) Score(id peer.ID) float64 {
+	return f(id)
+}
+
+func generateCandidates(t *testing.T, n int) []selection.Candidate {
+	t.Helper()
+
+	candidates := make([]selection.Candidate, 0, n)
+	for i := range n {
+		candidates = append(candidates, selection.Candidate{
+			ID: peer.ID(fmt.Sprintf("peer-%d", i)),
+		})
+	}
+
+	return candidates
+}
+
+func ids(candidates []selection.Candidate) []peer.ID {
+	out := make([]peer.ID, 0, len(candidates))
+	for _, c := range candidates {
+		out = append(out, c.ID)
+	}
+	return out
+}
+
+func requireUnique(t *testing.T, ids []peer.ID) {
+	t.Helper()
+
+	seen := make(map[peer.ID]struct{}, len(ids))
+	for _, id := range ids {
+		_, ok := seen[id]
+		require.False(t, ok, "peer selected more than once")
+		seen[id] = struct{}{}
+	}
+}
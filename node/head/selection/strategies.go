@@ -0,0 +1,211 @@
+package selection
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blessnetwork/b7s/models/bls"
+)
+
+// Pinger measures round trip time to a peer.
+type Pinger interface {
+	Ping(ctx context.Context, id peer.ID) (time.Duration, error)
+}
+
+// ScoreSource provides scores for peers. Higher score means a more desirable peer.
+type ScoreSource interface {
+	Score(id peer.ID) float64
+}
+
+// NewFirstCome returns a selector that chooses peers in the order in which they responded.
+func NewFirstCome() PeerSelector {
+	return firstCome{}
+}
+
+type firstCome struct{}
+
+func (firstCome) Select(_ context.Context, n int, candidates []Candidate) []peer.ID {
+	return peerIDs(candidates[:limit(n, candidates)])
+}
+
+// NewRandom returns a selector that chooses peers at random.
+func NewRandom() PeerSelector {
+	return random{}
+}
+
+type random struct{}
+
+func (random) Select(_ context.Context, n int, candidates []Candidate) []peer.ID {
+
+	shuffled := slices.Clone(candidates)
+	rand.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	return peerIDs(shuffled[:limit(n, shuffled)])
+}
+
+// NewLeastLoaded returns a selector that prefers peers with the lowest utilization - active executions relative to their capacity.
+// Among equally loaded peers, those that already have the function installed and those with lower CPU usage are preferred.
+// Peers that did not report their load are considered the most loaded.
+func NewLeastLoaded() PeerSelector {
+	return leastLoaded{}
+}
+
+type leastLoaded struct{}
+
+func (leastLoaded) Select(_ context.Context, n int, candidates []Candidate) []peer.ID {
+
+	sorted := slices.Clone(candidates)
+	slices.SortStableFunc(sorted, func(a, b Candidate) int {
+		return compareLoad(a.Load, b.Load)
+	})
+
+	return peerIDs(sorted[:limit(n, sorted)])
+}
+
+func compareLoad(a, b *bls.NodeLoad) int {
+
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+
+	// Utilization can only be compared if both peers reported their capacity.
+	var c int
+	if a.Capacity > 0 && b.Capacity > 0 {
+		c = cmp.Compare(a.Utilization(), b.Utilization())
+	} else {
+		c = cmp.Compare(a.ActiveExecutions, b.ActiveExecutions)
+	}
+
+	return cmp.Or(
+		c,
+		cmp.Compare(installedRank(a), installedRank(b)),
+		cmp.Compare(a.CPUUsage, b.CPUUsage),
+	)
+}
+
+func installedRank(l *bls.NodeLoad) int {
+	if l.FunctionInstalled {
+		return 0
+	}
+	return 1
+}
+
+// NewLowestLatency returns a selector that prefers peers with the lowest round trip time.
+// Peers that could not be reached within the timeout are considered the slowest.
+func NewLowestLatency(pinger Pinger, timeout time.Duration) PeerSelector {
+	return &lowestLatency{
+		pinger:  pinger,
+		timeout: timeout,
+	}
+}
+
+type lowestLatency struct {
+	pinger  Pinger
+	timeout time.Duration
+}
+
+func (l *lowestLatency) Select(ctx context.Context, n int, candidates []Candidate) []peer.ID {
+
+	ctx, cancel := context.WithTimeout(ctx, l.timeout)
+	defer cancel()
+
+	var (
+		lock sync.Mutex
+		wg   sync.WaitGroup
+		rtt  = make(map[peer.ID]time.Duration, len(candidates))
+	)
+
+	wg.Add(len(candidates))
+	for _, c := range candidates {
+		go func(id peer.ID) {
+			defer wg.Done()
+
+			d, err := l.pinger.Ping(ctx, id)
+			if err != nil {
+				d = time.Duration(math.MaxInt64)
+			}
+
+			lock.Lock()
+			defer lock.Unlock()
+			rtt[id] = d
+		}(c.ID)
+	}
+
+	wg.Wait()
+
+	sorted := slices.Clone(candidates)
+	slices.SortStableFunc(sorted, func(a, b Candidate) int {
+		return cmp.Compare(rtt[a.ID], rtt[b.ID])
+	})
+
+	return peerIDs(sorted[:limit(n, sorted)])
+}
+
+// NewReputationWeighted returns a selector that chooses peers at random, with the probability of choosing a peer proportional to its score.
+func NewReputationWeighted(scores ScoreSource) PeerSelector {
+	return &reputationWeighted{
+		scores: scores,
+	}
+}
+
+type reputationWeighted struct {
+	scores ScoreSource
+}
+
+// Minimum weight of a peer, so that peers with no (or negative) score still have a chance of being selected.
+const minimumWeight = 0.01
+
+func (r *reputationWeighted) Select(_ context.Context, n int, candidates []Candidate) []peer.ID {
+
+	type weighted struct {
+		id     peer.ID
+		weight float64
+	}
+
+	pool := make([]weighted, 0, len(candidates))
+	for _, c := range candidates {
+		pool = append(pool, weighted{
+			id:     c.ID,
+			weight: max(r.scores.Score(c.ID), minimumWeight),
+		})
+	}
+
+	// Weighted sampling without replacement.
+	count := limit(n, candidates)
+	selected := make([]peer.ID, 0, count)
+	for len(selected) < count {
+
+		var total float64
+		for _, p := range pool {
+			total += p.weight
+		}
+
+		target := rand.Float64() * total
+		i := 0
+		for ; i < len(pool)-1; i++ {
+			target -= pool[i].weight
+			if target < 0 {
+				break
+			}
+		}
+
+		selected = append(selected, pool[i].id)
+		pool = slices.Delete(pool, i, i+1)
+	}
+
+	return selected
+}
@@ -12,6 +12,7 @@ const (
 var (
 	rollCallsPublishedMetric = []string{"node", "rollcalls", "published"}
 	executionsMetric         = []string{"node", "function", "executions"}
+	rejectedExecutionsMetric = []string{"node", "function", "executions", "rejected"}
 )
 
 var Counters = []prometheus.CounterDefinition{
@@ -23,4 +24,8 @@ var Counters = []prometheus.CounterDefinition{
 		Name: executionsMetric,
 		Help: "Number of function executions.",
 	},
+	{
+		Name: rejectedExecutionsMetric,
+		Help: "Number of function executions rejected for being over the limit.",
+	},
 }
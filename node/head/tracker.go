@@ -0,0 +1,251 @@
+package head
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blessnetwork/b7s/models/bls"
+)
+
+// executionTracker keeps track of the executions that are currently in progress - their phase and the events that happened so far.
+// Once an execution is done, its result can be found in the node store.
+type executionTracker struct {
+	sync.RWMutex
+
+	m map[string]*trackedExecution
+}
+
+type trackedExecution struct {
+	phase       bls.ExecutionPhase
+	client      string // REST API client that submitted the request.
+	events      []bls.ExecutionEvent
+	subscribers map[chan bls.ExecutionEvent]struct{}
+
+	peers     []peer.ID               // Workers chosen to execute the request.
+	cluster   string                  // Cluster executing the request, when it is shared by multiple requests.
+	cancel    context.CancelCauseFunc // Function aborting the execution.
+	cancelled bool                    // Set if the execution was cancelled before it could be aborted.
+}
+
+func newExecutionTracker() *executionTracker {
+
+	t := executionTracker{
+		m: make(map[string]*trackedExecution),
+	}
+
+	return &t
+}
+
+// start records that the execution of the request submitted by the client started.
+func (t *executionTracker) start(requestID string, client string) {
+	t.Lock()
+	defer t.Unlock()
+
+	execution := t.execution(requestID)
+	execution.phase = bls.ExecutionPhaseRollCall
+	execution.client = client
+}
+
+// set records the current phase of the execution.
+func (t *executionTracker) set(requestID string, phase bls.ExecutionPhase) {
+	t.Lock()
+	defer t.Unlock()
+
+	t.execution(requestID).phase = phase
+}
+
+// setCancelFunc records the function that aborts the execution. If the execution was already cancelled, it is aborted right away.
+func (t *executionTracker) setCancelFunc(requestID string, cancel context.CancelCauseFunc) {
+	t.Lock()
+	defer t.Unlock()
+
+	execution := t.execution(requestID)
+	execution.cancel = cancel
+
+	if execution.cancelled {
+		cancel(bls.ErrExecutionCancelled)
+	}
+}
+
+// addPeers records the workers chosen to execute the request.
+func (t *executionTracker) addPeers(requestID string, peers ...peer.ID) {
+	t.Lock()
+	defer t.Unlock()
+
+	execution := t.execution(requestID)
+	for _, id := range peers {
+		if !slices.Contains(execution.peers, id) {
+			execution.peers = append(execution.peers, id)
+		}
+	}
+}
+
+// peers returns the workers chosen to execute the request, if the execution is in progress.
+func (t *executionTracker) peers(requestID string) ([]peer.ID, bool) {
+	t.RLock()
+	defer t.RUnlock()
+
+	execution, ok := t.m[requestID]
+	if !ok {
+		return nil, false
+	}
+
+	return slices.Clone(execution.peers), true
+}
+
+// setCluster records the cluster executing the request, when it is shared by multiple requests.
+func (t *executionTracker) setCluster(requestID string, clusterID string) {
+	t.Lock()
+	defer t.Unlock()
+
+	t.execution(requestID).cluster = clusterID
+}
+
+// sharedCluster returns the shared cluster executing the request, and whether the request is the last one in progress on it.
+func (t *executionTracker) sharedCluster(requestID string) (string, bool) {
+	t.RLock()
+	defer t.RUnlock()
+
+	execution, ok := t.m[requestID]
+	if !ok || execution.cluster == "" {
+		return "", false
+	}
+
+	for id, other := range t.m {
+		if id != requestID && other.cluster == execution.cluster && !other.cancelled {
+			return execution.cluster, false
+		}
+	}
+
+	return execution.cluster, true
+}
+
+// cancel aborts the execution, if it is in progress.
+func (t *executionTracker) cancel(requestID string) bool {
+	t.Lock()
+	defer t.Unlock()
+
+	execution, ok := t.m[requestID]
+	if !ok {
+		return false
+	}
+
+	execution.cancelled = true
+	if execution.cancel != nil {
+		execution.cancel(bls.ErrExecutionCancelled)
+	}
+
+	return true
+}
+
+// execution returns the tracked execution, creating it if it does not exist. Caller should hold the lock.
+func (t *executionTracker) execution(requestID string) *trackedExecution {
+
+	execution, ok := t.m[requestID]
+	if !ok {
+		execution = &trackedExecution{
+			subscribers: make(map[chan bls.ExecutionEvent]struct{}),
+		}
+		t.m[requestID] = execution
+	}
+
+	return execution
+}
+
+// get returns the current phase of the execution and the client that submitted the request, if the execution is in progress.
+func (t *executionTracker) get(requestID string) (bls.ExecutionPhase, string, bool) {
+	t.RLock()
+	defer t.RUnlock()
+
+	execution, ok := t.m[requestID]
+	if !ok {
+		return "", "", false
+	}
+
+	return execution.phase, execution.client, true
+}
+
+// publish records the event and sends it to all subscribers of the execution.
+// Events for executions that are not tracked are dropped.
+func (t *executionTracker) publish(event bls.ExecutionEvent) {
+	t.Lock()
+	defer t.Unlock()
+
+	execution, ok := t.m[event.RequestID]
+	if !ok {
+		return
+	}
+
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now().UTC()
+	}
+
+	execution.events = append(execution.events, event)
+
+	for ch := range execution.subscribers {
+		select {
+		case ch <- event:
+		default:
+			// Subscriber is not keeping up - drop it instead of blocking the execution.
+			delete(execution.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// subscribe returns a channel on which events for the execution will be delivered, starting with events that already happened.
+// The channel is closed once the execution is done. Returned function should be used to unsubscribe before that.
+func (t *executionTracker) subscribe(requestID string) (<-chan bls.ExecutionEvent, func(), bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	execution, ok := t.m[requestID]
+	if !ok {
+		return nil, nil, false
+	}
+
+	ch := make(chan bls.ExecutionEvent, len(execution.events)+executionEventBufferSize)
+	for _, event := range execution.events {
+		ch <- event
+	}
+
+	execution.subscribers[ch] = struct{}{}
+
+	unsubscribe := func() {
+		t.Lock()
+		defer t.Unlock()
+
+		// Channel may have been closed already, if the execution is done.
+		_, ok := execution.subscribers[ch]
+		if !ok {
+			return
+		}
+
+		delete(execution.subscribers, ch)
+		close(ch)
+	}
+
+	return ch, unsubscribe, true
+}
+
+// remove stops tracking the execution.
+func (t *executionTracker) remove(requestID string) {
+	t.Lock()
+	defer t.Unlock()
+
+	execution, ok := t.m[requestID]
+	if !ok {
+		return
+	}
+
+	for ch := range execution.subscribers {
+		delete(execution.subscribers, ch)
+		close(ch)
+	}
+
+	delete(t.m, requestID)
+}
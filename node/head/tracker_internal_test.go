@@ -0,0 +1,113 @@
+package head
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestExecutionTracker(t *testing.T) {
+
+	const requestID = "dummy-request-id"
+
+	t.Run("untracked execution", func(t *testing.T) {
+
+		tracker := newExecutionTracker()
+
+		_, _, ok := tracker.get(requestID)
+		require.False(t, ok)
+
+		_, _, ok = tracker.subscribe(requestID)
+		require.False(t, ok)
+
+		// Should not panic.
+		tracker.publish(bls.ExecutionEvent{RequestID: requestID, Type: bls.ExecutionEventRollCallStarted})
+	})
+	t.Run("subscriber receives past and future events", func(t *testing.T) {
+
+		tracker := newExecutionTracker()
+		tracker.start(requestID, mocks.GenericAPIClient.ID)
+
+		tracker.publish(bls.ExecutionEvent{RequestID: requestID, Type: bls.ExecutionEventRollCallStarted})
+		tracker.publish(bls.ExecutionEvent{RequestID: requestID, Type: bls.ExecutionEventPeerReported, Peer: mocks.GenericPeerID})
+
+		events, _, ok := tracker.subscribe(requestID)
+		require.True(t, ok)
+
+		tracker.set(requestID, bls.ExecutionPhaseClusterFormed)
+		tracker.publish(bls.ExecutionEvent{RequestID: requestID, Type: bls.ExecutionEventClusterFormed})
+
+		phase, client, ok := tracker.get(requestID)
+		require.True(t, ok)
+		require.Equal(t, bls.ExecutionPhaseClusterFormed, phase)
+		require.Equal(t, mocks.GenericAPIClient.ID, client)
+
+		// Execution is done - channel should be closed.
+		tracker.remove(requestID)
+
+		var received []bls.ExecutionEventType
+		for event := range events {
+			require.Equal(t, requestID, event.RequestID)
+			require.False(t, event.Timestamp.IsZero())
+
+			received = append(received, event.Type)
+		}
+
+		expected := []bls.ExecutionEventType{
+			bls.ExecutionEventRollCallStarted,
+			bls.ExecutionEventPeerReported,
+			bls.ExecutionEventClusterFormed,
+		}
+		require.Equal(t, expected, received)
+
+		_, _, ok = tracker.get(requestID)
+		require.False(t, ok)
+	})
+	t.Run("unsubscribe", func(t *testing.T) {
+
+		tracker := newExecutionTracker()
+		tracker.set(requestID, bls.ExecutionPhaseRollCall)
+
+		events, unsubscribe, ok := tracker.subscribe(requestID)
+		require.True(t, ok)
+
+		unsubscribe()
+
+		_, open := <-events
+		require.False(t, open)
+
+		// Unsubscribing after the execution is done should not panic.
+		tracker.remove(requestID)
+		unsubscribe()
+	})
+	t.Run("shared cluster", func(t *testing.T) {
+
+		const (
+			clusterID = "dummy-cluster-id"
+			otherID   = "other-request-id"
+		)
+
+		tracker := newExecutionTracker()
+		tracker.set(requestID, bls.ExecutionPhaseClusterFormed)
+
+		// Request has a cluster of its own.
+		_, last := tracker.sharedCluster(requestID)
+		require.False(t, last)
+
+		tracker.setCluster(requestID, clusterID)
+		tracker.setCluster(otherID, clusterID)
+
+		// Other request still uses the cluster.
+		cluster, last := tracker.sharedCluster(requestID)
+		require.Equal(t, clusterID, cluster)
+		require.False(t, last)
+
+		require.True(t, tracker.cancel(otherID))
+
+		_, last = tracker.sharedCluster(requestID)
+		require.True(t, last)
+	})
+}
@@ -0,0 +1,70 @@
+package head
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/request"
+	"github.com/blessnetwork/b7s/models/response"
+)
+
+// PublishFunctionUninstall asks the workers in the subgroup to remove the function, and returns the confirmations they sent.
+// Confirmations are collected until the timeout expires, so workers that are slow to respond are not listed.
+func (h *HeadNode) PublishFunctionUninstall(ctx context.Context, cid string, subgroup string) ([]bls.FunctionUninstallResult, error) {
+
+	if cid == "" {
+		return nil, errors.New("function CID is required")
+	}
+
+	req := request.UninstallFunction{
+		RequestID: newRequestID(),
+		CID:       cid,
+	}
+
+	if subgroup == "" {
+		subgroup = bls.DefaultTopic
+	}
+
+	h.Log().Debug().Str("subgroup", subgroup).Str("request", req.RequestID).Str("cid", cid).Msg("publishing function uninstall message")
+
+	confirmations, err := publishAndCollect(ctx, h, h.uninstalls, req.RequestID, subgroup, &req, functionUninstallTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("could not collect uninstall confirmations: %w", err)
+	}
+
+	out := make([]bls.FunctionUninstallResult, 0, len(confirmations))
+	for id, res := range confirmations {
+		out = append(out, bls.FunctionUninstallResult{
+			Peer:    id,
+			Code:    res.Code,
+			Message: res.Message,
+		})
+	}
+
+	slices.SortFunc(out, func(a, b bls.FunctionUninstallResult) int {
+		return strings.Compare(a.Peer.String(), b.Peer.String())
+	})
+
+	return out, nil
+}
+
+func (h *HeadNode) processUninstallFunctionResponse(ctx context.Context, from peer.ID, res response.UninstallFunction) error {
+
+	log := h.Log().With().Stringer("from", from).Str("request", res.RequestID).Str("cid", res.CID).Logger()
+
+	ok := h.uninstalls.add(res.RequestID, from, res)
+	if !ok {
+		log.Debug().Msg("ignoring function uninstall response - not collecting responses for request")
+		return nil
+	}
+
+	log.Debug().Stringer("code", res.Code).Msg("received function uninstall response")
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package head
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/request"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_PublishFunctionUninstall(t *testing.T) {
+
+	var (
+		core = mocks.BaselineNodeCore(t)
+		ctx  = context.Background()
+		cid  = mocks.GenericFunctionRecord.CID
+	)
+
+	head, err := New(core, mocks.BaselineStore(t))
+	require.NoError(t, err)
+
+	const subgroup = "dummy-topic"
+
+	var topic string
+	core.PublishToTopicFunc = func(_ context.Context, s string, msg bls.Message) error {
+		topic = s
+
+		req := msg.(*request.UninstallFunction)
+		require.Equal(t, cid, req.CID)
+
+		// Workers respond in reverse order; results are sorted by peer ID.
+		err := head.processUninstallFunctionResponse(ctx, mocks.GenericPeerIDs[1], *req.Response(codes.NotFound, "function not installed"))
+		require.NoError(t, err)
+		err = head.processUninstallFunctionResponse(ctx, mocks.GenericPeerIDs[0], *req.Response(codes.OK, "uninstalled"))
+		require.NoError(t, err)
+
+		return nil
+	}
+
+	results, err := head.PublishFunctionUninstall(ctx, cid, subgroup)
+	require.NoError(t, err)
+	require.Equal(t, subgroup, topic)
+
+	require.Len(t, results, 2)
+	byPeer := make(map[string]codes.Code)
+	for _, res := range results {
+		byPeer[res.Peer.String()] = res.Code
+	}
+	require.Equal(t, codes.OK, byPeer[mocks.GenericPeerIDs[0].String()])
+	require.Equal(t, codes.NotFound, byPeer[mocks.GenericPeerIDs[1].String()])
+	require.Less(t, results[0].Peer.String(), results[1].Peer.String())
+
+	// Confirmations arriving after collection finished are ignored.
+	late := request.UninstallFunction{RequestID: "late", CID: cid}
+	err = head.processUninstallFunctionResponse(ctx, mocks.GenericPeerIDs[2], *late.Response(codes.OK, "uninstalled"))
+	require.NoError(t, err)
+	require.Empty(t, head.uninstalls.m)
+
+	_, err = head.PublishFunctionUninstall(ctx, "", subgroup)
+	require.Error(t, err)
+}
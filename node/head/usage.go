@@ -0,0 +1,59 @@
+package head
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/execute"
+)
+
+// UsageEntries returns the entries from the usage ledger that pass the filter, ordered by timestamp.
+func (h *HeadNode) UsageEntries(ctx context.Context, filter bls.UsageFilter) ([]execute.UsageEntry, error) {
+
+	entries, err := h.store.RetrieveUsageEntries(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve usage entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+// recordUsage adds the resource usage reported by workers to the usage ledger, and charges it to the client.
+// Only entries signed by the worker that executed the request are recorded.
+func (h *HeadNode) recordUsage(ctx context.Context, requestID string, req execute.Request, results execute.ResultMap) {
+
+	recorded := make([]execute.UsageEntry, 0, len(results))
+	for peer, res := range results {
+
+		entry := res.UsageEntry
+		if entry == nil {
+			continue
+		}
+
+		log := h.Log().With().Str("request", requestID).Stringer("peer", peer).Logger()
+
+		if entry.Worker != peer || entry.RequestID != requestID || entry.FunctionID != req.FunctionID || entry.Client != req.Client {
+			log.Warn().Msg("usage entry does not match the execution, skipping")
+			continue
+		}
+
+		err := entry.VerifySignature()
+		if err != nil {
+			log.Warn().Err(err).Msg("could not verify signature of the usage entry, skipping")
+			h.reputation.RecordSignatureFailure(ctx, peer)
+			continue
+		}
+
+		err = h.store.SaveUsageEntry(ctx, *entry)
+		if err != nil {
+			log.Error().Err(err).Msg("could not save usage entry")
+		}
+
+		recorded = append(recorded, *entry)
+	}
+
+	if req.Client != "" && len(recorded) > 0 {
+		h.limits.charge(req.Client, recorded)
+	}
+}
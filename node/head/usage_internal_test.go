@@ -0,0 +1,165 @@
+package head
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_RecordUsage(t *testing.T) {
+
+	key, _, err := crypto.GenerateEd25519Key(nil)
+	require.NoError(t, err)
+
+	worker, err := peer.IDFromPrivateKey(key)
+	require.NoError(t, err)
+
+	var (
+		requestID = mocks.GenericUUID.String()
+		req       = mocks.GenericExecutionRequest
+	)
+	req.Client = "dummy-client"
+
+	signedEntry := func(t *testing.T) *execute.UsageEntry {
+		t.Helper()
+
+		entry := execute.NewUsageEntry(requestID, req, worker, mocks.GenericUsageEntry.Usage)
+		require.NoError(t, entry.Sign(key))
+
+		return &entry
+	}
+
+	// Create a head node that records saved usage entries and reputation updates.
+	newHead := func(t *testing.T) (*HeadNode, *[]execute.UsageEntry, *[]bls.PeerReputation) {
+		t.Helper()
+
+		var (
+			saved       []execute.UsageEntry
+			reputations []bls.PeerReputation
+		)
+
+		store := mocks.BaselineStore(t)
+		store.SaveUsageEntryFunc = func(_ context.Context, entry execute.UsageEntry) error {
+			saved = append(saved, entry)
+			return nil
+		}
+		store.RetrievePeerReputationFunc = func(context.Context, peer.ID) (bls.PeerReputation, error) {
+			return bls.PeerReputation{}, bls.ErrNotFound
+		}
+		store.SavePeerReputationFunc = func(_ context.Context, rep bls.PeerReputation) error {
+			reputations = append(reputations, rep)
+			return nil
+		}
+
+		head, err := New(mocks.BaselineNodeCore(t), store)
+		require.NoError(t, err)
+
+		return head, &saved, &reputations
+	}
+
+	t.Run("signed entry is recorded", func(t *testing.T) {
+
+		head, saved, _ := newHead(t)
+
+		entry := signedEntry(t)
+		results := execute.ResultMap{
+			worker:                  {UsageEntry: entry},
+			mocks.GenericPeerIDs[0]: {}, // No usage reported.
+		}
+
+		head.recordUsage(context.Background(), requestID, req, results)
+		require.Equal(t, []execute.UsageEntry{*entry}, *saved)
+	})
+	t.Run("entry for a different execution is skipped", func(t *testing.T) {
+
+		head, saved, _ := newHead(t)
+
+		other := req
+		other.FunctionID = "other-function"
+
+		head.recordUsage(context.Background(), requestID, other, execute.ResultMap{worker: {UsageEntry: signedEntry(t)}})
+		head.recordUsage(context.Background(), "other-request", req, execute.ResultMap{worker: {UsageEntry: signedEntry(t)}})
+		// Entry reported by a peer other than the worker that signed it.
+		head.recordUsage(context.Background(), requestID, req, execute.ResultMap{mocks.GenericPeerID: {UsageEntry: signedEntry(t)}})
+
+		require.Empty(t, *saved)
+	})
+	t.Run("entry with invalid signature is skipped", func(t *testing.T) {
+
+		head, saved, reputations := newHead(t)
+
+		entry := signedEntry(t)
+		entry.Usage.WallClockTime += time.Second
+
+		head.recordUsage(context.Background(), requestID, req, execute.ResultMap{worker: {UsageEntry: entry}})
+		require.Empty(t, *saved)
+
+		require.Len(t, *reputations, 1)
+		require.Equal(t, worker, (*reputations)[0].Peer)
+		require.Positive(t, (*reputations)[0].SignatureFailures)
+	})
+	t.Run("only recorded entries are charged to the client", func(t *testing.T) {
+
+		store := mocks.BaselineStore(t)
+		store.RetrieveUsageEntriesFunc = func(context.Context, bls.UsageFilter) ([]execute.UsageEntry, error) {
+			return nil, nil
+		}
+
+		head, err := New(mocks.BaselineNodeCore(t), store, ClientDailyQuota(UsageQuota{CPUTime: time.Hour}))
+		require.NoError(t, err)
+
+		release, err := head.limits.acquire(context.Background(), req.Client, req.FunctionID)
+		require.NoError(t, err)
+		release()
+
+		entry := signedEntry(t)
+		tampered := signedEntry(t)
+		tampered.Usage.CPUUserTime += time.Minute
+
+		unsigned := mocks.GenericUsageEntry.Usage
+		unsigned.CPUUserTime = time.Hour
+
+		head.recordUsage(context.Background(), requestID, req, execute.ResultMap{
+			worker:                  {UsageEntry: entry},
+			mocks.GenericPeerIDs[0]: {Result: execute.Result{Usage: unsigned}},
+		})
+		head.recordUsage(context.Background(), requestID, req, execute.ResultMap{worker: {UsageEntry: tampered}})
+
+		require.Equal(t, entry.Usage, head.limits.usage[req.Client].usage)
+	})
+}
+
+func TestHead_UsageEntries(t *testing.T) {
+
+	entries := []execute.UsageEntry{mocks.GenericUsageEntry, mocks.GenericUsageEntry}
+
+	var passed bls.UsageFilter
+	store := mocks.BaselineStore(t)
+	store.RetrieveUsageEntriesFunc = func(_ context.Context, filter bls.UsageFilter) ([]execute.UsageEntry, error) {
+		passed = filter
+		return entries, nil
+	}
+
+	head, err := New(mocks.BaselineNodeCore(t), store)
+	require.NoError(t, err)
+
+	filter := bls.UsageFilter{
+		From:   mocks.GenericUsageEntry.Timestamp,
+		To:     mocks.GenericUsageEntry.Timestamp.Add(time.Hour),
+		Client: mocks.GenericUsageEntry.Client,
+	}
+
+	retrieved, err := head.UsageEntries(context.Background(), filter)
+	require.NoError(t, err)
+
+	require.Equal(t, filter, passed)
+	require.Equal(t, entries, retrieved)
+}
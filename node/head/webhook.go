@@ -0,0 +1,273 @@
+package head
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"github.com/blessnetwork/b7s/consensus/pbft"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/node/aggregate"
+)
+
+// webhookPayload is the body of the webhook request. It has the same format as the execution response returned by the REST API.
+type webhookPayload struct {
+	Code        string                  `json:"code,omitempty"`
+	RequestID   string                  `json:"request_id,omitempty"`
+	Message     string                  `json:"message,omitempty"`
+	Results     aggregate.Results       `json:"results,omitempty"`
+	Aggregation *aggregate.Aggregation  `json:"aggregation,omitempty"`
+	Cluster     execute.Cluster         `json:"cluster,omitempty"`
+	Receipts    []execute.Receipt       `json:"receipts,omitempty"`
+	Certificate *pbft.ResultCertificate `json:"certificate,omitempty"`
+}
+
+var (
+	// errWebhookRejected is returned when the webhook responded with a status code that makes retrying pointless.
+	errWebhookRejected = errors.New("webhook rejected the delivery")
+	// errWebhookAddressNotAllowed is returned when the webhook host resolves to an address webhooks may not use.
+	errWebhookAddressNotAllowed = fmt.Errorf("%w: address not allowed", errWebhookRejected)
+)
+
+// startWebhookDelivery records a pending webhook delivery and starts delivering the execution result in the background.
+// Delivery outlives the request that started it, but not the node.
+func (h *HeadNode) startWebhookDelivery(ctx context.Context, record bls.ExecutionRecord, webhook bls.Webhook) {
+
+	delivery, err := newWebhookDelivery(record, webhook)
+	if err != nil {
+		h.Log().Error().Err(err).Str("request", record.RequestID).Msg("could not create webhook delivery")
+		return
+	}
+
+	ctx, cancel := h.background(ctx)
+
+	h.saveWebhookDelivery(ctx, delivery)
+
+	go func() {
+		defer cancel()
+		h.deliverWebhook(ctx, delivery)
+	}()
+}
+
+// newWebhookDelivery prepares the delivery of the execution result to the webhook.
+func newWebhookDelivery(record bls.ExecutionRecord, webhook bls.Webhook) (bls.WebhookDelivery, error) {
+
+	payload, err := json.Marshal(newWebhookPayload(record))
+	if err != nil {
+		return bls.WebhookDelivery{}, fmt.Errorf("could not encode webhook payload: %w", err)
+	}
+
+	now := time.Now().UTC()
+	delivery := bls.WebhookDelivery{
+		RequestID: record.RequestID,
+		URL:       webhook.URL,
+		Payload:   payload,
+		Status:    bls.WebhookDeliveryPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if webhook.Secret != "" {
+		delivery.HMAC = bls.WebhookHMAC(webhook.Secret, payload)
+	}
+
+	return delivery, nil
+}
+
+// resumeWebhookDeliveries restarts deliveries that were still pending when the node was shut down.
+func (h *HeadNode) resumeWebhookDeliveries(ctx context.Context) {
+
+	deliveries, err := h.store.RetrieveWebhookDeliveries(ctx)
+	if err != nil {
+		h.Log().Error().Err(err).Msg("could not retrieve webhook deliveries")
+		return
+	}
+
+	for _, delivery := range deliveries {
+
+		if delivery.Status != bls.WebhookDeliveryPending {
+			continue
+		}
+
+		h.Log().Debug().Str("request", delivery.RequestID).Uint("attempts", delivery.Attempts).Msg("resuming webhook delivery")
+
+		go h.deliverWebhook(ctx, delivery)
+	}
+}
+
+// deliverWebhook sends the execution result to the webhook, retrying with exponential backoff.
+func (h *HeadNode) deliverWebhook(ctx context.Context, delivery bls.WebhookDelivery) {
+
+	log := h.Log().With().Str("request", delivery.RequestID).Str("url", delivery.URL).Logger()
+
+	backoff := h.cfg.WebhookBackoff
+	for delivery.Attempts < h.cfg.WebhookAttempts {
+
+		if delivery.Attempts > 0 {
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				// Delivery stays pending and will be resumed on restart.
+				return
+			}
+
+			backoff = min(2*backoff, webhookMaxBackoff)
+		}
+
+		delivery.Attempts++
+
+		code, err := h.postWebhook(ctx, delivery)
+		if ctx.Err() != nil {
+			// Node is shutting down - this attempt does not count.
+			return
+		}
+
+		delivery.StatusCode = code
+		delivery.UpdatedAt = time.Now().UTC()
+
+		if err == nil {
+			delivery.Status = bls.WebhookDeliveryDelivered
+			delivery.Error = ""
+			h.saveWebhookDelivery(ctx, delivery)
+
+			log.Debug().Uint("attempts", delivery.Attempts).Msg("webhook delivered")
+			return
+		}
+
+		log.Warn().Err(err).Uint("attempt", delivery.Attempts).Msg("webhook delivery failed")
+
+		delivery.Error = err.Error()
+		if errors.Is(err, errWebhookRejected) {
+			break
+		}
+
+		h.saveWebhookDelivery(ctx, delivery)
+	}
+
+	delivery.Status = bls.WebhookDeliveryFailed
+	h.saveWebhookDelivery(ctx, delivery)
+
+	log.Error().Uint("attempts", delivery.Attempts).Msg("giving up on webhook delivery")
+}
+
+// postWebhook makes a single webhook delivery attempt. It returns the HTTP status code of the response, if any.
+func (h *HeadNode) postWebhook(ctx context.Context, delivery bls.WebhookDelivery) (int, error) {
+
+	signature, err := bls.SignWebhookPayload(h.Host().PrivateKey(), delivery.Payload)
+	if err != nil {
+		return 0, fmt.Errorf("could not sign payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("could not create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", webhookUserAgent)
+	req.Header.Set(bls.WebhookHeaderRequestID, delivery.RequestID)
+	req.Header.Set(bls.WebhookHeaderNodeID, h.ID())
+	req.Header.Set(bls.WebhookHeaderSignature, signature)
+
+	if delivery.HMAC != "" {
+		req.Header.Set(bls.WebhookHeaderHMAC, delivery.HMAC)
+	}
+
+	res, err := h.webhooks.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("could not send request: %w", err)
+	}
+	defer res.Body.Close()
+
+	// Drain the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	switch {
+	case res.StatusCode >= 200 && res.StatusCode < 300:
+		return res.StatusCode, nil
+
+	// Server errors and rate limiting are worth retrying, other client errors are not.
+	case res.StatusCode >= 500, res.StatusCode == http.StatusTooManyRequests, res.StatusCode == http.StatusRequestTimeout:
+		return res.StatusCode, fmt.Errorf("unexpected status code: %v", res.StatusCode)
+
+	default:
+		return res.StatusCode, fmt.Errorf("%w (status code: %v)", errWebhookRejected, res.StatusCode)
+	}
+}
+
+func (h *HeadNode) saveWebhookDelivery(ctx context.Context, delivery bls.WebhookDelivery) {
+	err := h.store.SaveWebhookDelivery(ctx, delivery)
+	if err != nil {
+		h.Log().Error().Err(err).Str("request", delivery.RequestID).Msg("could not save webhook delivery")
+	}
+}
+
+func newWebhookPayload(record bls.ExecutionRecord) webhookPayload {
+	return webhookPayload{
+		Code:        string(record.Code),
+		RequestID:   record.RequestID,
+		Message:     record.Message,
+		Results:     record.Aggregated,
+		Aggregation: record.Aggregation,
+		Cluster:     record.Cluster,
+		Receipts:    record.Results.Receipts(),
+		Certificate: pbft.NewResultCertificate(record.RequestID, record.Cluster.Peers, record.Results),
+	}
+}
+
+// newWebhookClient creates the HTTP client used for webhook deliveries. Client refuses to connect to addresses that are not
+// public, unless they are in one of the allowed networks. Addresses are checked when dialing, so host names resolving to a
+// different address than when the webhook was accepted, as well as redirects, are covered too.
+func newWebhookClient(timeout time.Duration, allowed []netip.Prefix) *http.Client {
+
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(_ string, address string, _ syscall.RawConn) error {
+			return checkWebhookAddress(address, allowed)
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	// Proxy would be the one connecting to the webhook, bypassing the address check.
+	transport.Proxy = nil
+
+	client := http.Client{
+		Timeout:   timeout,
+		Transport: otelhttp.NewTransport(transport),
+	}
+
+	return &client
+}
+
+func checkWebhookAddress(address string, allowed []netip.Prefix) error {
+
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("could not parse address: %w", err)
+	}
+
+	addr := addrPort.Addr().Unmap()
+	if bls.PublicAddress(addr) {
+		return nil
+	}
+
+	for _, network := range allowed {
+		if network.Contains(addr) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w (address: %v)", errWebhookAddressNotAllowed, addr)
+}
@@ -0,0 +1,291 @@
+package head
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/store"
+	"github.com/blessnetwork/b7s/store/codec"
+	"github.com/blessnetwork/b7s/testing/helpers"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_WebhookPayload(t *testing.T) {
+
+	record := certifiedExecutionRecord(t)
+
+	payload := newWebhookPayload(record)
+	require.Equal(t, record.RequestID, payload.RequestID)
+	require.Equal(t, record.Results.Receipts(), payload.Receipts)
+
+	require.NotNil(t, payload.Certificate)
+	require.NoError(t, payload.Certificate.Verify(record.Cluster.Peers))
+
+	// Results not produced by a PBFT cluster have no certificate.
+	payload = newWebhookPayload(mocks.GenericExecutionRecord)
+	require.Nil(t, payload.Certificate)
+}
+
+func TestHead_WebhookDelivery(t *testing.T) {
+
+	const secret = "dummy-secret"
+
+	var (
+		ctx    = context.Background()
+		record = mocks.GenericExecutionRecord
+	)
+
+	t.Run("nominal case", func(t *testing.T) {
+
+		head, store := createWebhookTestHeadNode(t)
+
+		var (
+			body    []byte
+			headers http.Header
+		)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ = io.ReadAll(r.Body)
+			headers = r.Header.Clone()
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer srv.Close()
+
+		webhook := bls.Webhook{
+			URL:    srv.URL,
+			Secret: secret,
+		}
+
+		delivery, err := newWebhookDelivery(record, webhook)
+		require.NoError(t, err)
+
+		head.deliverWebhook(ctx, delivery)
+
+		// Verify payload.
+		var payload webhookPayload
+		require.NoError(t, json.Unmarshal(body, &payload))
+		require.Equal(t, record.RequestID, payload.RequestID)
+		require.Equal(t, record.Code.String(), payload.Code)
+		require.Equal(t, record.Cluster, payload.Cluster)
+		require.Equal(t, record.Aggregated, payload.Results)
+
+		// Verify signatures.
+		require.Equal(t, record.RequestID, headers.Get(bls.WebhookHeaderRequestID))
+
+		nodeID, err := peer.Decode(headers.Get(bls.WebhookHeaderNodeID))
+		require.NoError(t, err)
+		require.Equal(t, head.ID(), nodeID.String())
+
+		err = bls.VerifyWebhookSignature(nodeID, body, headers.Get(bls.WebhookHeaderSignature))
+		require.NoError(t, err)
+
+		err = bls.VerifyWebhookHMAC(secret, body, headers.Get(bls.WebhookHeaderHMAC))
+		require.NoError(t, err)
+
+		// Verify delivery record.
+		saved, err := store.RetrieveWebhookDelivery(ctx, record.RequestID)
+		require.NoError(t, err)
+		require.Equal(t, bls.WebhookDeliveryDelivered, saved.Status)
+		require.Equal(t, uint(1), saved.Attempts)
+		require.Equal(t, http.StatusOK, saved.StatusCode)
+
+		// Secret is not stored.
+		encoded, err := json.Marshal(saved)
+		require.NoError(t, err)
+		require.NotContains(t, string(encoded), secret)
+	})
+	t.Run("retries failed deliveries", func(t *testing.T) {
+
+		head, store := createWebhookTestHeadNode(t)
+
+		var calls atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer srv.Close()
+
+		delivery, err := newWebhookDelivery(record, bls.Webhook{URL: srv.URL})
+		require.NoError(t, err)
+
+		head.deliverWebhook(ctx, delivery)
+
+		require.Equal(t, int32(3), calls.Load())
+
+		saved, err := store.RetrieveWebhookDelivery(ctx, record.RequestID)
+		require.NoError(t, err)
+		require.Equal(t, bls.WebhookDeliveryDelivered, saved.Status)
+		require.Equal(t, uint(3), saved.Attempts)
+		require.Empty(t, saved.Error)
+	})
+	t.Run("gives up after max attempts", func(t *testing.T) {
+
+		head, store := createWebhookTestHeadNode(t)
+
+		var calls atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer srv.Close()
+
+		delivery, err := newWebhookDelivery(record, bls.Webhook{URL: srv.URL})
+		require.NoError(t, err)
+
+		head.deliverWebhook(ctx, delivery)
+
+		require.Equal(t, int32(head.cfg.WebhookAttempts), calls.Load())
+
+		saved, err := store.RetrieveWebhookDelivery(ctx, record.RequestID)
+		require.NoError(t, err)
+		require.Equal(t, bls.WebhookDeliveryFailed, saved.Status)
+		require.Equal(t, head.cfg.WebhookAttempts, saved.Attempts)
+		require.Equal(t, http.StatusInternalServerError, saved.StatusCode)
+		require.NotEmpty(t, saved.Error)
+	})
+	t.Run("does not retry rejected deliveries", func(t *testing.T) {
+
+		head, store := createWebhookTestHeadNode(t)
+
+		var calls atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer srv.Close()
+
+		delivery, err := newWebhookDelivery(record, bls.Webhook{URL: srv.URL})
+		require.NoError(t, err)
+
+		head.deliverWebhook(ctx, delivery)
+
+		require.Equal(t, int32(1), calls.Load())
+
+		saved, err := store.RetrieveWebhookDelivery(ctx, record.RequestID)
+		require.NoError(t, err)
+		require.Equal(t, bls.WebhookDeliveryFailed, saved.Status)
+		require.Equal(t, uint(1), saved.Attempts)
+	})
+	t.Run("does not deliver to non-public addresses", func(t *testing.T) {
+
+		head, store := createWebhookTestHeadNode(t)
+		head.webhooks = newWebhookClient(head.cfg.WebhookTimeout, nil)
+
+		var calls atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer srv.Close()
+
+		delivery, err := newWebhookDelivery(record, bls.Webhook{URL: srv.URL})
+		require.NoError(t, err)
+
+		head.deliverWebhook(ctx, delivery)
+
+		require.Zero(t, calls.Load())
+
+		saved, err := store.RetrieveWebhookDelivery(ctx, record.RequestID)
+		require.NoError(t, err)
+		require.Equal(t, bls.WebhookDeliveryFailed, saved.Status)
+		require.Equal(t, uint(1), saved.Attempts)
+	})
+	t.Run("delivery stays pending when node stops", func(t *testing.T) {
+
+		head, store := createWebhookTestHeadNode(t)
+		head.cfg.WebhookBackoff = time.Minute
+
+		attempted := make(chan struct{}, 1)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempted <- struct{}{}
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+
+		delivery, err := newWebhookDelivery(record, bls.Webhook{URL: srv.URL})
+		require.NoError(t, err)
+
+		head.saveWebhookDelivery(ctx, delivery)
+
+		ctx, cancel := context.WithCancel(ctx)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			head.deliverWebhook(ctx, delivery)
+		}()
+
+		// Stop the node while waiting to retry.
+		<-attempted
+		cancel()
+		<-done
+
+		saved, err := store.RetrieveWebhookDelivery(context.Background(), record.RequestID)
+		require.NoError(t, err)
+		require.Equal(t, bls.WebhookDeliveryPending, saved.Status)
+	})
+}
+
+func TestHead_CheckWebhookAddress(t *testing.T) {
+
+	allowed := []netip.Prefix{netip.MustParsePrefix("10.1.0.0/16")}
+
+	tests := []struct {
+		address string
+		allowed bool
+	}{
+		{address: "93.184.215.14:443", allowed: true},
+		{address: "[2606:2800:21f:cb07:6820:80da:af6b:8b2c]:443", allowed: true},
+		{address: "127.0.0.1:80"},
+		{address: "[::1]:80"},
+		{address: "169.254.169.254:80"},
+		{address: "192.168.1.1:80"},
+		{address: "10.2.0.1:80"},
+		{address: "[::ffff:10.2.0.1]:80"},
+		{address: "10.1.2.3:80", allowed: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.address, func(t *testing.T) {
+			err := checkWebhookAddress(test.address, allowed)
+			if test.allowed {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, errWebhookAddressNotAllowed)
+				require.ErrorIs(t, err, errWebhookRejected)
+			}
+		})
+	}
+}
+
+func createWebhookTestHeadNode(t *testing.T) (*HeadNode, *store.Store) {
+	t.Helper()
+
+	db := helpers.InMemoryDB(t)
+	t.Cleanup(func() { db.Close() })
+
+	store := store.New(db, codec.NewJSONCodec())
+
+	head, err := New(mocks.BaselineNodeCore(t), store,
+		WebhookAttempts(3),
+		WebhookBackoff(time.Millisecond),
+		// Test servers listen on the loopback interface.
+		WebhookAllowedNetworks(netip.MustParsePrefix("127.0.0.0/8")),
+	)
+	require.NoError(t, err)
+
+	return head, store
+}
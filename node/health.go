@@ -3,13 +3,16 @@ package node
 import (
 	"context"
 	"net/http"
+	"slices"
 	"time"
 
+	"github.com/blessnetwork/b7s/info"
+	"github.com/blessnetwork/b7s/models/bls"
 	"github.com/blessnetwork/b7s/models/response"
 )
 
 // HealthPing will run a long running loop, publishing health signal until cancelled.
-func (c *core) emitHealthPing(ctx context.Context, interval time.Duration) {
+func (c *core) emitHealthPing(ctx context.Context, interval time.Duration, status func(context.Context) bls.NodeStatus) {
 
 	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
@@ -20,7 +23,8 @@ func (c *core) emitHealthPing(ctx context.Context, interval time.Duration) {
 		case <-ticker.C:
 
 			msg := response.Health{
-				Code: http.StatusOK,
+				Code:       http.StatusOK,
+				NodeStatus: c.healthStatus(ctx, status),
 			}
 
 			err := c.Publish(ctx, &msg)
@@ -37,3 +41,19 @@ func (c *core) emitHealthPing(ctx context.Context, interval time.Duration) {
 		}
 	}
 }
+
+// healthStatus returns the status of the node shared in health pings. Version and topics are set by the node core,
+// the rest of the status is role specific.
+func (c *core) healthStatus(ctx context.Context, status func(context.Context) bls.NodeStatus) bls.NodeStatus {
+
+	var s bls.NodeStatus
+	if status != nil {
+		s = status(ctx)
+	}
+
+	s.Version = info.VcsVersion()
+	s.Topics = c.topics.Keys()
+	slices.Sort(s.Topics)
+
+	return s
+}
@@ -75,7 +75,11 @@ func correctPipeline(msg string, pipeline Pipeline) bool {
 		bls.MessageFormCluster,
 		bls.MessageFormClusterResponse,
 		bls.MessageDisbandCluster,
-		bls.MessageRollCallResponse:
+		bls.MessageRollCallResponse,
+		bls.MessageAttestation,
+		bls.MessageAttestationResponse,
+		bls.MessageListFunctionsResponse,
+		bls.MessageUninstallFunctionResponse:
 
 		return false
 
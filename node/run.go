@@ -16,8 +16,8 @@ import (
 	"github.com/blessnetwork/b7s/models/bls"
 )
 
-// Run will start the main loop for the node.
-func (c *core) Run(ctx context.Context, process func(context.Context, peer.ID, string, []byte) error) error {
+// Run will start the main loop for the node. Health pings published by the node include the status returned by `status`.
+func (c *core) Run(ctx context.Context, process func(context.Context, peer.ID, string, []byte) error, status func(context.Context) bls.NodeStatus) error {
 
 	err := c.host.InitPubSub(ctx)
 	if err != nil {
@@ -117,14 +117,14 @@ func (c *core) Run(ctx context.Context, process func(context.Context, peer.ID, s
 		}(topicName, topic.subscription)
 	}
 
+	// Start the health signal emitter in a separate goroutine.
+	go c.emitHealthPing(ctx, c.cfg.HealthInterval, status)
+
 	workers.Wait()
 
 	c.log.Debug().Msg("waiting for messages being processed")
 	wg.Wait()
 
-	// Start the health signal emitter in a separate goroutine.
-	go c.emitHealthPing(ctx, c.cfg.HealthInterval)
-
 	return nil
 }
 
@@ -0,0 +1,31 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/request"
+)
+
+// processAttestation shares the signed attributes of the node with the head node, so it can verify what the node claims.
+func (w *Worker) processAttestation(ctx context.Context, from peer.ID, req request.Attestation) error {
+
+	w.Log().Debug().Stringer("peer", from).Str("request", req.RequestID).Msg("received attestation request")
+
+	att := w.attributes.Load()
+
+	code := codes.OK
+	if att == nil {
+		code = codes.NotFound
+	}
+
+	err := w.Send(ctx, from, req.Response(code, att))
+	if err != nil {
+		return fmt.Errorf("could not send response: %w", err)
+	}
+
+	return nil
+}
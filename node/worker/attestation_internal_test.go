@@ -0,0 +1,85 @@
+package worker
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/blocklessnetwork/b7s-attributes/attributes"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/config"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/request"
+	"github.com/blessnetwork/b7s/models/response"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestWorker_LoadAttributesFromFile(t *testing.T) {
+
+	var (
+		core  = mocks.BaselineNodeCore(t)
+		attrs = []attributes.Attribute{{Name: "region", Value: "eu-west-1"}}
+	)
+
+	sig, err := attributes.SignAttributes(attrs, core.Host().PrivateKey())
+	require.NoError(t, err)
+
+	att := attributes.Attestation{
+		Attributes: attrs,
+		Signature:  &attributes.Signature{Signer: core.Host().ID(), Signature: sig},
+	}
+
+	newWorker := func(att attributes.Attestation) (*Worker, error) {
+
+		path := filepath.Join(t.TempDir(), defaultAttributesFilename)
+		f, err := os.Create(path)
+		require.NoError(t, err)
+		defer f.Close()
+
+		require.NoError(t, attributes.ExportAttestation(f, att))
+
+		return New(core, mocks.BaselineFStore(t), mocks.BaselineExecutor(t),
+			Workspace(t.TempDir()),
+			AttributeLoading(true),
+			AttributesPath(path),
+		)
+	}
+
+	t.Run("attributes are loaded and shared", func(t *testing.T) {
+
+		worker, err := newWorker(att)
+		require.NoError(t, err)
+		require.Equal(t, att, *worker.attributes.Load())
+
+		var sent *response.Attestation
+		core.SendFunc = func(_ context.Context, _ peer.ID, msg bls.Message) error {
+			sent = msg.(*response.Attestation)
+			return nil
+		}
+
+		err = worker.processAttestation(context.Background(), mocks.GenericPeerID, request.Attestation{RequestID: "request-id"})
+		require.NoError(t, err)
+
+		require.NotNil(t, sent)
+		require.Equal(t, codes.OK, sent.Code)
+		require.Equal(t, "request-id", sent.RequestID)
+		require.Equal(t, att, *sent.Attestation)
+	})
+	t.Run("tampered attributes are rejected", func(t *testing.T) {
+
+		tampered := att
+		tampered.Attributes = []attributes.Attribute{{Name: "region", Value: "us-east-1"}}
+
+		_, err := newWorker(tampered)
+		require.Error(t, err)
+	})
+}
+
+func TestWorker_IPNSGatewayURL(t *testing.T) {
+	require.Equal(t, "https://name.ipns.cf-ipfs.com/attributes.bin", ipnsGatewayURL(config.DefaultAttributesGateway, "name"))
+	require.Equal(t, "https://ipfs.io/ipns/name/attributes.bin", ipnsGatewayURL("https://ipfs.io/ipns/{name}/", "name"))
+}
@@ -1,124 +1,127 @@
 package worker
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"net/http"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/ipfs/boxo/ipns"
 
-	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/peer"
 
-	"github.com/blessnetwork/b7s/models/bls"
-	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/node"
 	"github.com/blocklessnetwork/b7s-attributes/attributes"
 )
 
 const (
 	defaultAttributesFilename = "attributes.bin"
+
+	// Placeholder for the IPNS name of the node in the gateway URL.
+	attributesGatewayNamePlaceholder = "{name}"
 )
 
-func loadAttributes(key crypto.PubKey) (attributes.Attestation, error) {
+// attributesClient is used to fetch attributes from the IPNS gateway. Unlike the default client, requests to an unresponsive gateway do not hang.
+var attributesClient = &http.Client{
+	Timeout: attributesFetchTimeout,
+}
 
-	name, err := getAttributesIPNSName(key)
-	if err != nil {
-		return attributes.Attestation{}, fmt.Errorf("could not get name from key: %w", err)
+// loadAttributes loads the attributes of the node, from a local file if set, or from the IPNS gateway otherwise.
+// Signatures of the node and of all attestors are verified.
+func (w *Worker) loadAttributes(ctx context.Context) (attributes.Attestation, error) {
+
+	var (
+		att attributes.Attestation
+		err error
+	)
+	if w.cfg.AttributesPath != "" {
+		att, err = readAttributes(w.cfg.AttributesPath)
+	} else {
+		att, err = fetchAttributes(ctx, w.cfg.AttributesGateway, w.Host().ID())
 	}
-
-	attributeURL := ipnsGatewayURL(name)
-
-	res, err := http.Get(attributeURL)
 	if err != nil {
-		return attributes.Attestation{}, fmt.Errorf("could not get attribute file from URL: %w", err)
+		return attributes.Attestation{}, err
 	}
-	defer res.Body.Close()
 
-	att, err := attributes.ImportAttestation(res.Body)
+	err = node.VerifyAttestation(att, w.Host().ID())
 	if err != nil {
-		return attributes.Attestation{}, fmt.Errorf("could not load attestation from file: %w", err)
+		return attributes.Attestation{}, fmt.Errorf("could not verify attestation: %w", err)
 	}
 
 	return att, nil
 }
 
-func getAttributesIPNSName(key crypto.PubKey) (string, error) {
+func readAttributes(path string) (attributes.Attestation, error) {
 
-	id, err := peer.IDFromPublicKey(key)
+	f, err := os.Open(path)
 	if err != nil {
-		return "", fmt.Errorf("could not get peer ID for key: %w", err)
+		return attributes.Attestation{}, fmt.Errorf("could not open attribute file: %w", err)
 	}
+	defer f.Close()
 
-	name := ipns.NameFromPeer(id)
-	return name.String(), nil
-}
+	att, err := attributes.ImportAttestation(f)
+	if err != nil {
+		return attributes.Attestation{}, fmt.Errorf("could not load attestation from file: %w", err)
+	}
 
-func ipnsGatewayURL(name string) string {
-	return fmt.Sprintf("https://%s.ipns.cf-ipfs.com/%s", name, defaultAttributesFilename)
+	return att, nil
 }
 
-func haveAttributes(have attributes.Attestation, want execute.Attributes) error {
+func fetchAttributes(ctx context.Context, gateway string, id peer.ID) (attributes.Attestation, error) {
 
-	if want.AttestationRequired && len(have.Attestors) == 0 {
-		return errors.New("attestors required but none found")
-	}
+	attributeURL := ipnsGatewayURL(gateway, ipns.NameFromPeer(id).String())
 
-	// If we need to check attestors, create a map of them now.
-	var attestors map[peer.ID]struct{}
-	if len(want.Attestors.Each) > 0 || len(want.Attestors.OneOf) > 0 {
-		attestors = make(map[peer.ID]struct{}, len(have.Attestors))
-		for _, attestor := range have.Attestors {
-			attestors[attestor.Signer] = struct{}{}
-		}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, attributeURL, nil)
+	if err != nil {
+		return attributes.Attestation{}, fmt.Errorf("could not create request: %w", err)
 	}
 
-	// If the client wants specific attestors, check if they're present.
-	if len(want.Attestors.Each) > 0 {
-		for _, wa := range want.Attestors.Each {
-			_, ok := attestors[wa]
-			if !ok {
-				return fmt.Errorf("attestor %s explicitly requested but not found", wa.String())
-			}
-		}
+	res, err := attributesClient.Do(req)
+	if err != nil {
+		return attributes.Attestation{}, fmt.Errorf("could not get attribute file from URL: %w", err)
 	}
+	defer res.Body.Close()
 
-	// If the client wants some of these attestors, check if at least one if found.
-	if len(want.Attestors.OneOf) > 0 {
-		var found bool
-		for _, wa := range want.Attestors.OneOf {
-			_, ok := attestors[wa]
-			if ok {
-				found = true
-				break
-			}
-		}
-		if !found {
-			return fmt.Errorf("at least one attestor wanted but none found (wanted: %s)", bls.PeerIDsToStr(want.Attestors.OneOf))
-		}
+	if res.StatusCode != http.StatusOK {
+		return attributes.Attestation{}, fmt.Errorf("unexpected status code when getting attribute file: %v", res.StatusCode)
 	}
 
-	// It doesn't make a lot of sense to require attestors without wanting specific attributes,
-	// but if that's the case, and there's no attributes wanted, we're done now.
-	if len(want.Values) == 0 {
-		return nil
+	att, err := attributes.ImportAttestation(res.Body)
+	if err != nil {
+		return attributes.Attestation{}, fmt.Errorf("could not load attestation from file: %w", err)
 	}
 
-	attrs := make(map[string]string, len(have.Attributes))
-	for _, attr := range have.Attributes {
-		attrs[attr.Name] = attr.Value
-	}
+	return att, nil
+}
 
-	for _, wantAttr := range want.Values {
+func ipnsGatewayURL(gateway string, name string) string {
+	base := strings.ReplaceAll(gateway, attributesGatewayNamePlaceholder, name)
+	return strings.TrimSuffix(base, "/") + "/" + defaultAttributesFilename
+}
 
-		value, ok := attrs[wantAttr.Name]
-		if !ok {
-			return fmt.Errorf("attribute wanted but not found (attr: %v, value: %v)", wantAttr.Name, wantAttr.Value)
-		}
+// runAttributeRefreshLoop periodically reloads the attributes of the node. If the attributes cannot be loaded, the node keeps the ones it has.
+func (w *Worker) runAttributeRefreshLoop(ctx context.Context) {
 
-		if value != wantAttr.Value {
-			return fmt.Errorf("attribute wanted but value doesn't match (attr: %v, want: %v, have: %v)", wantAttr.Name, wantAttr.Value, value)
+	ticker := time.NewTicker(w.cfg.AttributesRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			att, err := w.loadAttributes(ctx)
+			if err != nil {
+				w.Log().Warn().Err(err).Msg("could not refresh attributes")
+				continue
+			}
+
+			w.attributes.Store(&att)
+
+			w.Log().Debug().Any("attributes", att).Msg("node refreshed attributes")
+
+		case <-ctx.Done():
+			return
 		}
 	}
-
-	return nil
 }
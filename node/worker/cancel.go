@@ -0,0 +1,74 @@
+package worker
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/request"
+)
+
+// processCancelExecution aborts the execution of the request, if it is in progress on this node.
+// If the node is part of a consensus cluster formed for the request, it leaves the cluster. Clusters shared by multiple
+// requests are identified by the cluster ID - head node sets it only once no other request is executing on the cluster.
+// Only the peer that sent the work order, or that formed the cluster, can cancel the execution.
+func (w *Worker) processCancelExecution(ctx context.Context, from peer.ID, req request.CancelExecution) error {
+
+	log := w.Log().With().Stringer("peer", from).Str("request", req.RequestID).Logger()
+
+	log.Info().Msg("received request to cancel execution")
+
+	clusterID := cmp.Or(req.ClusterID, req.RequestID)
+
+	origin, executing := w.executions.Get(req.RequestID)
+	if executing && origin != from {
+		return fmt.Errorf("execution requested by a different peer (request: %s, origin: %s)", req.RequestID, origin)
+	}
+
+	cluster, clustered := w.clusters.Get(clusterID)
+	if clustered && cluster.origin != from {
+		return fmt.Errorf("cluster formed by a different peer (request: %s, cluster: %s, origin: %s)", req.RequestID, clusterID, cluster.origin)
+	}
+
+	if !executing && !clustered {
+		log.Info().Msg("no execution or cluster for the request")
+		return nil
+	}
+
+	err := w.executor.Cancel(req.RequestID)
+	if err != nil && !errors.Is(err, bls.ErrNotFound) {
+		return fmt.Errorf("could not cancel execution (request: %s): %w", req.RequestID, err)
+	}
+
+	// Execution may be running on another cluster member - no need to wait for it to complete before leaving the cluster.
+	err = w.shutdownCluster(clusterID)
+	if err != nil && !errors.Is(err, errNoCluster) {
+		return fmt.Errorf("could not disband cluster (request: %s, cluster: %s): %w", req.RequestID, clusterID, err)
+	}
+
+	log.Info().Msg("execution cancelled")
+
+	return nil
+}
+
+// startExecution records the peer that sent the work order for the request. It returns false if the request
+// is already being executed, in which case the origin of the first work order is kept.
+func (w *Worker) startExecution(requestID string, origin peer.ID) bool {
+
+	started := false
+	w.executions.WithLock(func(data map[string]peer.ID) {
+		_, ok := data[requestID]
+		if ok {
+			return
+		}
+
+		data[requestID] = origin
+		started = true
+	})
+
+	return started
+}
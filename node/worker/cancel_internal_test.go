@@ -0,0 +1,178 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/consensus"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/models/request"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestWorker_ProcessCancelExecution(t *testing.T) {
+
+	t.Run("execution is cancelled and cluster disbanded", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			requestID = fmt.Sprintf("request-id-%v", rand.Int())
+			cancelled string
+			cluster   = &dummyCluster{}
+		)
+
+		executor := mocks.BaselineExecutor(t)
+		executor.CancelFunc = func(id string) error {
+			cancelled = id
+			return nil
+		}
+
+		worker := createWorkerNode(t)
+		worker.executor = executor
+		worker.clusters.Set(requestID, workerCluster{consensusExecutor: cluster, origin: mocks.GenericPeerID})
+
+		err := worker.processCancelExecution(context.Background(), mocks.GenericPeerID, request.CancelExecution{RequestID: requestID})
+		require.NoError(t, err)
+
+		require.Equal(t, requestID, cancelled)
+		require.True(t, cluster.shutdown)
+
+		_, ok := worker.clusters.Get(requestID)
+		require.False(t, ok)
+
+		// Disbanding the cluster after the cancellation is not an error.
+		err = worker.processDisbandCluster(context.Background(), mocks.GenericPeerID, request.DisbandCluster{RequestID: requestID})
+		require.NoError(t, err)
+	})
+	t.Run("shared cluster is disbanded", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			requestID = fmt.Sprintf("request-id-%v", rand.Int())
+			clusterID = fmt.Sprintf("cluster-id-%v", rand.Int())
+			cluster   = &dummyCluster{}
+		)
+
+		executor := mocks.BaselineExecutor(t)
+		executor.CancelFunc = func(string) error {
+			return nil
+		}
+
+		worker := createWorkerNode(t)
+		worker.executor = executor
+		worker.clusters.Set(clusterID, workerCluster{consensusExecutor: cluster, origin: mocks.GenericPeerID})
+
+		// Cluster is left in place for other requests.
+		err := worker.processCancelExecution(context.Background(), mocks.GenericPeerID, request.CancelExecution{RequestID: requestID})
+		require.NoError(t, err)
+		require.False(t, cluster.shutdown)
+
+		err = worker.processCancelExecution(context.Background(), mocks.GenericPeerID, request.CancelExecution{RequestID: requestID, ClusterID: clusterID})
+		require.NoError(t, err)
+		require.True(t, cluster.shutdown)
+
+		_, ok := worker.clusters.Get(clusterID)
+		require.False(t, ok)
+	})
+	t.Run("no execution in progress", func(t *testing.T) {
+		t.Parallel()
+
+		executor := mocks.BaselineExecutor(t)
+		executor.CancelFunc = func(string) error {
+			return bls.ErrNotFound
+		}
+
+		worker := createWorkerNode(t)
+		worker.executor = executor
+		worker.executions.Set("dummy-request-id", mocks.GenericPeerID)
+
+		err := worker.processCancelExecution(context.Background(), mocks.GenericPeerID, request.CancelExecution{RequestID: "dummy-request-id"})
+		require.NoError(t, err)
+	})
+	t.Run("executor error", func(t *testing.T) {
+		t.Parallel()
+
+		executor := mocks.BaselineExecutor(t)
+		executor.CancelFunc = func(string) error {
+			return mocks.GenericError
+		}
+
+		worker := createWorkerNode(t)
+		worker.executor = executor
+		worker.executions.Set("dummy-request-id", mocks.GenericPeerID)
+
+		err := worker.processCancelExecution(context.Background(), mocks.GenericPeerID, request.CancelExecution{RequestID: "dummy-request-id"})
+		require.Error(t, err)
+	})
+	t.Run("cancellation from a different peer is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			requestID = fmt.Sprintf("request-id-%v", rand.Int())
+			cluster   = &dummyCluster{}
+			other     = peer.ID("dummy-peer-id")
+		)
+
+		executor := mocks.BaselineExecutor(t)
+		executor.CancelFunc = func(string) error {
+			require.FailNow(t, "unexpected cancellation")
+			return nil
+		}
+
+		worker := createWorkerNode(t)
+		worker.executor = executor
+
+		// Execution requested by a different peer.
+		worker.executions.Set(requestID, mocks.GenericPeerID)
+		err := worker.processCancelExecution(context.Background(), other, request.CancelExecution{RequestID: requestID})
+		require.Error(t, err)
+
+		// Cluster formed by a different peer.
+		worker.executions.Delete(requestID)
+		worker.clusters.Set(requestID, workerCluster{consensusExecutor: cluster, origin: mocks.GenericPeerID})
+		err = worker.processCancelExecution(context.Background(), other, request.CancelExecution{RequestID: requestID})
+		require.Error(t, err)
+
+		err = worker.processDisbandCluster(context.Background(), other, request.DisbandCluster{RequestID: requestID})
+		require.Error(t, err)
+
+		require.False(t, cluster.shutdown)
+	})
+	t.Run("origin of the first work order is kept", func(t *testing.T) {
+		t.Parallel()
+
+		worker := createWorkerNode(t)
+
+		require.True(t, worker.startExecution("dummy-request-id", mocks.GenericPeerID))
+		require.False(t, worker.startExecution("dummy-request-id", peer.ID("dummy-peer-id")))
+
+		origin, ok := worker.executions.Get("dummy-request-id")
+		require.True(t, ok)
+		require.Equal(t, mocks.GenericPeerID, origin)
+	})
+}
+
+type dummyCluster struct {
+	shutdown bool
+}
+
+func (d *dummyCluster) Consensus() consensus.Type {
+	return consensus.Raft
+}
+
+func (d *dummyCluster) Execute(peer.ID, string, time.Time, execute.Request) (codes.Code, execute.Result, error) {
+	return codes.OK, execute.Result{}, nil
+}
+
+func (d *dummyCluster) Shutdown() error {
+	d.shutdown = true
+	return nil
+}
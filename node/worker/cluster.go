@@ -13,6 +13,15 @@ import (
 	"github.com/blessnetwork/b7s/models/request"
 )
 
+var errNoCluster = errors.New("no cluster with that ID")
+
+// workerCluster is a consensus cluster the node belongs to.
+type workerCluster struct {
+	consensusExecutor
+
+	origin peer.ID // Peer that requested the cluster formation. Only it can disband the cluster.
+}
+
 func (w *Worker) processFormCluster(ctx context.Context, from peer.ID, req request.FormCluster) error {
 
 	w.Log().Info().
@@ -60,7 +69,17 @@ func (w *Worker) processDisbandCluster(ctx context.Context, from peer.ID, req re
 		Str("request", req.RequestID).
 		Msg("received request to disband consensus cluster")
 
+	cluster, ok := w.clusters.Get(req.RequestID)
+	if ok && cluster.origin != from {
+		return fmt.Errorf("cluster formed by a different peer (request: %s, origin: %s)", req.RequestID, cluster.origin)
+	}
+
 	err := w.leaveCluster(req.RequestID, consensusClusterDisbandTimeout)
+	if errors.Is(err, errNoCluster) {
+		// Cluster may have already been disbanded if the execution was cancelled.
+		w.Log().Info().Str("request", req.RequestID).Msg("no consensus cluster to disband")
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("could not disband cluster (request: %s): %w", req.RequestID, err)
 	}
@@ -78,7 +97,7 @@ func (w *Worker) leaveCluster(requestID string, timeout time.Duration) error {
 	// Shutdown can take a while so use short locking intervals.
 	cluster, ok := w.clusters.Get(requestID)
 	if !ok {
-		return errors.New("no cluster with that ID")
+		return errNoCluster
 	}
 
 	log := w.Log().With().Str("request", requestID).Logger()
@@ -95,6 +114,17 @@ func (w *Worker) leaveCluster(requestID string, timeout time.Duration) error {
 
 	log.Info().Bool("executed_work", ok).Msg("waiting for execution done, leaving cluster")
 
+	return w.shutdownCluster(requestID)
+}
+
+// shutdownCluster leaves the cluster immediately, without waiting for the execution to complete.
+func (w *Worker) shutdownCluster(requestID string) error {
+
+	cluster, ok := w.clusters.Get(requestID)
+	if !ok {
+		return errNoCluster
+	}
+
 	err := cluster.Shutdown()
 	if err != nil {
 		// Not much we can do at this point.
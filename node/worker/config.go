@@ -3,9 +3,12 @@ package worker
 import (
 	"errors"
 	"path/filepath"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/libp2p/go-libp2p/core/peer"
 
+	"github.com/blessnetwork/b7s/config"
 	"github.com/blessnetwork/b7s/metadata"
 )
 
@@ -14,15 +17,23 @@ type Option func(*Config)
 
 // DefaultConfig represents the default settings for the node.
 var DefaultConfig = Config{
-	LoadAttributes:   DefaultAttributeLoadingSetting,
-	MetadataProvider: metadata.NewNoopProvider(),
+	LoadAttributes:            DefaultAttributeLoadingSetting,
+	AttributesGateway:         config.DefaultAttributesGateway,
+	AttributesRefreshInterval: config.DefaultAttributesRefresh,
+	MetadataProvider:          metadata.NewNoopProvider(),
 }
 
 // Config represents the Node configuration.
 type Config struct {
-	Workspace        string            // Directory where we can store files needed for execution.
-	LoadAttributes   bool              // Node should try to load its attributes from IPFS.
-	MetadataProvider metadata.Provider // Metadata provider for the node
+	Workspace                 string            // Directory where we can store files needed for execution.
+	LoadAttributes            bool              // Node should try to load its attributes from IPFS.
+	AttributesPath            string            // Local attribute file. If set, attributes are not loaded from IPFS.
+	AttributesGateway         string            // IPNS gateway URL used to load attributes. `{name}` is replaced with the IPNS name of the node.
+	AttributesRefreshInterval time.Duration     // How often are attributes reloaded. Zero means attributes are loaded only on startup.
+	Capacity                  uint              // How many executions can the node run at the same time. Roll calls are declined when at capacity. Zero means there is no limit.
+	Cgroup                    string            // Cgroup (v2) executions run in, used to measure CPU usage. Defaults to the cgroup of the node.
+	UninstallPeers            []peer.ID         // Head nodes allowed to uninstall functions. If empty, uninstall requests are refused.
+	MetadataProvider          metadata.Provider // Metadata provider for the node
 }
 
 // Validate checks if the given configuration is correct.
@@ -34,6 +45,14 @@ func (c Config) Valid() error {
 		err = multierror.Append(err, errors.New("workspace must be an absolute path"))
 	}
 
+	if c.LoadAttributes && c.AttributesPath == "" && c.AttributesGateway == "" {
+		err = multierror.Append(err, errors.New("attribute file path or IPNS gateway must be set to load attributes"))
+	}
+
+	if c.AttributesRefreshInterval < 0 {
+		err = multierror.Append(err, errors.New("attribute refresh interval cannot be negative"))
+	}
+
 	return err.ErrorOrNil()
 }
 
@@ -51,6 +70,48 @@ func AttributeLoading(b bool) Option {
 	}
 }
 
+// AttributesPath sets the local file the node loads its attributes from, instead of IPFS.
+func AttributesPath(path string) Option {
+	return func(cfg *Config) {
+		cfg.AttributesPath = path
+	}
+}
+
+// AttributesGateway sets the IPNS gateway URL used to load attributes. `{name}` in the URL is replaced with the IPNS name of the node.
+func AttributesGateway(url string) Option {
+	return func(cfg *Config) {
+		cfg.AttributesGateway = url
+	}
+}
+
+// AttributesRefreshInterval sets how often the node reloads its attributes.
+func AttributesRefreshInterval(d time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.AttributesRefreshInterval = d
+	}
+}
+
+// Capacity sets how many executions the node can run at the same time. Node declines roll calls when at capacity.
+func Capacity(n uint) Option {
+	return func(cfg *Config) {
+		cfg.Capacity = n
+	}
+}
+
+// Cgroup sets the cgroup (v2) executions run in - the cgroup of the resource limiter, if one is used. CPU usage of this cgroup is reported on roll call.
+func Cgroup(path string) Option {
+	return func(cfg *Config) {
+		cfg.Cgroup = path
+	}
+}
+
+// UninstallPeers sets the head nodes that are allowed to uninstall functions from the node.
+func UninstallPeers(ids []peer.ID) Option {
+	return func(cfg *Config) {
+		cfg.UninstallPeers = ids
+	}
+}
+
 // MetadataProvider sets the metadata provider for the node.
 func MetadataProvider(p metadata.Provider) Option {
 	return func(cfg *Config) {
@@ -27,33 +27,11 @@ type consensusExecutor interface {
 
 func (w *Worker) createRaftCluster(ctx context.Context, from peer.ID, fc request.FormCluster) error {
 
-	// Add a callback function to send the execution result to origin.
-	sendFn := func(req raft.FSMLogEntry, res execute.NodeResult) {
-
-		ctx, cancel := context.WithTimeout(context.Background(), consensusClusterSendTimeout)
-		defer cancel()
-
-		metadata, err := w.cfg.MetadataProvider.Metadata(req.Execute, res.Result.Result)
-		if err != nil {
-			w.Log().Warn().Err(err).Msg("could not get metadata")
-		}
-		res.Metadata = metadata
-
-		msg := response.WorkOrder{
-			Code:      res.Code,
-			RequestID: fc.RequestID,
-			Result:    res,
-		}
-
-		err = w.Send(ctx, req.Origin, &msg)
-		if err != nil {
-			w.Log().Error().Err(err).Stringer("peer", req.Origin).Msg("could not send execution result to node")
-		}
-	}
-
-	// Add a callback function to cache the execution result
+	// Add a callback function to cache the execution result.
+	// Cluster may execute multiple requests, so the result is cached for the cluster too, signaling the cluster did its work.
 	cacheFn := func(req raft.FSMLogEntry, res execute.NodeResult) {
 		w.executeResponses.Set(req.RequestID, res)
+		w.executeResponses.Set(fc.RequestID, res)
 	}
 
 	rh, err := raft.New(
@@ -63,13 +41,13 @@ func (w *Worker) createRaftCluster(ctx context.Context, from peer.ID, fc request
 		fc.RequestID,
 		w.executor,
 		fc.Peers,
-		raft.WithCallbacks(cacheFn, sendFn),
+		raft.WithCallbacks(cacheFn, w.sendRaftResult),
 	)
 	if err != nil {
 		return fmt.Errorf("could not create raft node: %w", err)
 	}
 
-	w.clusters.Set(fc.RequestID, rh)
+	w.clusters.Set(fc.RequestID, workerCluster{consensusExecutor: rh, origin: from})
 
 	err = w.Send(ctx, from, fc.Response(codes.OK).WithConsensus(fc.Consensus))
 	if err != nil {
@@ -79,9 +57,43 @@ func (w *Worker) createRaftCluster(ctx context.Context, from peer.ID, fc request
 	return nil
 }
 
+// sendRaftResult sends the result of an execution done as part of a Raft cluster to origin.
+func (w *Worker) sendRaftResult(req raft.FSMLogEntry, res execute.NodeResult) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), consensusClusterSendTimeout)
+	defer cancel()
+
+	log := w.Log().With().Str("request", req.RequestID).Logger()
+
+	metadata, err := w.cfg.MetadataProvider.Metadata(req.Execute, res.Result.Result)
+	if err != nil {
+		log.Warn().Err(err).Msg("could not get metadata")
+	}
+	res.Metadata = metadata
+
+	entry, receipt, err := execute.SignExecution(w.Host().PrivateKey(), req.RequestID, req.Execute, res.Result)
+	if err != nil {
+		log.Error().Err(err).Msg("could not sign execution")
+	}
+	res.UsageEntry = entry
+	res.Receipt = receipt
+
+	msg := response.WorkOrder{
+		Code:      res.Code,
+		RequestID: req.RequestID,
+		Result:    res,
+	}
+
+	err = w.Send(ctx, req.Origin, &msg)
+	if err != nil {
+		log.Error().Err(err).Stringer("peer", req.Origin).Msg("could not send execution result to node")
+	}
+}
+
 func (w *Worker) createPBFTCluster(ctx context.Context, from peer.ID, fc request.FormCluster) error {
 
 	cacheFn := func(requestID string, origin peer.ID, req execute.Request, res execute.NodeResult) {
+		w.executeResponses.Set(requestID, res)
 		w.executeResponses.Set(fc.RequestID, res)
 	}
 
@@ -106,7 +118,7 @@ func (w *Worker) createPBFTCluster(ctx context.Context, from peer.ID, fc request
 		return fmt.Errorf("could not create PBFT node: %w", err)
 	}
 
-	w.clusters.Set(fc.RequestID, ph)
+	w.clusters.Set(fc.RequestID, workerCluster{consensusExecutor: ph, origin: from})
 
 	err = w.Send(ctx, from, fc.Response(codes.OK).WithConsensus(fc.Consensus))
 	if err != nil {
@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/consensus/raft"
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/models/response"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestWorker_SendRaftResult(t *testing.T) {
+
+	var (
+		entry = raft.FSMLogEntry{
+			RequestID: "request-id",
+			Origin:    mocks.GenericPeerID,
+			Execute:   mocks.GenericExecutionRequest,
+		}
+
+		result = execute.NodeResult{
+			Result: execute.Result{
+				Code: codes.OK,
+				Result: execute.RuntimeOutput{
+					Stdout: "test-stdout",
+				},
+				Usage: execute.Usage{
+					WallClockTime: time.Second,
+					MemoryMaxKB:   2048,
+				},
+			},
+		}
+	)
+
+	var sent *response.WorkOrder
+	core := mocks.BaselineNodeCore(t)
+	core.SendFunc = func(_ context.Context, to peer.ID, msg bls.Message) error {
+		require.Equal(t, entry.Origin, to)
+
+		wo, ok := any(msg).(*response.WorkOrder)
+		require.True(t, ok)
+
+		sent = wo
+		return nil
+	}
+
+	worker := createWorkerNode(t)
+	worker.Core = core
+
+	worker.sendRaftResult(entry, result)
+
+	require.NotNil(t, sent)
+	require.Equal(t, entry.RequestID, sent.RequestID)
+	require.Equal(t, result.Result, sent.Result.Result)
+
+	// Verify the worker signed its resource usage.
+	usage := sent.Result.UsageEntry
+	require.NotNil(t, usage)
+	require.Equal(t, worker.Host().ID(), usage.Worker)
+	require.Equal(t, entry.RequestID, usage.RequestID)
+	require.Equal(t, result.Usage, usage.Usage)
+	require.NoError(t, usage.VerifySignature())
+
+	// Verify the worker signed a receipt for the execution.
+	receipt := sent.Result.Receipt
+	require.NotNil(t, receipt)
+	require.Equal(t, worker.Host().ID(), receipt.Worker)
+	require.NoError(t, receipt.Verify(entry.RequestID, entry.Execute, result.Result.Result))
+}
@@ -2,6 +2,8 @@ package worker
 
 import (
 	"context"
+
+	"github.com/blessnetwork/b7s/models/bls"
 )
 
 // FStore provides retrieval of function manifest.
@@ -12,6 +14,15 @@ type FStore interface {
 	// IsInstalled returns info if the function is installed or not.
 	IsInstalled(cid string) (bool, error)
 
+	// Uninstall removes the function with the given CID.
+	Uninstall(ctx context.Context, cid string) error
+
+	// IsRetired returns info if the function was uninstalled, and should not be installed on roll call.
+	IsRetired(cid string) (bool, error)
+
+	// InstalledFunctions returns the records of installed functions.
+	InstalledFunctions(ctx context.Context) ([]bls.FunctionRecord, error)
+
 	// TODO: Refactor the sync code - move the logic outside of the package
 	// Sync will ensure function installations are correct, redownloading functions if needed.
 	Sync(ctx context.Context, haltOnError bool) error
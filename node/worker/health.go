@@ -5,6 +5,7 @@ import (
 
 	"github.com/libp2p/go-libp2p/core/peer"
 
+	"github.com/blessnetwork/b7s/models/bls"
 	"github.com/blessnetwork/b7s/models/response"
 )
 
@@ -12,3 +13,42 @@ func (w *Worker) processHealthCheck(ctx context.Context, from peer.ID, _ respons
 	w.Log().Trace().Stringer("from", from).Msg("peer health check received")
 	return nil
 }
+
+// healthStatus returns the status of the worker shared in health pings - its load, installed functions and attributes.
+// Health pings are published periodically, so the number of functions and attributes listed is capped.
+func (w *Worker) healthStatus(ctx context.Context) bls.NodeStatus {
+
+	load := w.currentLoad()
+	status := bls.NodeStatus{
+		Role: bls.WorkerNode.String(),
+		Load: &load,
+	}
+
+	functions, err := w.fstore.InstalledFunctions(ctx)
+	if err != nil {
+		w.Log().Warn().Err(err).Msg("could not retrieve installed functions")
+	}
+	if len(functions) > healthMaxFunctions {
+		functions = functions[:healthMaxFunctions]
+		status.Truncated = true
+	}
+	for _, fn := range functions {
+		status.Functions = append(status.Functions, fn.CID)
+	}
+
+	att := w.attributes.Load()
+	if att != nil {
+		attrs := att.Attributes
+		if len(attrs) > healthMaxAttributes {
+			attrs = attrs[:healthMaxAttributes]
+			status.Truncated = true
+		}
+
+		status.Attributes = make(map[string]string, len(attrs))
+		for _, attr := range attrs {
+			status.Attributes[attr.Name] = attr.Value
+		}
+	}
+
+	return status
+}
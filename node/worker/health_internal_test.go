@@ -0,0 +1,60 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/blocklessnetwork/b7s-attributes/attributes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestWorker_HealthStatus(t *testing.T) {
+
+	newWorker := func(t *testing.T, count int) *Worker {
+
+		functions := make([]bls.FunctionRecord, 0, count)
+		for i := 0; i < count; i++ {
+			fn := mocks.GenericFunctionRecord
+			fn.CID = fmt.Sprintf("cid-%v", i)
+			functions = append(functions, fn)
+		}
+
+		fstore := mocks.BaselineFStore(t)
+		fstore.InstalledFunctionsFunc = func(context.Context) ([]bls.FunctionRecord, error) {
+			return functions, nil
+		}
+
+		worker, err := New(mocks.BaselineNodeCore(t), fstore, mocks.BaselineExecutor(t), Workspace(t.TempDir()))
+		require.NoError(t, err)
+
+		var att attributes.Attestation
+		for i := 0; i < count; i++ {
+			att.Attributes = append(att.Attributes, attributes.Attribute{Name: fmt.Sprintf("name-%v", i), Value: "value"})
+		}
+		worker.attributes.Store(&att)
+
+		return worker
+	}
+
+	t.Run("functions and attributes are listed", func(t *testing.T) {
+
+		status := newWorker(t, 2).healthStatus(context.Background())
+
+		require.Equal(t, bls.WorkerNode.String(), status.Role)
+		require.Equal(t, []string{"cid-0", "cid-1"}, status.Functions)
+		require.Equal(t, map[string]string{"name-0": "value", "name-1": "value"}, status.Attributes)
+		require.False(t, status.Truncated)
+	})
+	t.Run("listing is capped", func(t *testing.T) {
+
+		status := newWorker(t, healthMaxFunctions+healthMaxAttributes).healthStatus(context.Background())
+
+		require.Len(t, status.Functions, healthMaxFunctions)
+		require.Len(t, status.Attributes, healthMaxAttributes)
+		require.True(t, status.Truncated)
+	})
+}
@@ -2,28 +2,43 @@ package worker
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/libp2p/go-libp2p/core/peer"
 
+	"github.com/blessnetwork/b7s/models/bls"
 	"github.com/blessnetwork/b7s/models/codes"
 	"github.com/blessnetwork/b7s/models/request"
 )
 
 func (w *Worker) processInstallFunction(ctx context.Context, from peer.ID, req request.InstallFunction) error {
 
+	res := req.Response(codes.Accepted)
+
 	// Install function.
-	err := w.installFunction(ctx, req.CID, req.ManifestURL)
-	if err != nil {
-		return fmt.Errorf("could not install function: %w", err)
+	installErr := w.installFunction(ctx, req.CID, req.ManifestURL)
+	switch {
+	case errors.Is(installErr, bls.ErrChecksumMismatch):
+		res.Code = codes.Error
+		res.Status = bls.FunctionChecksumMismatch
+		res.Message = "function checksum mismatch"
+	case installErr != nil:
+		res.Code = codes.Error
+		res.Status = bls.FunctionInstallFailed
+		res.Message = "could not install function"
 	}
 
-	// Reply to the caller.
-	err = w.Send(ctx, from, req.Response(codes.Accepted))
+	// Reply to the caller - the head node keeps track of failed installations too.
+	err := w.Send(ctx, from, res)
 	if err != nil {
 		return fmt.Errorf("could not send the response (peer: %s): %w", from, err)
 	}
 
+	if installErr != nil {
+		return fmt.Errorf("could not install function: %w", installErr)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,86 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/request"
+	"github.com/blessnetwork/b7s/models/response"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestWorker_ProcessInstallFunction(t *testing.T) {
+
+	newWorker := func(t *testing.T, fstore *mocks.FStore) (*Worker, *response.InstallFunction) {
+
+		core := mocks.BaselineNodeCore(t)
+		worker, err := New(core, fstore, mocks.BaselineExecutor(t), Workspace(t.TempDir()))
+		require.NoError(t, err)
+
+		var sent response.InstallFunction
+		core.SendFunc = func(_ context.Context, _ peer.ID, msg bls.Message) error {
+			sent = *msg.(*response.InstallFunction)
+			return nil
+		}
+
+		return worker, &sent
+	}
+
+	req := request.InstallFunction{
+		InstallID:   "install-id",
+		ManifestURL: "https://example.com/manifest.json",
+		CID:         mocks.GenericFunctionRecord.CID,
+	}
+
+	notInstalled := func(t *testing.T, installErr error) *mocks.FStore {
+		fstore := mocks.BaselineFStore(t)
+		fstore.IsInstalledFunc = func(string) (bool, error) {
+			return false, nil
+		}
+		fstore.InstallFunc = func(context.Context, string, string) error {
+			return installErr
+		}
+
+		return fstore
+	}
+
+	t.Run("function is installed", func(t *testing.T) {
+
+		worker, sent := newWorker(t, notInstalled(t, nil))
+
+		err := worker.processInstallFunction(context.Background(), mocks.GenericPeerID, req)
+		require.NoError(t, err)
+
+		require.Equal(t, codes.Accepted, sent.Code)
+		require.Equal(t, bls.FunctionInstalled, sent.Status)
+		require.Equal(t, req.InstallID, sent.InstallID)
+		require.Equal(t, req.CID, sent.CID)
+	})
+	t.Run("install failure is reported", func(t *testing.T) {
+
+		worker, sent := newWorker(t, notInstalled(t, mocks.GenericError))
+
+		err := worker.processInstallFunction(context.Background(), mocks.GenericPeerID, req)
+		require.Error(t, err)
+
+		require.Equal(t, codes.Error, sent.Code)
+		require.Equal(t, bls.FunctionInstallFailed, sent.Status)
+		require.Equal(t, req.InstallID, sent.InstallID)
+	})
+	t.Run("checksum mismatch is reported", func(t *testing.T) {
+
+		worker, sent := newWorker(t, notInstalled(t, fmt.Errorf("could not download function: %w", bls.ErrChecksumMismatch)))
+
+		err := worker.processInstallFunction(context.Background(), mocks.GenericPeerID, req)
+		require.Error(t, err)
+
+		require.Equal(t, codes.Error, sent.Code)
+		require.Equal(t, bls.FunctionChecksumMismatch, sent.Status)
+	})
+}
@@ -0,0 +1,42 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/request"
+)
+
+// processListFunctions reports the functions installed on the node to the head node.
+func (w *Worker) processListFunctions(ctx context.Context, from peer.ID, req request.ListFunctions) error {
+
+	w.Log().Debug().Stringer("peer", from).Str("request", req.RequestID).Msg("received request to list installed functions")
+
+	functions, err := w.fstore.InstalledFunctions(ctx)
+	if err != nil {
+		w.Log().Error().Err(err).Str("request", req.RequestID).Msg("could not retrieve installed functions")
+
+		err = w.Send(ctx, from, req.Response(codes.Error, nil))
+		if err != nil {
+			return fmt.Errorf("could not send response: %w", err)
+		}
+
+		return nil
+	}
+
+	installed := make([]bls.InstalledFunction, 0, len(functions))
+	for _, fn := range functions {
+		installed = append(installed, bls.NewInstalledFunction(fn))
+	}
+
+	err = w.Send(ctx, from, req.Response(codes.OK, installed))
+	if err != nil {
+		return fmt.Errorf("could not send response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/request"
+	"github.com/blessnetwork/b7s/models/response"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestWorker_ProcessListFunctions(t *testing.T) {
+
+	newWorker := func(t *testing.T, fstore *mocks.FStore) (*Worker, *response.ListFunctions) {
+
+		core := mocks.BaselineNodeCore(t)
+		worker, err := New(core, fstore, mocks.BaselineExecutor(t), Workspace(t.TempDir()))
+		require.NoError(t, err)
+
+		var sent response.ListFunctions
+		core.SendFunc = func(_ context.Context, _ peer.ID, msg bls.Message) error {
+			sent = *msg.(*response.ListFunctions)
+			return nil
+		}
+
+		return worker, &sent
+	}
+
+	t.Run("installed functions are listed", func(t *testing.T) {
+
+		worker, sent := newWorker(t, mocks.BaselineFStore(t))
+
+		err := worker.processListFunctions(context.Background(), mocks.GenericPeerID, request.ListFunctions{RequestID: "request-id"})
+		require.NoError(t, err)
+
+		require.Equal(t, codes.OK, sent.Code)
+		require.Equal(t, "request-id", sent.RequestID)
+		require.Equal(t, []bls.InstalledFunction{bls.NewInstalledFunction(mocks.GenericFunctionRecord)}, sent.Functions)
+	})
+	t.Run("function store failure", func(t *testing.T) {
+
+		fstore := mocks.BaselineFStore(t)
+		fstore.InstalledFunctionsFunc = func(context.Context) ([]bls.FunctionRecord, error) {
+			return nil, mocks.GenericError
+		}
+
+		worker, sent := newWorker(t, fstore)
+
+		err := worker.processListFunctions(context.Background(), mocks.GenericPeerID, request.ListFunctions{RequestID: "request-id"})
+		require.NoError(t, err)
+
+		require.Equal(t, codes.Error, sent.Code)
+		require.Empty(t, sent.Functions)
+	})
+}
@@ -0,0 +1,72 @@
+package worker
+
+import (
+	"context"
+	"math"
+	"runtime"
+	"time"
+
+	"github.com/blessnetwork/b7s/models/bls"
+)
+
+// currentLoad returns the current load of the node. Resource usage is reported on a best-effort basis - values that cannot be determined are left unset.
+func (w *Worker) currentLoad() bls.NodeLoad {
+
+	load := bls.NodeLoad{
+		ActiveExecutions: uint(max(w.activeExecutions.Load(), 0)),
+		Capacity:         w.cfg.Capacity,
+		CPUUsage:         math.Float64frombits(w.cpuUsage.Load()),
+	}
+
+	free, err := freeMemoryKB()
+	if err == nil {
+		load.FreeMemoryKB = free
+	}
+
+	return load
+}
+
+// runLoadSampler periodically measures the CPU usage of the node. If executions are confined to a cgroup by the resource limiter,
+// CPU usage of that cgroup is measured instead, as executions do not run in the cgroup of the node.
+func (w *Worker) runLoadSampler(ctx context.Context) {
+
+	prevUsage, err := cpuUsageTime(w.cfg.Cgroup)
+	if err != nil {
+		w.Log().Debug().Err(err).Msg("CPU usage not available, not sampling node load")
+		return
+	}
+	prevTime := time.Now()
+
+	ticker := time.NewTicker(loadSampleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+
+			usage, err := cpuUsageTime(w.cfg.Cgroup)
+			if err != nil {
+				w.Log().Warn().Err(err).Msg("could not read CPU usage")
+				continue
+			}
+			now := time.Now()
+
+			w.cpuUsage.Store(math.Float64bits(cpuPercentage(usage-prevUsage, now.Sub(prevTime), runtime.NumCPU())))
+
+			prevUsage, prevTime = usage, now
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// cpuPercentage returns the CPU time used in the given interval, as a percentage of the CPU time available on all CPUs.
+func cpuPercentage(used time.Duration, interval time.Duration, cpus int) float64 {
+
+	if interval <= 0 || cpus <= 0 {
+		return 0
+	}
+
+	return min(100, 100*float64(used)/(float64(interval)*float64(cpus)))
+}
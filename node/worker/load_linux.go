@@ -0,0 +1,111 @@
+//go:build linux
+
+package worker
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	cgroupMountpoint = "/sys/fs/cgroup"
+	procCgroupPath   = "/proc/self/cgroup"
+	procMeminfoPath  = "/proc/meminfo"
+)
+
+// freeMemoryKB returns the memory available for starting new processes, as reported by the kernel.
+func freeMemoryKB() (uint64, error) {
+
+	value, err := readField(procMeminfoPath, "MemAvailable:")
+	if err != nil {
+		return 0, err
+	}
+
+	// Value is in kB, e.g. `MemAvailable:   12345678 kB`.
+	free, err := strconv.ParseUint(strings.TrimSuffix(value, " kB"), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse available memory: %w", err)
+	}
+
+	return free, nil
+}
+
+// cpuUsageTime returns the total CPU time used by the given cgroup (v2). If the cgroup is not set, the cgroup of the node is used.
+func cpuUsageTime(cgroup string) (time.Duration, error) {
+
+	if cgroup == "" {
+		var err error
+		cgroup, err = nodeCgroup()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return cgroupCPUUsage(filepath.Join(cgroupMountpoint, cgroup))
+}
+
+// nodeCgroup returns the cgroup (v2) of the node process.
+func nodeCgroup() (string, error) {
+
+	data, err := os.ReadFile(procCgroupPath)
+	if err != nil {
+		return "", fmt.Errorf("could not read cgroup info: %w", err)
+	}
+
+	// On cgroup v2 systems, the entry looks like `0::/path/to/cgroup`.
+	for _, line := range strings.Split(string(data), "\n") {
+		path, ok := strings.CutPrefix(line, "0::")
+		if ok {
+			return path, nil
+		}
+	}
+
+	return "", errors.New("cgroup v2 not found")
+}
+
+// cgroupCPUUsage returns the total CPU time used by the cgroup in the given directory.
+func cgroupCPUUsage(dir string) (time.Duration, error) {
+
+	value, err := readField(filepath.Join(dir, "cpu.stat"), "usage_usec")
+	if err != nil {
+		return 0, err
+	}
+
+	usec, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse CPU usage: %w", err)
+	}
+
+	return time.Duration(usec) * time.Microsecond, nil
+}
+
+// readField returns the value of the first line in the file starting with the given name.
+func readField(path string, name string) (string, error) {
+
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("could not open file: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		value, ok := strings.CutPrefix(scanner.Text(), name)
+		if ok {
+			return strings.TrimSpace(value), nil
+		}
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return "", fmt.Errorf("could not read file: %w", err)
+	}
+
+	return "", fmt.Errorf("field not found (file: %s, field: %s)", path, name)
+}
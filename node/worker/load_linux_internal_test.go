@@ -0,0 +1,32 @@
+//go:build linux
+
+package worker
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCgroupCPUUsage(t *testing.T) {
+
+	t.Run("usage is read from cpu.stat", func(t *testing.T) {
+
+		dir := t.TempDir()
+
+		stat := "usage_usec 1500000\nuser_usec 1000000\nsystem_usec 500000\n"
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "cpu.stat"), []byte(stat), 0644))
+
+		usage, err := cgroupCPUUsage(dir)
+		require.NoError(t, err)
+		require.Equal(t, 1500*time.Millisecond, usage)
+	})
+	t.Run("missing cgroup", func(t *testing.T) {
+
+		_, err := cgroupCPUUsage(filepath.Join(t.TempDir(), "missing"))
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,16 @@
+//go:build !linux
+
+package worker
+
+import (
+	"errors"
+	"time"
+)
+
+func freeMemoryKB() (uint64, error) {
+	return 0, errors.New("not supported on this platform")
+}
+
+func cpuUsageTime(string) (time.Duration, error) {
+	return 0, errors.New("not supported on this platform")
+}
@@ -12,6 +12,13 @@ const (
 	consensusClusterSendTimeout = 10 * time.Second
 
 	syncInterval = time.Hour // How often do we recheck function installations.
+
+	loadSampleInterval = 5 * time.Second // How often do we measure CPU usage reported on roll call.
+
+	attributesFetchTimeout = 30 * time.Second // How long do we wait for the IPNS gateway to return the attributes.
+
+	healthMaxFunctions  = 256 // How many installed functions do we list in a health ping.
+	healthMaxAttributes = 64  // How many attributes do we list in a health ping.
 )
 
 // Raft and consensus related parameters.
@@ -25,6 +25,14 @@ func (w *Worker) process(ctx context.Context, from peer.ID, msg string, payload
 		return node.HandleMessage(ctx, from, payload, w.processFormCluster)
 	case bls.MessageDisbandCluster:
 		return node.HandleMessage(ctx, from, payload, w.processDisbandCluster)
+	case bls.MessageCancelExecution:
+		return node.HandleMessage(ctx, from, payload, w.processCancelExecution)
+	case bls.MessageAttestation:
+		return node.HandleMessage(ctx, from, payload, w.processAttestation)
+	case bls.MessageListFunctions:
+		return node.HandleMessage(ctx, from, payload, w.processListFunctions)
+	case bls.MessageUninstallFunction:
+		return node.HandleMessage(ctx, from, payload, w.processUninstallFunction)
 	}
 
 	return fmt.Errorf("unsupported message: %s", msg)
@@ -10,6 +10,7 @@ import (
 	"github.com/blessnetwork/b7s/consensus"
 	"github.com/blessnetwork/b7s/models/codes"
 	"github.com/blessnetwork/b7s/models/request"
+	"github.com/blessnetwork/b7s/node"
 )
 
 func (w *Worker) processRollCall(ctx context.Context, from peer.ID, req request.RollCall) error {
@@ -31,18 +32,38 @@ func (w *Worker) processRollCall(ctx context.Context, from peer.ID, req request.
 
 	if req.Attributes != nil {
 
-		if w.attributes == nil {
+		attributes := w.attributes.Load()
+		if attributes == nil {
 			log.Info().Msg("skipping attributed roll call - no attributes set")
 			return nil
 		}
 
-		err := haveAttributes(*w.attributes, *req.Attributes)
+		err := node.HaveAttributes(*attributes, *req.Attributes)
 		if err != nil {
 			log.Info().Err(err).Msg("skipping attributed roll call - we do not match requested attributes")
 			return nil
 		}
 	}
 
+	// Decline the roll call if we're already running as many executions as we can.
+	load := w.currentLoad()
+	if load.Saturated() {
+
+		log.Info().
+			Uint("active_executions", load.ActiveExecutions).
+			Uint("capacity", load.Capacity).
+			Msg("declining roll call - node at capacity")
+
+		w.Metrics().IncrCounterWithLabels(rollCallsDeclinedMetric, 1, []metrics.Label{{Name: "function", Value: req.FunctionID}})
+
+		err := w.Send(ctx, from, req.Response(codes.NotAvailable).WithLoad(load))
+		if err != nil {
+			return fmt.Errorf("could not send response: %w", err)
+		}
+
+		return nil
+	}
+
 	// Check if we have this function installed.
 	installed, err := w.fstore.IsInstalled(req.FunctionID)
 	if err != nil {
@@ -56,9 +77,32 @@ func (w *Worker) processRollCall(ctx context.Context, from peer.ID, req request.
 		return fmt.Errorf("could not check if function is installed: %w", err)
 	}
 
-	// We don't have this function - install it now.
+	// We don't have this function - install it now, unless it was uninstalled.
 	if !installed {
 
+		retired, err := w.fstore.IsRetired(req.FunctionID)
+		if err != nil {
+			sendErr := w.Send(ctx, from, req.Response(codes.Error))
+			if sendErr != nil {
+				// Log send error but choose to return the original error.
+				log.Error().Err(sendErr).Stringer("to", from).Msg("could not send response")
+			}
+			return fmt.Errorf("could not check if function is retired: %w", err)
+		}
+
+		if retired {
+			log.Info().Msg("declining roll call - function was uninstalled")
+
+			w.Metrics().IncrCounterWithLabels(rollCallsDeclinedMetric, 1, []metrics.Label{{Name: "function", Value: req.FunctionID}})
+
+			err = w.Send(ctx, from, req.Response(codes.NotFound).WithLoad(load))
+			if err != nil {
+				return fmt.Errorf("could not send response: %w", err)
+			}
+
+			return nil
+		}
+
 		log.Info().Msg("roll call but function not installed, installing now")
 
 		err = w.installFunction(ctx, req.FunctionID, manifestURLFromCID(req.FunctionID))
@@ -76,8 +120,10 @@ func (w *Worker) processRollCall(ctx context.Context, from peer.ID, req request.
 
 	w.Metrics().IncrCounterWithLabels(rollCallsAppliedMetric, 1, []metrics.Label{{Name: "function", Value: req.FunctionID}})
 
+	load.FunctionInstalled = installed
+
 	// Send positive response.
-	err = w.Send(ctx, from, req.Response(codes.Accepted))
+	err = w.Send(ctx, from, req.Response(codes.Accepted).WithLoad(load))
 	if err != nil {
 		return fmt.Errorf("could not send response: %w", err)
 	}
@@ -89,7 +135,7 @@ func (w *Worker) processRollCall(ctx context.Context, from peer.ID, req request.
 func (w *Worker) haveRaftClusters() bool {
 
 	found := false
-	w.clusters.WithRLock(func(data map[string]consensusExecutor) {
+	w.clusters.WithRLock(func(data map[string]workerCluster) {
 		for _, cluster := range data {
 			if cluster.Consensus() == consensus.Raft {
 				found = true
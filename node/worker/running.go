@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"sync"
+)
+
+// runningFunctions tracks executions in progress per function, so that functions are not removed while they are being executed.
+type runningFunctions struct {
+	sync.Mutex
+
+	running  map[string]uint
+	removing map[string]struct{}
+}
+
+func newRunningFunctions() *runningFunctions {
+
+	r := runningFunctions{
+		running:  make(map[string]uint),
+		removing: make(map[string]struct{}),
+	}
+
+	return &r
+}
+
+// start records that the function is being executed. It returns false if the function is being removed.
+func (r *runningFunctions) start(cid string) bool {
+	r.Lock()
+	defer r.Unlock()
+
+	_, ok := r.removing[cid]
+	if ok {
+		return false
+	}
+
+	r.running[cid]++
+	return true
+}
+
+// done records that an execution of the function completed.
+func (r *runningFunctions) done(cid string) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.running[cid]--
+	if r.running[cid] == 0 {
+		delete(r.running, cid)
+	}
+}
+
+// remove marks the function as being removed, preventing new executions from starting.
+// It returns false if the function has executions in progress or is already being removed.
+func (r *runningFunctions) remove(cid string) bool {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.running[cid] > 0 {
+		return false
+	}
+
+	_, ok := r.removing[cid]
+	if ok {
+		return false
+	}
+
+	r.removing[cid] = struct{}{}
+	return true
+}
+
+// removed records that the function removal completed.
+func (r *runningFunctions) removed(cid string) {
+	r.Lock()
+	defer r.Unlock()
+
+	delete(r.removing, cid)
+}
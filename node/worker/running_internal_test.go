@@ -0,0 +1,38 @@
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunningFunctions(t *testing.T) {
+
+	const cid = "dummy-cid"
+
+	t.Run("function with executions in progress is not removed", func(t *testing.T) {
+
+		functions := newRunningFunctions()
+
+		require.True(t, functions.start(cid))
+		require.True(t, functions.start(cid))
+		require.False(t, functions.remove(cid))
+
+		functions.done(cid)
+		require.False(t, functions.remove(cid))
+
+		functions.done(cid)
+		require.True(t, functions.remove(cid))
+	})
+	t.Run("function being removed is not executed", func(t *testing.T) {
+
+		functions := newRunningFunctions()
+
+		require.True(t, functions.remove(cid))
+		require.False(t, functions.start(cid))
+		require.False(t, functions.remove(cid))
+
+		functions.removed(cid)
+		require.True(t, functions.start(cid))
+	})
+}
@@ -10,9 +10,10 @@ const (
 )
 
 var (
-	rollCallsSeenMetric    = []string{"node", "rollcalls", "seen"}
-	rollCallsAppliedMetric = []string{"node", "rollcalls", "applied"}
-	workOrderMetric        = []string{"node", "workorders"}
+	rollCallsSeenMetric     = []string{"node", "rollcalls", "seen"}
+	rollCallsAppliedMetric  = []string{"node", "rollcalls", "applied"}
+	rollCallsDeclinedMetric = []string{"node", "rollcalls", "declined"}
+	workOrderMetric         = []string{"node", "workorders"}
 )
 
 var Counters = []prometheus.CounterDefinition{
@@ -24,6 +25,10 @@ var Counters = []prometheus.CounterDefinition{
 		Name: rollCallsAppliedMetric,
 		Help: "Number of roll calls this node applied to.",
 	},
+	{
+		Name: rollCallsDeclinedMetric,
+		Help: "Number of roll calls this node declined because it was at capacity.",
+	},
 	{
 		Name: workOrderMetric,
 		Help: "Number of work orders.",
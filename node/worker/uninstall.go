@@ -0,0 +1,60 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/request"
+)
+
+// processUninstallFunction removes the function from the node and confirms the removal to the head node.
+// Only head nodes set in the configuration can uninstall functions. Functions with executions in progress are not removed.
+func (w *Worker) processUninstallFunction(ctx context.Context, from peer.ID, req request.UninstallFunction) error {
+
+	log := w.Log().With().Stringer("peer", from).Str("request", req.RequestID).Str("cid", req.CID).Logger()
+
+	log.Info().Msg("received request to uninstall function")
+
+	code, message := codes.OK, "uninstalled"
+
+	err := req.Valid()
+	switch {
+	case err != nil:
+		code, message = codes.Invalid, err.Error()
+
+	case !slices.Contains(w.cfg.UninstallPeers, from):
+		log.Warn().Msg("refusing to uninstall function - peer not allowed to uninstall functions")
+		code, message = codes.NotPermitted, "peer not allowed to uninstall functions"
+
+	case !w.functions.remove(req.CID):
+		log.Info().Msg("refusing to uninstall function - executions in progress")
+		code, message = codes.NotAvailable, "function has executions in progress"
+
+	default:
+		err = w.fstore.Uninstall(ctx, req.CID)
+		w.functions.removed(req.CID)
+
+		switch {
+		case errors.Is(err, bls.ErrNotFound):
+			code, message = codes.NotFound, "function not installed"
+		case err != nil:
+			log.Error().Err(err).Msg("could not uninstall function")
+			code, message = codes.Error, "could not uninstall function"
+		default:
+			log.Info().Msg("function uninstalled")
+		}
+	}
+
+	err = w.Send(ctx, from, req.Response(code, message))
+	if err != nil {
+		return fmt.Errorf("could not send the response (peer: %s): %w", from, err)
+	}
+
+	return nil
+}
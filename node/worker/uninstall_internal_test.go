@@ -0,0 +1,144 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/codes"
+	"github.com/blessnetwork/b7s/models/request"
+	"github.com/blessnetwork/b7s/models/response"
+	"github.com/blessnetwork/b7s/testing/mocks"
+)
+
+func TestWorker_ProcessUninstallFunction(t *testing.T) {
+
+	newWorker := func(t *testing.T, fstore *mocks.FStore) (*Worker, *response.UninstallFunction) {
+
+		core := mocks.BaselineNodeCore(t)
+		worker, err := New(core, fstore, mocks.BaselineExecutor(t), Workspace(t.TempDir()), UninstallPeers([]peer.ID{mocks.GenericPeerID}))
+		require.NoError(t, err)
+
+		var sent response.UninstallFunction
+		core.SendFunc = func(_ context.Context, _ peer.ID, msg bls.Message) error {
+			sent = *msg.(*response.UninstallFunction)
+			return nil
+		}
+
+		return worker, &sent
+	}
+
+	req := request.UninstallFunction{
+		RequestID: "request-id",
+		CID:       mocks.GenericFunctionRecord.CID,
+	}
+
+	t.Run("function is uninstalled", func(t *testing.T) {
+
+		var cid string
+		fstore := mocks.BaselineFStore(t)
+		fstore.UninstallFunc = func(_ context.Context, c string) error {
+			cid = c
+			return nil
+		}
+
+		worker, sent := newWorker(t, fstore)
+
+		err := worker.processUninstallFunction(context.Background(), mocks.GenericPeerID, req)
+		require.NoError(t, err)
+
+		require.Equal(t, req.CID, cid)
+		require.Equal(t, codes.OK, sent.Code)
+		require.Equal(t, req.RequestID, sent.RequestID)
+		require.Equal(t, req.CID, sent.CID)
+	})
+	t.Run("function not installed", func(t *testing.T) {
+
+		fstore := mocks.BaselineFStore(t)
+		fstore.UninstallFunc = func(context.Context, string) error {
+			return fmt.Errorf("could not retrieve function: %w", bls.ErrNotFound)
+		}
+
+		worker, sent := newWorker(t, fstore)
+
+		err := worker.processUninstallFunction(context.Background(), mocks.GenericPeerID, req)
+		require.NoError(t, err)
+
+		require.Equal(t, codes.NotFound, sent.Code)
+	})
+	t.Run("function store failure", func(t *testing.T) {
+
+		fstore := mocks.BaselineFStore(t)
+		fstore.UninstallFunc = func(context.Context, string) error {
+			return mocks.GenericError
+		}
+
+		worker, sent := newWorker(t, fstore)
+
+		err := worker.processUninstallFunction(context.Background(), mocks.GenericPeerID, req)
+		require.NoError(t, err)
+
+		require.Equal(t, codes.Error, sent.Code)
+	})
+	t.Run("peer not allowed to uninstall", func(t *testing.T) {
+
+		fstore := mocks.BaselineFStore(t)
+		fstore.UninstallFunc = func(context.Context, string) error {
+			require.FailNow(t, "uninstall should not be called")
+			return nil
+		}
+
+		worker, sent := newWorker(t, fstore)
+
+		err := worker.processUninstallFunction(context.Background(), peer.ID("other-peer"), req)
+		require.NoError(t, err)
+
+		require.Equal(t, codes.NotPermitted, sent.Code)
+	})
+	t.Run("function has executions in progress", func(t *testing.T) {
+
+		fstore := mocks.BaselineFStore(t)
+		fstore.UninstallFunc = func(context.Context, string) error {
+			require.FailNow(t, "uninstall should not be called")
+			return nil
+		}
+
+		worker, sent := newWorker(t, fstore)
+
+		require.True(t, worker.functions.start(req.CID))
+
+		err := worker.processUninstallFunction(context.Background(), mocks.GenericPeerID, req)
+		require.NoError(t, err)
+		require.Equal(t, codes.NotAvailable, sent.Code)
+
+		// Once the execution is done, the function can be uninstalled.
+		worker.functions.done(req.CID)
+
+		fstore.UninstallFunc = func(context.Context, string) error {
+			return nil
+		}
+
+		err = worker.processUninstallFunction(context.Background(), mocks.GenericPeerID, req)
+		require.NoError(t, err)
+		require.Equal(t, codes.OK, sent.Code)
+	})
+	t.Run("missing CID", func(t *testing.T) {
+
+		fstore := mocks.BaselineFStore(t)
+		fstore.UninstallFunc = func(context.Context, string) error {
+			require.FailNow(t, "uninstall should not be called")
+			return nil
+		}
+
+		worker, sent := newWorker(t, fstore)
+
+		err := worker.processUninstallFunction(context.Background(), mocks.GenericPeerID, request.UninstallFunction{RequestID: "request-id"})
+		require.NoError(t, err)
+
+		require.Equal(t, codes.Invalid, sent.Code)
+	})
+}
@@ -1,6 +1,7 @@
 package worker
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -29,11 +30,16 @@ func (w *Worker) processWorkOrder(ctx context.Context, from peer.ID, req request
 	ctx, span := w.Tracer().Start(ctx, spanWorkOrder, trace.WithAttributes(tracing.ExecutionAttributes(requestID, req.Request)...))
 	defer span.End()
 
-	log := w.Log().With().Str("request", requestID).Str("function", req.FunctionID).Logger()
+	log := w.Log().With().Str("request", requestID).Str("function", req.FunctionID).Str("client", req.Client).Logger()
+
+	// Record the origin of the execution, so that only it can cancel it.
+	if w.startExecution(requestID, from) {
+		defer w.executions.Delete(requestID)
+	}
 
 	// NOTE: In case of an error, we do not return early from this function.
 	// Instead, we send the response back to the caller, whatever it may be.
-	code, result, err := w.execute(ctx, requestID, req.Timestamp, req.Request, from)
+	code, result, err := w.execute(ctx, requestID, cmp.Or(req.ClusterID, requestID), req.Timestamp, req.Request, from)
 	if err != nil {
 		log.Error().Err(err).Stringer("peer", from).Msg("execution failed")
 	}
@@ -57,6 +63,14 @@ func (w *Worker) processWorkOrder(ctx context.Context, from peer.ID, req request
 	// Prepare a work order response.
 	res := req.Response(code, result).WithMetadata(metadata)
 
+	// Sign the resource usage so the head node can record it in its usage ledger, and the receipt so the result can be audited.
+	entry, receipt, err := execute.SignExecution(w.Host().PrivateKey(), requestID, req.Request, result)
+	if err != nil {
+		log.Error().Err(err).Msg("could not sign execution")
+	}
+	res.Result.UsageEntry = entry
+	res.Result.Receipt = receipt
+
 	log.Info().Stringer("code", code).Msg("execution complete")
 
 	// Send the response, whatever it may be (success or failure).
@@ -68,7 +82,16 @@ func (w *Worker) processWorkOrder(ctx context.Context, from peer.ID, req request
 	return nil
 }
 
-func (w *Worker) execute(ctx context.Context, requestID string, timestamp time.Time, req execute.Request, from peer.ID) (codes.Code, execute.Result, error) {
+func (w *Worker) execute(ctx context.Context, requestID string, clusterID string, timestamp time.Time, req execute.Request, from peer.ID) (codes.Code, execute.Result, error) {
+
+	w.activeExecutions.Add(1)
+	defer w.activeExecutions.Add(-1)
+
+	// Function is being uninstalled.
+	if !w.functions.start(req.FunctionID) {
+		return codes.NotFound, execute.Result{}, nil
+	}
+	defer w.functions.done(req.FunctionID)
 
 	// Check if we have function in store.
 	functionInstalled, err := w.fstore.IsInstalled(req.FunctionID)
@@ -102,9 +125,9 @@ func (w *Worker) execute(ctx context.Context, requestID string, timestamp time.T
 	}
 
 	// Now we KNOW we need a consensus. A cluster must already exist.
-	cluster, ok := w.clusters.Get(requestID)
+	cluster, ok := w.clusters.Get(clusterID)
 	if !ok {
-		return codes.Error, execute.Result{}, fmt.Errorf("consensus required but no cluster found; omitted cluster formation message or error forming cluster (request: %s)", requestID)
+		return codes.Error, execute.Result{}, fmt.Errorf("consensus required but no cluster found; omitted cluster formation message or error forming cluster (request: %s, cluster: %s)", requestID, clusterID)
 	}
 
 	log := w.Log().With().
@@ -74,6 +74,7 @@ func TestWorker_ProcessWorkOrder(t *testing.T) {
 
 	// Create node core with overrridden Send function.
 	// Send function verifies that the result it is passed to it is what the executor returned.
+	var receipt *execute.Receipt
 	core := mocks.BaselineNodeCore(t)
 	core.SendFunc = func(_ context.Context, _ peer.ID, msg bls.Message) error {
 		er, ok := any(msg).(*response.WorkOrder)
@@ -83,6 +84,7 @@ func TestWorker_ProcessWorkOrder(t *testing.T) {
 		require.Equal(t, result.Result, er.Result.Result.Result) // RuntimeOutput
 		require.Equal(t, result.Usage, er.Result.Result.Usage)
 
+		receipt = er.Result.Receipt
 		return nil
 	}
 
@@ -92,6 +94,12 @@ func TestWorker_ProcessWorkOrder(t *testing.T) {
 
 	err := worker.processWorkOrder(context.Background(), mocks.GenericPeerID, req)
 	require.NoError(t, err)
+
+	// Verify the worker signed a receipt for the execution.
+	require.NotNil(t, receipt)
+	require.Equal(t, worker.Host().ID(), receipt.Worker)
+	require.Equal(t, result.Usage, receipt.Usage)
+	require.NoError(t, receipt.Verify(requestID, req.Request, result.Result))
 }
 
 func TestWorker_ProcessWorkOrder_Metadata(t *testing.T) {
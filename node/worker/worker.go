@@ -3,8 +3,10 @@ package worker
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 
 	"github.com/armon/go-metrics"
+	"github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/blessnetwork/b7s/info"
 	"github.com/blessnetwork/b7s/models/bls"
@@ -23,10 +25,15 @@ type Worker struct {
 	executor bls.Executor
 	fstore   FStore
 
-	attributes *attributes.Attestation
+	attributes atomic.Pointer[attributes.Attestation]
 
-	clusters         *syncmap.Map[string, consensusExecutor] // clusters maps request ID to the cluster the node belongs to.
+	clusters         *syncmap.Map[string, workerCluster] // clusters maps request ID to the cluster the node belongs to.
+	executions       *syncmap.Map[string, peer.ID]       // executions maps request ID to the peer that sent the work order, for executions in progress.
 	executeResponses *waitmap.WaitMap[string, execute.NodeResult]
+	functions        *runningFunctions // functions tracks executions in progress, so functions are not uninstalled while in use.
+
+	activeExecutions atomic.Int64  // activeExecutions is the number of executions currently in progress, reported to head nodes on roll call.
+	cpuUsage         atomic.Uint64 // cpuUsage is the last measured CPU usage percentage, stored as float64 bits.
 }
 
 func New(core node.Core, fstore FStore, executor bls.Executor, options ...Option) (*Worker, error) {
@@ -48,13 +55,15 @@ func New(core node.Core, fstore FStore, executor bls.Executor, options ...Option
 
 		fstore:           fstore,
 		executor:         executor,
-		clusters:         syncmap.New[string, consensusExecutor](),
+		clusters:         syncmap.New[string, workerCluster](),
+		executions:       syncmap.New[string, peer.ID](),
 		executeResponses: waitmap.New[string, execute.NodeResult](1000),
+		functions:        newRunningFunctions(),
 	}
 
 	if cfg.LoadAttributes {
 
-		attributes, err := loadAttributes(core.Host().PublicKey())
+		attributes, err := worker.loadAttributes(context.Background())
 		if err != nil {
 			return nil, fmt.Errorf("could not load attribute data: %w", err)
 		}
@@ -63,7 +72,7 @@ func New(core node.Core, fstore FStore, executor bls.Executor, options ...Option
 			Any("attributes", attributes).
 			Msg("node loaded attributes")
 
-		worker.attributes = &attributes
+		worker.attributes.Store(&attributes)
 	}
 
 	worker.Metrics().SetGaugeWithLabels(node.NodeInfoMetric, 1,
@@ -87,5 +96,11 @@ func (w *Worker) Run(ctx context.Context) error {
 	// Start the function sync in the background to periodically check functions.
 	go w.runSyncLoop(ctx)
 
-	return w.Core.Run(ctx, w.process)
+	go w.runLoadSampler(ctx)
+
+	if w.cfg.LoadAttributes && w.cfg.AttributesRefreshInterval > 0 {
+		go w.runAttributeRefreshLoop(ctx)
+	}
+
+	return w.Core.Run(ctx, w.process, w.healthStatus)
 }
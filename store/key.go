@@ -1,7 +1,14 @@
 package store
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
+	"slices"
+	"time"
+
+	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/execute"
 )
 
 func encodeKey(prefix uint8, segments ...any) []byte {
@@ -31,3 +38,59 @@ func encodeKey(prefix uint8, segments ...any) []byte {
 
 	return key
 }
+
+// timestampKey returns the key segment for the timestamp. Keys with timestamp segments sort chronologically.
+func timestampKey(t time.Time) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(t.UnixNano()))
+}
+
+// executionResultTimeKey returns the index key for the execution result. Keys sort by result creation time.
+func executionResultTimeKey(record bls.ExecutionRecord) []byte {
+	return encodeKey(PrefixExecutionResultTime, timestampKey(record.CreatedAt), record.RequestID)
+}
+
+// pipelineResultTimeKey returns the index key for the pipeline result. Keys sort by result creation time.
+func pipelineResultTimeKey(record bls.PipelineRecord) []byte {
+	return encodeKey(PrefixPipelineResultTime, timestampKey(record.CreatedAt), record.PipelineID)
+}
+
+// apiClientKeyIndexKey returns the index key for the API client with the given API key hash.
+func apiClientKeyIndexKey(keyHash string) []byte {
+	return encodeKey(PrefixAPIClientKey, keyHash)
+}
+
+// usageEntryKey returns the key for the usage entry. Keys sort by entry timestamp.
+func usageEntryKey(entry execute.UsageEntry) []byte {
+	return encodeKey(PrefixUsageEntry, timestampKey(entry.Timestamp), entry.RequestID, entry.Worker.String())
+}
+
+// usageIndexKey returns the index key for the usage entry, for the given client or function. Keys sort by entry timestamp.
+func usageIndexKey(prefix uint8, value string, entry execute.UsageEntry) []byte {
+	return encodeKey(prefix, usageIndexSegment(value), timestampKey(entry.Timestamp), entry.RequestID, entry.Worker.String())
+}
+
+// usageBoundKey returns the key bounding a scan of usage entries, or of a usage index, at the given timestamp.
+func usageBoundKey(prefix []byte, t time.Time) []byte {
+	key := append(slices.Clone(prefix), Separator)
+	return append(key, timestampKey(t)...)
+}
+
+// usageIndexSegment returns the key segment for the client or function in the usage index. Value is hashed so that
+// values containing the separator cannot be mistaken for other values.
+func usageIndexSegment(value string) []byte {
+	hash := sha256.Sum256([]byte(value))
+	return hash[:]
+}
+
+// parseTimeIndexKey returns the creation time and the ID from a creation time index key.
+func parseTimeIndexKey(key []byte) (time.Time, string, error) {
+
+	// Prefix, separator, timestamp, separator, ID.
+	const idOffset = 1 + 1 + 8 + 1
+	if len(key) < idOffset {
+		return time.Time{}, "", fmt.Errorf("invalid index key (key: %x)", key)
+	}
+
+	ts := binary.BigEndian.Uint64(key[2:10])
+	return time.Unix(0, int64(ts)).UTC(), string(key[idOffset:]), nil
+}
@@ -1,8 +1,25 @@
 package store
 
 const (
-	PrefixPeer     = 1
-	PrefixFunction = 2
+	PrefixPeer            = 1
+	PrefixFunction        = 2
+	PrefixExecutionResult = 3
+	PrefixWebhookDelivery = 4
+	PrefixPeerReputation  = 5
+	PrefixSchedule        = 6
+	PrefixAPIClient       = 7
+	PrefixUsageEntry      = 8
+	// Index of execution results by creation time.
+	PrefixExecutionResultTime = 9
+	PrefixPipelineResult      = 10
+	// Index of pipeline results by creation time.
+	PrefixPipelineResultTime = 11
+	PrefixFunctionTombstone  = 12
+	// Index of API clients by API key hash.
+	PrefixAPIClientKey = 13
+	// Indexes of usage entries by client and by function, ordered by timestamp.
+	PrefixUsageEntryClient   = 14
+	PrefixUsageEntryFunction = 15
 )
 
 const (
@@ -2,10 +2,13 @@ package store
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/cockroachdb/pebble"
 	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blessnetwork/b7s/models/bls"
 )
 
 func (s *Store) RemovePeer(_ context.Context, id peer.ID) error {
@@ -35,6 +38,163 @@ func (s *Store) RemoveFunction(_ context.Context, cid string) error {
 	return nil
 }
 
+func (s *Store) RemoveFunctionTombstone(_ context.Context, cid string) error {
+
+	key := encodeKey(PrefixFunctionTombstone, cid)
+	err := s.remove(key)
+	if err != nil {
+		return fmt.Errorf("could not remove function tombstone: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) RemoveExecutionResult(_ context.Context, id string) error {
+
+	key := encodeKey(PrefixExecutionResult, id)
+
+	var record bls.ExecutionRecord
+	err := s.retrieve(key, &record)
+	if err != nil {
+		if errors.Is(err, bls.ErrNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("could not retrieve execution result: %w", err)
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	err = batch.Delete(key, nil)
+	if err != nil {
+		return fmt.Errorf("could not remove execution result: %w", err)
+	}
+
+	err = batch.Delete(executionResultTimeKey(record), nil)
+	if err != nil {
+		return fmt.Errorf("could not remove execution result index: %w", err)
+	}
+
+	err = batch.Commit(pebble.Sync)
+	if err != nil {
+		return fmt.Errorf("could not remove execution result: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) RemovePipelineResult(_ context.Context, id string) error {
+
+	key := encodeKey(PrefixPipelineResult, id)
+
+	var record bls.PipelineRecord
+	err := s.retrieve(key, &record)
+	if err != nil {
+		if errors.Is(err, bls.ErrNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("could not retrieve pipeline result: %w", err)
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	err = batch.Delete(key, nil)
+	if err != nil {
+		return fmt.Errorf("could not remove pipeline result: %w", err)
+	}
+
+	err = batch.Delete(pipelineResultTimeKey(record), nil)
+	if err != nil {
+		return fmt.Errorf("could not remove pipeline result index: %w", err)
+	}
+
+	err = batch.Commit(pebble.Sync)
+	if err != nil {
+		return fmt.Errorf("could not remove pipeline result: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) RemoveWebhookDelivery(_ context.Context, id string) error {
+
+	key := encodeKey(PrefixWebhookDelivery, id)
+	err := s.remove(key)
+	if err != nil {
+		return fmt.Errorf("could not remove webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) RemovePeerReputation(_ context.Context, id peer.ID) error {
+
+	idBytes, err := id.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("could not encode peer ID: %w", err)
+	}
+
+	key := encodeKey(PrefixPeerReputation, idBytes)
+	err = s.remove(key)
+	if err != nil {
+		return fmt.Errorf("could not remove peer reputation: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) RemoveSchedule(_ context.Context, id string) error {
+
+	key := encodeKey(PrefixSchedule, id)
+	err := s.remove(key)
+	if err != nil {
+		return fmt.Errorf("could not remove schedule: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveAPIClient removes the API client, along with its index entry.
+func (s *Store) RemoveAPIClient(_ context.Context, id string) error {
+
+	key := encodeKey(PrefixAPIClient, id)
+
+	var client bls.APIClient
+	err := s.retrieve(key, &client)
+	if err != nil {
+		if errors.Is(err, bls.ErrNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("could not retrieve API client: %w", err)
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	err = batch.Delete(key, nil)
+	if err != nil {
+		return fmt.Errorf("could not remove API client: %w", err)
+	}
+
+	if client.KeyHash != "" {
+		err = batch.Delete(apiClientKeyIndexKey(client.KeyHash), nil)
+		if err != nil {
+			return fmt.Errorf("could not remove API client index: %w", err)
+		}
+	}
+
+	err = batch.Commit(pebble.Sync)
+	if err != nil {
+		return fmt.Errorf("could not remove API client: %w", err)
+	}
+
+	return nil
+}
+
 func (s *Store) remove(key []byte) error {
 	return s.db.Delete(key, pebble.Sync)
 }
@@ -4,11 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/cockroachdb/pebble"
 	"github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/execute"
 )
 
 func (s *Store) RetrievePeer(_ context.Context, id peer.ID) (bls.Peer, error) {
@@ -63,6 +66,18 @@ func (s *Store) RetrieveFunction(_ context.Context, cid string) (bls.FunctionRec
 	return function, nil
 }
 
+func (s *Store) RetrieveFunctionTombstone(_ context.Context, cid string) (bls.FunctionTombstone, error) {
+
+	key := encodeKey(PrefixFunctionTombstone, cid)
+	var tombstone bls.FunctionTombstone
+	err := s.retrieve(key, &tombstone)
+	if err != nil {
+		return bls.FunctionTombstone{}, fmt.Errorf("could not retrieve function tombstone: %w", err)
+	}
+
+	return tombstone, nil
+}
+
 func (s *Store) RetrieveFunctions(_ context.Context) ([]bls.FunctionRecord, error) {
 
 	functions := make([]bls.FunctionRecord, 0)
@@ -86,6 +101,340 @@ func (s *Store) RetrieveFunctions(_ context.Context) ([]bls.FunctionRecord, erro
 	return functions, nil
 }
 
+func (s *Store) RetrieveExecutionResult(_ context.Context, id string) (bls.ExecutionRecord, error) {
+
+	key := encodeKey(PrefixExecutionResult, id)
+	var record bls.ExecutionRecord
+	err := s.retrieve(key, &record)
+	if err != nil {
+		return bls.ExecutionRecord{}, fmt.Errorf("could not retrieve execution result: %w", err)
+	}
+
+	return record, nil
+}
+
+func (s *Store) RetrieveExecutionResults(_ context.Context) ([]bls.ExecutionRecord, error) {
+
+	records := make([]bls.ExecutionRecord, 0)
+
+	opts := prefixIterOptions([]byte{PrefixExecutionResult})
+	it, err := s.db.NewIter(opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not create iterator: %w", err)
+	}
+	for it.First(); it.Valid(); it.Next() {
+
+		var record bls.ExecutionRecord
+		err := s.retrieve(it.Key(), &record)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve execution result (key: %x): %w", it.Key(), err)
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// RetrieveStaleExecutionResults returns the IDs of execution results created before the given time, or not among
+// the `keep` newest results, oldest first. Zero values disable the respective condition. Only the creation time index
+// is read, going from the newest results, so the kept results are not decoded.
+func (s *Store) RetrieveStaleExecutionResults(_ context.Context, before time.Time, keep uint) ([]string, error) {
+
+	ids, err := s.retrieveStale(PrefixExecutionResultTime, before, keep)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve stale execution results: %w", err)
+	}
+
+	return ids, nil
+}
+
+func (s *Store) RetrievePipelineResult(_ context.Context, id string) (bls.PipelineRecord, error) {
+
+	key := encodeKey(PrefixPipelineResult, id)
+	var record bls.PipelineRecord
+	err := s.retrieve(key, &record)
+	if err != nil {
+		return bls.PipelineRecord{}, fmt.Errorf("could not retrieve pipeline result: %w", err)
+	}
+
+	return record, nil
+}
+
+// RetrieveStalePipelineResults returns the IDs of pipeline results created before the given time, or not among
+// the `keep` newest results, oldest first. Zero values disable the respective condition.
+func (s *Store) RetrieveStalePipelineResults(_ context.Context, before time.Time, keep uint) ([]string, error) {
+
+	ids, err := s.retrieveStale(PrefixPipelineResultTime, before, keep)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve stale pipeline results: %w", err)
+	}
+
+	return ids, nil
+}
+
+// retrieveStale returns the IDs from the creation time index with the given prefix, for records created before the
+// given time or not among the `keep` newest records, oldest first.
+func (s *Store) retrieveStale(prefix uint8, before time.Time, keep uint) ([]string, error) {
+
+	if before.IsZero() && keep == 0 {
+		return []string{}, nil
+	}
+
+	opts := prefixIterOptions([]byte{prefix})
+
+	// Without a limit on the number of records, only expired records are of interest.
+	if keep == 0 {
+		opts.UpperBound = encodeKey(prefix, timestampKey(before))
+	}
+
+	it, err := s.db.NewIter(opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not create iterator: %w", err)
+	}
+	defer it.Close()
+
+	var (
+		ids  = make([]string, 0)
+		seen uint
+	)
+	for it.Last(); it.Valid(); it.Prev() {
+
+		seen++
+
+		created, id, err := parseTimeIndexKey(it.Key())
+		if err != nil {
+			return nil, err
+		}
+
+		if (keep > 0 && seen > keep) || (!before.IsZero() && created.Before(before)) {
+			ids = append(ids, id)
+		}
+	}
+
+	slices.Reverse(ids)
+
+	return ids, nil
+}
+
+func (s *Store) RetrieveWebhookDelivery(_ context.Context, id string) (bls.WebhookDelivery, error) {
+
+	key := encodeKey(PrefixWebhookDelivery, id)
+	var record bls.WebhookDelivery
+	err := s.retrieve(key, &record)
+	if err != nil {
+		return bls.WebhookDelivery{}, fmt.Errorf("could not retrieve webhook delivery: %w", err)
+	}
+
+	return record, nil
+}
+
+func (s *Store) RetrieveWebhookDeliveries(_ context.Context) ([]bls.WebhookDelivery, error) {
+
+	records := make([]bls.WebhookDelivery, 0)
+
+	opts := prefixIterOptions([]byte{PrefixWebhookDelivery})
+	it, err := s.db.NewIter(opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not create iterator: %w", err)
+	}
+	defer it.Close()
+
+	for it.First(); it.Valid(); it.Next() {
+
+		var record bls.WebhookDelivery
+		err := s.retrieve(it.Key(), &record)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve webhook delivery (key: %x): %w", it.Key(), err)
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func (s *Store) RetrievePeerReputation(_ context.Context, id peer.ID) (bls.PeerReputation, error) {
+
+	idBytes, err := id.MarshalBinary()
+	if err != nil {
+		return bls.PeerReputation{}, fmt.Errorf("could not serialize peer ID: %w", err)
+	}
+
+	key := encodeKey(PrefixPeerReputation, idBytes)
+	var record bls.PeerReputation
+	err = s.retrieve(key, &record)
+	if err != nil {
+		return bls.PeerReputation{}, fmt.Errorf("could not retrieve peer reputation: %w", err)
+	}
+
+	return record, nil
+}
+
+func (s *Store) RetrievePeerReputations(_ context.Context) ([]bls.PeerReputation, error) {
+
+	records := make([]bls.PeerReputation, 0)
+
+	opts := prefixIterOptions([]byte{PrefixPeerReputation})
+	it, err := s.db.NewIter(opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not create iterator: %w", err)
+	}
+	defer it.Close()
+
+	for it.First(); it.Valid(); it.Next() {
+
+		var record bls.PeerReputation
+		err := s.retrieve(it.Key(), &record)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve peer reputation (key: %x): %w", it.Key(), err)
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func (s *Store) RetrieveSchedule(_ context.Context, id string) (bls.Schedule, error) {
+
+	key := encodeKey(PrefixSchedule, id)
+	var record bls.Schedule
+	err := s.retrieve(key, &record)
+	if err != nil {
+		return bls.Schedule{}, fmt.Errorf("could not retrieve schedule: %w", err)
+	}
+
+	return record, nil
+}
+
+func (s *Store) RetrieveSchedules(_ context.Context) ([]bls.Schedule, error) {
+
+	records := make([]bls.Schedule, 0)
+
+	opts := prefixIterOptions([]byte{PrefixSchedule})
+	it, err := s.db.NewIter(opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not create iterator: %w", err)
+	}
+	defer it.Close()
+
+	for it.First(); it.Valid(); it.Next() {
+
+		var record bls.Schedule
+		err := s.retrieve(it.Key(), &record)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve schedule (key: %x): %w", it.Key(), err)
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func (s *Store) RetrieveAPIClient(_ context.Context, id string) (bls.APIClient, error) {
+
+	key := encodeKey(PrefixAPIClient, id)
+	var client bls.APIClient
+	err := s.retrieve(key, &client)
+	if err != nil {
+		return bls.APIClient{}, fmt.Errorf("could not retrieve API client: %w", err)
+	}
+
+	return client, nil
+}
+
+// RetrieveAPIClientByKey returns the API client with the given API key hash.
+func (s *Store) RetrieveAPIClientByKey(ctx context.Context, keyHash string) (bls.APIClient, error) {
+
+	var id string
+	err := s.retrieve(apiClientKeyIndexKey(keyHash), &id)
+	if err != nil {
+		return bls.APIClient{}, fmt.Errorf("could not retrieve API client index: %w", err)
+	}
+
+	return s.RetrieveAPIClient(ctx, id)
+}
+
+func (s *Store) RetrieveAPIClients(_ context.Context) ([]bls.APIClient, error) {
+
+	clients := make([]bls.APIClient, 0)
+
+	opts := prefixIterOptions([]byte{PrefixAPIClient})
+	it, err := s.db.NewIter(opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not create iterator: %w", err)
+	}
+	defer it.Close()
+
+	for it.First(); it.Valid(); it.Next() {
+
+		var client bls.APIClient
+		err := s.retrieve(it.Key(), &client)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve API client (key: %x): %w", it.Key(), err)
+		}
+
+		clients = append(clients, client)
+	}
+
+	return clients, nil
+}
+
+// RetrieveUsageEntries returns the usage entries that pass the filter, ordered by timestamp.
+// If the filter sets the client or the function, only the index for that client or function is scanned.
+func (s *Store) RetrieveUsageEntries(_ context.Context, filter bls.UsageFilter) ([]execute.UsageEntry, error) {
+
+	// Entry keys and index keys both start with the timestamp, after the prefix.
+	var prefix []byte
+	indexed := true
+	switch {
+	case filter.Client != "":
+		prefix = encodeKey(PrefixUsageEntryClient, usageIndexSegment(filter.Client))
+	case filter.FunctionID != "":
+		prefix = encodeKey(PrefixUsageEntryFunction, usageIndexSegment(filter.FunctionID))
+	default:
+		prefix = []byte{PrefixUsageEntry}
+		indexed = false
+	}
+
+	opts := prefixIterOptions(prefix)
+	if !filter.From.IsZero() {
+		opts.LowerBound = usageBoundKey(prefix, filter.From)
+	}
+	if !filter.To.IsZero() {
+		opts.UpperBound = usageBoundKey(prefix, filter.To)
+	}
+
+	it, err := s.db.NewIter(opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not create iterator: %w", err)
+	}
+	defer it.Close()
+
+	entries := make([]execute.UsageEntry, 0)
+	for it.First(); it.Valid(); it.Next() {
+
+		key := it.Key()
+		if indexed {
+			key = it.Value()
+		}
+
+		var entry execute.UsageEntry
+		err := s.retrieve(key, &entry)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve usage entry (key: %x): %w", key, err)
+		}
+
+		if filter.Matches(entry) {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, nil
+}
+
 func (s *Store) retrieve(key []byte, out any) error {
 
 	value, closer, err := s.db.Get(key)
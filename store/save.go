@@ -2,11 +2,13 @@ package store
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/cockroachdb/pebble"
 
 	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/execute"
 )
 
 func (s *Store) SavePeer(_ context.Context, peer bls.Peer) error {
@@ -36,6 +38,205 @@ func (s *Store) SaveFunction(_ context.Context, function bls.FunctionRecord) err
 	return nil
 }
 
+func (s *Store) SaveFunctionTombstone(_ context.Context, tombstone bls.FunctionTombstone) error {
+
+	key := encodeKey(PrefixFunctionTombstone, tombstone.CID)
+	err := s.save(key, tombstone)
+	if err != nil {
+		return fmt.Errorf("could not save function tombstone: %w", err)
+	}
+
+	return nil
+}
+
+// SaveExecutionResult saves the execution result, along with an index entry used to find results by creation time.
+func (s *Store) SaveExecutionResult(_ context.Context, record bls.ExecutionRecord) error {
+
+	encoded, err := s.codec.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("could not encode value: %w", err)
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	key := encodeKey(PrefixExecutionResult, record.RequestID)
+	err = batch.Set(key, encoded, nil)
+	if err != nil {
+		return fmt.Errorf("could not save execution result: %w", err)
+	}
+
+	err = batch.Set(executionResultTimeKey(record), nil, nil)
+	if err != nil {
+		return fmt.Errorf("could not save execution result index: %w", err)
+	}
+
+	err = batch.Commit(pebble.Sync)
+	if err != nil {
+		return fmt.Errorf("could not save execution result: %w", err)
+	}
+
+	return nil
+}
+
+// SavePipelineResult saves the pipeline result, along with an index entry used to find results by creation time.
+func (s *Store) SavePipelineResult(_ context.Context, record bls.PipelineRecord) error {
+
+	encoded, err := s.codec.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("could not encode value: %w", err)
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	key := encodeKey(PrefixPipelineResult, record.PipelineID)
+	err = batch.Set(key, encoded, nil)
+	if err != nil {
+		return fmt.Errorf("could not save pipeline result: %w", err)
+	}
+
+	err = batch.Set(pipelineResultTimeKey(record), nil, nil)
+	if err != nil {
+		return fmt.Errorf("could not save pipeline result index: %w", err)
+	}
+
+	err = batch.Commit(pebble.Sync)
+	if err != nil {
+		return fmt.Errorf("could not save pipeline result: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) SaveWebhookDelivery(_ context.Context, record bls.WebhookDelivery) error {
+
+	key := encodeKey(PrefixWebhookDelivery, record.RequestID)
+	err := s.save(key, record)
+	if err != nil {
+		return fmt.Errorf("could not save webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) SavePeerReputation(_ context.Context, record bls.PeerReputation) error {
+
+	id, err := record.Peer.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("could not serialize peer ID: %w", err)
+	}
+
+	key := encodeKey(PrefixPeerReputation, id)
+	err = s.save(key, record)
+	if err != nil {
+		return fmt.Errorf("could not save peer reputation: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) SaveSchedule(_ context.Context, record bls.Schedule) error {
+
+	key := encodeKey(PrefixSchedule, record.ID)
+	err := s.save(key, record)
+	if err != nil {
+		return fmt.Errorf("could not save schedule: %w", err)
+	}
+
+	return nil
+}
+
+// SaveAPIClient saves the API client, along with an index entry used to find clients by API key hash.
+func (s *Store) SaveAPIClient(_ context.Context, client bls.APIClient) error {
+
+	key := encodeKey(PrefixAPIClient, client.ID)
+
+	// If the client already exists, its previous API key must no longer be valid.
+	var existing bls.APIClient
+	err := s.retrieve(key, &existing)
+	if err != nil && !errors.Is(err, bls.ErrNotFound) {
+		return fmt.Errorf("could not retrieve existing API client: %w", err)
+	}
+
+	encoded, err := s.codec.Marshal(client)
+	if err != nil {
+		return fmt.Errorf("could not encode value: %w", err)
+	}
+
+	id, err := s.codec.Marshal(client.ID)
+	if err != nil {
+		return fmt.Errorf("could not encode value: %w", err)
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	err = batch.Set(key, encoded, nil)
+	if err != nil {
+		return fmt.Errorf("could not save API client: %w", err)
+	}
+
+	if existing.KeyHash != "" && existing.KeyHash != client.KeyHash {
+		err = batch.Delete(apiClientKeyIndexKey(existing.KeyHash), nil)
+		if err != nil {
+			return fmt.Errorf("could not remove API client index: %w", err)
+		}
+	}
+
+	if client.KeyHash != "" {
+		err = batch.Set(apiClientKeyIndexKey(client.KeyHash), id, nil)
+		if err != nil {
+			return fmt.Errorf("could not save API client index: %w", err)
+		}
+	}
+
+	err = batch.Commit(pebble.Sync)
+	if err != nil {
+		return fmt.Errorf("could not save API client: %w", err)
+	}
+
+	return nil
+}
+
+// SaveUsageEntry saves the usage entry, along with index entries used to find entries by client and by function.
+func (s *Store) SaveUsageEntry(_ context.Context, entry execute.UsageEntry) error {
+
+	encoded, err := s.codec.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("could not encode value: %w", err)
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	key := usageEntryKey(entry)
+	err = batch.Set(key, encoded, nil)
+	if err != nil {
+		return fmt.Errorf("could not save usage entry: %w", err)
+	}
+
+	// Index entries point to the usage entry.
+	if entry.Client != "" {
+		err = batch.Set(usageIndexKey(PrefixUsageEntryClient, entry.Client, entry), key, nil)
+		if err != nil {
+			return fmt.Errorf("could not save usage entry client index: %w", err)
+		}
+	}
+
+	err = batch.Set(usageIndexKey(PrefixUsageEntryFunction, entry.FunctionID, entry), key, nil)
+	if err != nil {
+		return fmt.Errorf("could not save usage entry function index: %w", err)
+	}
+
+	err = batch.Commit(pebble.Sync)
+	if err != nil {
+		return fmt.Errorf("could not save usage entry: %w", err)
+	}
+
+	return nil
+}
+
 func (s *Store) save(key []byte, value any) error {
 
 	encoded, err := s.codec.Marshal(value)
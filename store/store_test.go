@@ -6,11 +6,13 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/require"
 
 	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/execute"
 	"github.com/blessnetwork/b7s/store"
 	"github.com/blessnetwork/b7s/store/codec"
 	"github.com/blessnetwork/b7s/testing/helpers"
@@ -103,6 +105,42 @@ func TestStore_FunctionOperations(t *testing.T) {
 	})
 }
 
+func TestStore_FunctionTombstoneOperations(t *testing.T) {
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	tombstone := bls.FunctionTombstone{
+		CID:       mocks.GenericFunctionRecord.CID,
+		RemovedAt: time.Now().UTC(),
+	}
+	store := store.New(db, codec.NewJSONCodec())
+	ctx := context.Background()
+
+	t.Run("save function tombstone", func(t *testing.T) {
+		err := store.SaveFunctionTombstone(ctx, tombstone)
+		require.NoError(t, err)
+	})
+	t.Run("retrieve function tombstone", func(t *testing.T) {
+		retrieved, err := store.RetrieveFunctionTombstone(ctx, tombstone.CID)
+		require.NoError(t, err)
+
+		require.True(t, tombstone.RemovedAt.Equal(retrieved.RemovedAt))
+		require.Equal(t, tombstone.CID, retrieved.CID)
+	})
+	t.Run("tombstone does not list as a function", func(t *testing.T) {
+		functions, err := store.RetrieveFunctions(ctx)
+		require.NoError(t, err)
+		require.Empty(t, functions)
+	})
+	t.Run("remove function tombstone", func(t *testing.T) {
+		err := store.RemoveFunctionTombstone(ctx, tombstone.CID)
+		require.NoError(t, err)
+
+		_, err = store.RetrieveFunctionTombstone(ctx, tombstone.CID)
+		require.ErrorIs(t, err, bls.ErrNotFound)
+	})
+}
+
 func TestStore_RetrieveFunctions(t *testing.T) {
 	db := helpers.InMemoryDB(t)
 	defer db.Close()
@@ -140,6 +178,392 @@ func TestStore_RetrieveFunctions(t *testing.T) {
 	}
 }
 
+func TestStore_ExecutionResultOperations(t *testing.T) {
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	record := mocks.GenericExecutionRecord
+	store := store.New(db, codec.NewJSONCodec())
+	ctx := context.Background()
+
+	t.Run("save execution result", func(t *testing.T) {
+		err := store.SaveExecutionResult(ctx, record)
+		require.NoError(t, err)
+	})
+	t.Run("retrieve execution result", func(t *testing.T) {
+		retrieved, err := store.RetrieveExecutionResult(ctx, record.RequestID)
+		require.NoError(t, err)
+
+		require.Equal(t, record, retrieved)
+	})
+	t.Run("remove execution result", func(t *testing.T) {
+		err := store.RemoveExecutionResult(ctx, record.RequestID)
+		require.NoError(t, err)
+
+		// Verify execution result is gone.
+		_, err = store.RetrieveExecutionResult(ctx, record.RequestID)
+		require.ErrorIs(t, err, bls.ErrNotFound)
+	})
+}
+
+func TestStore_RetrieveExecutionResults(t *testing.T) {
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+	store := store.New(db, codec.NewJSONCodec())
+	ctx := context.Background()
+
+	count := 10
+	records := make(map[string]bls.ExecutionRecord)
+	for i := 0; i < count; i++ {
+
+		record := mocks.GenericExecutionRecord
+		record.RequestID = fmt.Sprintf("dummy-request-id-%v", i)
+
+		records[record.RequestID] = record
+	}
+
+	// Save execution results.
+	for _, record := range records {
+		err := store.SaveExecutionResult(ctx, record)
+		require.NoError(t, err)
+	}
+
+	// Save a function too, to verify it's not picked up.
+	err := store.SaveFunction(ctx, mocks.GenericFunctionRecord)
+	require.NoError(t, err)
+
+	retrieved, err := store.RetrieveExecutionResults(ctx)
+	require.NoError(t, err)
+	require.Len(t, retrieved, count)
+
+	// Verify execution results.
+	for _, record := range retrieved {
+		require.Equal(t, records[record.RequestID], record)
+	}
+}
+
+func TestStore_RetrieveStaleExecutionResults(t *testing.T) {
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+	store := store.New(db, codec.NewJSONCodec())
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+
+	// Results are created a minute apart, first result is the oldest.
+	count := 5
+	for i := 0; i < count; i++ {
+
+		record := mocks.GenericExecutionRecord
+		record.RequestID = fmt.Sprintf("dummy-request-id-%v", i)
+		record.CreatedAt = now.Add(-time.Duration(count-i) * time.Minute)
+
+		err := store.SaveExecutionResult(ctx, record)
+		require.NoError(t, err)
+	}
+
+	t.Run("no conditions", func(t *testing.T) {
+		ids, err := store.RetrieveStaleExecutionResults(ctx, time.Time{}, 0)
+		require.NoError(t, err)
+		require.Empty(t, ids)
+	})
+	t.Run("created before", func(t *testing.T) {
+		ids, err := store.RetrieveStaleExecutionResults(ctx, now.Add(-3*time.Minute-time.Second), 0)
+		require.NoError(t, err)
+		require.Equal(t, []string{"dummy-request-id-0", "dummy-request-id-1"}, ids)
+	})
+	t.Run("over the limit", func(t *testing.T) {
+		ids, err := store.RetrieveStaleExecutionResults(ctx, time.Time{}, 2)
+		require.NoError(t, err)
+		require.Equal(t, []string{"dummy-request-id-0", "dummy-request-id-1", "dummy-request-id-2"}, ids)
+	})
+	t.Run("removed results are not listed", func(t *testing.T) {
+		err := store.RemoveExecutionResult(ctx, "dummy-request-id-0")
+		require.NoError(t, err)
+
+		ids, err := store.RetrieveStaleExecutionResults(ctx, now, 0)
+		require.NoError(t, err)
+		require.Equal(t, []string{"dummy-request-id-1", "dummy-request-id-2", "dummy-request-id-3", "dummy-request-id-4"}, ids)
+	})
+}
+
+func TestStore_PipelineResultOperations(t *testing.T) {
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	record := mocks.GenericPipelineRecord
+	store := store.New(db, codec.NewJSONCodec())
+	ctx := context.Background()
+
+	t.Run("save pipeline result", func(t *testing.T) {
+		err := store.SavePipelineResult(ctx, record)
+		require.NoError(t, err)
+	})
+	t.Run("retrieve pipeline result", func(t *testing.T) {
+		retrieved, err := store.RetrievePipelineResult(ctx, record.PipelineID)
+		require.NoError(t, err)
+
+		require.Equal(t, record, retrieved)
+	})
+	t.Run("retrieve stale pipeline results", func(t *testing.T) {
+		ids, err := store.RetrieveStalePipelineResults(ctx, record.CreatedAt.Add(time.Second), 0)
+		require.NoError(t, err)
+		require.Equal(t, []string{record.PipelineID}, ids)
+
+		// Execution result index is separate.
+		ids, err = store.RetrieveStaleExecutionResults(ctx, record.CreatedAt.Add(time.Second), 0)
+		require.NoError(t, err)
+		require.Empty(t, ids)
+	})
+	t.Run("remove pipeline result", func(t *testing.T) {
+		err := store.RemovePipelineResult(ctx, record.PipelineID)
+		require.NoError(t, err)
+
+		// Verify pipeline result and its index entry are gone.
+		_, err = store.RetrievePipelineResult(ctx, record.PipelineID)
+		require.ErrorIs(t, err, bls.ErrNotFound)
+
+		ids, err := store.RetrieveStalePipelineResults(ctx, record.CreatedAt.Add(time.Second), 0)
+		require.NoError(t, err)
+		require.Empty(t, ids)
+	})
+}
+
+func TestStore_WebhookDeliveryOperations(t *testing.T) {
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	delivery := mocks.GenericWebhookDelivery
+	store := store.New(db, codec.NewJSONCodec())
+	ctx := context.Background()
+
+	t.Run("save webhook delivery", func(t *testing.T) {
+		err := store.SaveWebhookDelivery(ctx, delivery)
+		require.NoError(t, err)
+	})
+	t.Run("retrieve webhook delivery", func(t *testing.T) {
+		retrieved, err := store.RetrieveWebhookDelivery(ctx, delivery.RequestID)
+		require.NoError(t, err)
+
+		require.Equal(t, delivery, retrieved)
+	})
+	t.Run("retrieve webhook deliveries", func(t *testing.T) {
+		retrieved, err := store.RetrieveWebhookDeliveries(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, []bls.WebhookDelivery{delivery}, retrieved)
+	})
+	t.Run("remove webhook delivery", func(t *testing.T) {
+		err := store.RemoveWebhookDelivery(ctx, delivery.RequestID)
+		require.NoError(t, err)
+
+		// Verify webhook delivery is gone.
+		_, err = store.RetrieveWebhookDelivery(ctx, delivery.RequestID)
+		require.ErrorIs(t, err, bls.ErrNotFound)
+	})
+}
+
+func TestStore_PeerReputationOperations(t *testing.T) {
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	reputation := mocks.GenericPeerReputation
+	store := store.New(db, codec.NewJSONCodec())
+	ctx := context.Background()
+
+	t.Run("save peer reputation", func(t *testing.T) {
+		err := store.SavePeerReputation(ctx, reputation)
+		require.NoError(t, err)
+	})
+	t.Run("retrieve peer reputation", func(t *testing.T) {
+		retrieved, err := store.RetrievePeerReputation(ctx, reputation.Peer)
+		require.NoError(t, err)
+
+		require.Equal(t, reputation, retrieved)
+	})
+	t.Run("retrieve peer reputations", func(t *testing.T) {
+		retrieved, err := store.RetrievePeerReputations(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, []bls.PeerReputation{reputation}, retrieved)
+	})
+	t.Run("remove peer reputation", func(t *testing.T) {
+		err := store.RemovePeerReputation(ctx, reputation.Peer)
+		require.NoError(t, err)
+
+		// Verify peer reputation is gone.
+		_, err = store.RetrievePeerReputation(ctx, reputation.Peer)
+		require.ErrorIs(t, err, bls.ErrNotFound)
+	})
+}
+
+func TestStore_ScheduleOperations(t *testing.T) {
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	schedule := mocks.GenericSchedule
+	store := store.New(db, codec.NewJSONCodec())
+	ctx := context.Background()
+
+	t.Run("save schedule", func(t *testing.T) {
+		err := store.SaveSchedule(ctx, schedule)
+		require.NoError(t, err)
+	})
+	t.Run("retrieve schedule", func(t *testing.T) {
+		retrieved, err := store.RetrieveSchedule(ctx, schedule.ID)
+		require.NoError(t, err)
+
+		require.Equal(t, schedule, retrieved)
+	})
+	t.Run("retrieve schedules", func(t *testing.T) {
+		retrieved, err := store.RetrieveSchedules(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, []bls.Schedule{schedule}, retrieved)
+	})
+	t.Run("remove schedule", func(t *testing.T) {
+		err := store.RemoveSchedule(ctx, schedule.ID)
+		require.NoError(t, err)
+
+		// Verify schedule is gone.
+		_, err = store.RetrieveSchedule(ctx, schedule.ID)
+		require.ErrorIs(t, err, bls.ErrNotFound)
+	})
+}
+
+func TestStore_APIClientOperations(t *testing.T) {
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	client := mocks.GenericAPIClient
+	store := store.New(db, codec.NewJSONCodec())
+	ctx := context.Background()
+
+	t.Run("save API client", func(t *testing.T) {
+		err := store.SaveAPIClient(ctx, client)
+		require.NoError(t, err)
+	})
+	t.Run("retrieve API client", func(t *testing.T) {
+		retrieved, err := store.RetrieveAPIClient(ctx, client.ID)
+		require.NoError(t, err)
+
+		require.Equal(t, client, retrieved)
+	})
+	t.Run("retrieve API clients", func(t *testing.T) {
+		retrieved, err := store.RetrieveAPIClients(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, []bls.APIClient{client}, retrieved)
+	})
+	t.Run("retrieve API client by key", func(t *testing.T) {
+		retrieved, err := store.RetrieveAPIClientByKey(ctx, client.KeyHash)
+		require.NoError(t, err)
+
+		require.Equal(t, client, retrieved)
+	})
+	t.Run("replaced API key is no longer indexed", func(t *testing.T) {
+		updated := client
+		updated.KeyHash = bls.HashAPIKey("other-key")
+
+		err := store.SaveAPIClient(ctx, updated)
+		require.NoError(t, err)
+
+		_, err = store.RetrieveAPIClientByKey(ctx, client.KeyHash)
+		require.ErrorIs(t, err, bls.ErrNotFound)
+
+		retrieved, err := store.RetrieveAPIClientByKey(ctx, updated.KeyHash)
+		require.NoError(t, err)
+		require.Equal(t, updated, retrieved)
+
+		client = updated
+	})
+	t.Run("remove API client", func(t *testing.T) {
+		err := store.RemoveAPIClient(ctx, client.ID)
+		require.NoError(t, err)
+
+		// Verify client is gone.
+		_, err = store.RetrieveAPIClient(ctx, client.ID)
+		require.ErrorIs(t, err, bls.ErrNotFound)
+
+		_, err = store.RetrieveAPIClientByKey(ctx, client.KeyHash)
+		require.ErrorIs(t, err, bls.ErrNotFound)
+	})
+}
+
+func TestStore_UsageEntryOperations(t *testing.T) {
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	store := store.New(db, codec.NewJSONCodec())
+	ctx := context.Background()
+
+	start := mocks.GenericUsageEntry.Timestamp
+
+	count := 5
+	entries := make([]execute.UsageEntry, 0, count)
+	for i := 0; i < count; i++ {
+		entry := mocks.GenericUsageEntry
+		entry.RequestID = fmt.Sprintf("dummy-request-%v", i)
+		entry.Timestamp = start.Add(time.Duration(i) * time.Hour)
+		entry.Client = fmt.Sprintf("dummy-client-%v", i%2)
+		entry.FunctionID = fmt.Sprintf("dummy-function-%v", i%3)
+
+		entries = append(entries, entry)
+	}
+
+	t.Run("save usage entries", func(t *testing.T) {
+		// Save in reverse order to verify entries are ordered by timestamp.
+		for i := len(entries) - 1; i >= 0; i-- {
+			err := store.SaveUsageEntry(ctx, entries[i])
+			require.NoError(t, err)
+		}
+	})
+	t.Run("retrieve all usage entries", func(t *testing.T) {
+		retrieved, err := store.RetrieveUsageEntries(ctx, bls.UsageFilter{})
+		require.NoError(t, err)
+
+		require.Equal(t, entries, retrieved)
+	})
+	t.Run("retrieve usage entries in time range", func(t *testing.T) {
+		retrieved, err := store.RetrieveUsageEntries(ctx, bls.UsageFilter{From: start.Add(time.Hour), To: start.Add(3 * time.Hour)})
+		require.NoError(t, err)
+
+		require.Equal(t, entries[1:3], retrieved)
+	})
+	t.Run("retrieve usage entries with open ended range", func(t *testing.T) {
+		retrieved, err := store.RetrieveUsageEntries(ctx, bls.UsageFilter{From: start.Add(3 * time.Hour)})
+		require.NoError(t, err)
+		require.Equal(t, entries[3:], retrieved)
+
+		retrieved, err = store.RetrieveUsageEntries(ctx, bls.UsageFilter{To: start.Add(time.Hour)})
+		require.NoError(t, err)
+		require.Equal(t, entries[:1], retrieved)
+	})
+	t.Run("retrieve usage entries for client", func(t *testing.T) {
+		retrieved, err := store.RetrieveUsageEntries(ctx, bls.UsageFilter{Client: "dummy-client-0"})
+		require.NoError(t, err)
+		require.Equal(t, []execute.UsageEntry{entries[0], entries[2], entries[4]}, retrieved)
+
+		retrieved, err = store.RetrieveUsageEntries(ctx, bls.UsageFilter{Client: "dummy-client-0", From: start.Add(time.Hour), To: start.Add(4 * time.Hour)})
+		require.NoError(t, err)
+		require.Equal(t, []execute.UsageEntry{entries[2]}, retrieved)
+	})
+	t.Run("retrieve usage entries for function", func(t *testing.T) {
+		retrieved, err := store.RetrieveUsageEntries(ctx, bls.UsageFilter{FunctionID: "dummy-function-0"})
+		require.NoError(t, err)
+		require.Equal(t, []execute.UsageEntry{entries[0], entries[3]}, retrieved)
+
+		retrieved, err = store.RetrieveUsageEntries(ctx, bls.UsageFilter{Client: "dummy-client-1", FunctionID: "dummy-function-0"})
+		require.NoError(t, err)
+		require.Equal(t, []execute.UsageEntry{entries[3]}, retrieved)
+	})
+	t.Run("retrieve usage entries for unknown client", func(t *testing.T) {
+		retrieved, err := store.RetrieveUsageEntries(ctx, bls.UsageFilter{Client: "unknown-client"})
+		require.NoError(t, err)
+		require.Empty(t, retrieved)
+	})
+}
+
 func TestStore_HandlesFailures(t *testing.T) {
 
 	db := helpers.InMemoryDB(t)
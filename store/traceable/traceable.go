@@ -2,12 +2,14 @@ package traceable
 
 import (
 	"context"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/execute"
 	"github.com/blessnetwork/b7s/store"
 	"github.com/blessnetwork/b7s/telemetry/b7ssemconv"
 	"github.com/blessnetwork/b7s/telemetry/tracing"
@@ -49,6 +51,29 @@ func (s *Store) SaveFunction(ctx context.Context, function bls.FunctionRecord) e
 	return s.tracer.WithSpanFromContext(ctx, "SaveFunction", callback, opts...)
 }
 
+func (s *Store) SaveFunctionTombstone(ctx context.Context, tombstone bls.FunctionTombstone) error {
+
+	callback := func() error {
+		return s.store.SaveFunctionTombstone(ctx, tombstone)
+	}
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.FunctionCID.String(tombstone.CID)))
+	return s.tracer.WithSpanFromContext(ctx, "SaveFunctionTombstone", callback, opts...)
+}
+
+func (s *Store) SaveExecutionResult(ctx context.Context, record bls.ExecutionRecord) error {
+
+	callback := func() error {
+		return s.store.SaveExecutionResult(ctx, record)
+	}
+
+	opts := storeSpanOptions(trace.WithAttributes(
+		b7ssemconv.ExecutionRequestID.String(record.RequestID),
+		b7ssemconv.FunctionCID.String(record.FunctionID),
+	))
+	return s.tracer.WithSpanFromContext(ctx, "SaveExecutionResult", callback, opts...)
+}
+
 func (s *Store) RetrievePeer(ctx context.Context, id peer.ID) (bls.Peer, error) {
 
 	var peer bls.Peer
@@ -90,6 +115,20 @@ func (s *Store) RetrieveFunction(ctx context.Context, cid string) (bls.FunctionR
 	return function, err
 }
 
+func (s *Store) RetrieveFunctionTombstone(ctx context.Context, cid string) (bls.FunctionTombstone, error) {
+
+	var tombstone bls.FunctionTombstone
+	var err error
+	callback := func() error {
+		tombstone, err = s.store.RetrieveFunctionTombstone(ctx, cid)
+		return err
+	}
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.FunctionCID.String(cid)))
+	_ = s.tracer.WithSpanFromContext(ctx, "GetFunctionTombstone", callback, opts...)
+	return tombstone, err
+}
+
 func (s *Store) RetrieveFunctions(ctx context.Context) ([]bls.FunctionRecord, error) {
 
 	var functions []bls.FunctionRecord
@@ -103,6 +142,46 @@ func (s *Store) RetrieveFunctions(ctx context.Context) ([]bls.FunctionRecord, er
 	return functions, err
 }
 
+func (s *Store) RetrieveExecutionResult(ctx context.Context, id string) (bls.ExecutionRecord, error) {
+
+	var record bls.ExecutionRecord
+	var err error
+	callback := func() error {
+		record, err = s.store.RetrieveExecutionResult(ctx, id)
+		return err
+	}
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.ExecutionRequestID.String(id)))
+	_ = s.tracer.WithSpanFromContext(ctx, "GetExecutionResult", callback, opts...)
+	return record, err
+}
+
+func (s *Store) RetrieveExecutionResults(ctx context.Context) ([]bls.ExecutionRecord, error) {
+
+	var records []bls.ExecutionRecord
+	var err error
+	callback := func() error {
+		records, err = s.store.RetrieveExecutionResults(ctx)
+		return err
+	}
+
+	_ = s.tracer.WithSpanFromContext(ctx, "ListExecutionResults", callback, storeSpanOptions()...)
+	return records, err
+}
+
+func (s *Store) RetrieveStaleExecutionResults(ctx context.Context, before time.Time, keep uint) ([]string, error) {
+
+	var ids []string
+	var err error
+	callback := func() error {
+		ids, err = s.store.RetrieveStaleExecutionResults(ctx, before, keep)
+		return err
+	}
+
+	_ = s.tracer.WithSpanFromContext(ctx, "ListStaleExecutionResults", callback, storeSpanOptions()...)
+	return ids, err
+}
+
 func (s *Store) RemovePeer(ctx context.Context, id peer.ID) error {
 
 	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.PeerID.String(id.String())))
@@ -123,9 +202,290 @@ func (s *Store) RemoveFunction(ctx context.Context, cid string) error {
 		opts...)
 }
 
+func (s *Store) RemoveFunctionTombstone(ctx context.Context, cid string) error {
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.FunctionCID.String(cid)))
+	return s.tracer.WithSpanFromContext(
+		ctx,
+		"RemoveFunctionTombstone",
+		func() error { return s.store.RemoveFunctionTombstone(ctx, cid) },
+		opts...)
+}
+
+func (s *Store) RemoveExecutionResult(ctx context.Context, id string) error {
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.ExecutionRequestID.String(id)))
+	return s.tracer.WithSpanFromContext(
+		ctx,
+		"RemoveExecutionResult",
+		func() error { return s.store.RemoveExecutionResult(ctx, id) },
+		opts...)
+}
+
 func peerAttributes(peer bls.Peer) []attribute.KeyValue {
 	return []attribute.KeyValue{
 		b7ssemconv.PeerID.String(peer.ID.String()),
 		b7ssemconv.PeerMultiaddr.String(peer.MultiAddr),
 	}
 }
+
+func (s *Store) SaveWebhookDelivery(ctx context.Context, record bls.WebhookDelivery) error {
+
+	callback := func() error {
+		return s.store.SaveWebhookDelivery(ctx, record)
+	}
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.ExecutionRequestID.String(record.RequestID)))
+	return s.tracer.WithSpanFromContext(ctx, "SaveWebhookDelivery", callback, opts...)
+}
+
+func (s *Store) RetrieveWebhookDelivery(ctx context.Context, id string) (bls.WebhookDelivery, error) {
+
+	var record bls.WebhookDelivery
+	var err error
+	callback := func() error {
+		record, err = s.store.RetrieveWebhookDelivery(ctx, id)
+		return err
+	}
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.ExecutionRequestID.String(id)))
+	_ = s.tracer.WithSpanFromContext(ctx, "GetWebhookDelivery", callback, opts...)
+	return record, err
+}
+
+func (s *Store) RetrieveWebhookDeliveries(ctx context.Context) ([]bls.WebhookDelivery, error) {
+
+	var records []bls.WebhookDelivery
+	var err error
+	callback := func() error {
+		records, err = s.store.RetrieveWebhookDeliveries(ctx)
+		return err
+	}
+
+	_ = s.tracer.WithSpanFromContext(ctx, "ListWebhookDeliveries", callback, storeSpanOptions()...)
+	return records, err
+}
+
+func (s *Store) RemoveWebhookDelivery(ctx context.Context, id string) error {
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.ExecutionRequestID.String(id)))
+	return s.tracer.WithSpanFromContext(
+		ctx,
+		"RemoveWebhookDelivery",
+		func() error { return s.store.RemoveWebhookDelivery(ctx, id) },
+		opts...)
+}
+
+func (s *Store) SavePeerReputation(ctx context.Context, record bls.PeerReputation) error {
+
+	callback := func() error {
+		return s.store.SavePeerReputation(ctx, record)
+	}
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.PeerID.String(record.Peer.String())))
+	return s.tracer.WithSpanFromContext(ctx, "SavePeerReputation", callback, opts...)
+}
+
+func (s *Store) RetrievePeerReputation(ctx context.Context, id peer.ID) (bls.PeerReputation, error) {
+
+	var record bls.PeerReputation
+	var err error
+	callback := func() error {
+		record, err = s.store.RetrievePeerReputation(ctx, id)
+		return err
+	}
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.PeerID.String(id.String())))
+	_ = s.tracer.WithSpanFromContext(ctx, "GetPeerReputation", callback, opts...)
+	return record, err
+}
+
+func (s *Store) RetrievePeerReputations(ctx context.Context) ([]bls.PeerReputation, error) {
+
+	var records []bls.PeerReputation
+	var err error
+	callback := func() error {
+		records, err = s.store.RetrievePeerReputations(ctx)
+		return err
+	}
+
+	_ = s.tracer.WithSpanFromContext(ctx, "ListPeerReputations", callback, storeSpanOptions()...)
+	return records, err
+}
+
+func (s *Store) RemovePeerReputation(ctx context.Context, id peer.ID) error {
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.PeerID.String(id.String())))
+	return s.tracer.WithSpanFromContext(
+		ctx,
+		"RemovePeerReputation",
+		func() error { return s.store.RemovePeerReputation(ctx, id) },
+		opts...)
+}
+
+func (s *Store) SavePipelineResult(ctx context.Context, record bls.PipelineRecord) error {
+
+	callback := func() error {
+		return s.store.SavePipelineResult(ctx, record)
+	}
+
+	return s.tracer.WithSpanFromContext(ctx, "SavePipelineResult", callback, storeSpanOptions()...)
+}
+
+func (s *Store) RetrievePipelineResult(ctx context.Context, id string) (bls.PipelineRecord, error) {
+
+	var record bls.PipelineRecord
+	var err error
+	callback := func() error {
+		record, err = s.store.RetrievePipelineResult(ctx, id)
+		return err
+	}
+
+	_ = s.tracer.WithSpanFromContext(ctx, "GetPipelineResult", callback, storeSpanOptions()...)
+	return record, err
+}
+
+func (s *Store) RetrieveStalePipelineResults(ctx context.Context, before time.Time, keep uint) ([]string, error) {
+
+	var ids []string
+	var err error
+	callback := func() error {
+		ids, err = s.store.RetrieveStalePipelineResults(ctx, before, keep)
+		return err
+	}
+
+	_ = s.tracer.WithSpanFromContext(ctx, "ListStalePipelineResults", callback, storeSpanOptions()...)
+	return ids, err
+}
+
+func (s *Store) RemovePipelineResult(ctx context.Context, id string) error {
+
+	return s.tracer.WithSpanFromContext(
+		ctx,
+		"RemovePipelineResult",
+		func() error { return s.store.RemovePipelineResult(ctx, id) },
+		storeSpanOptions()...)
+}
+
+func (s *Store) SaveSchedule(ctx context.Context, record bls.Schedule) error {
+
+	callback := func() error {
+		return s.store.SaveSchedule(ctx, record)
+	}
+
+	return s.tracer.WithSpanFromContext(ctx, "SaveSchedule", callback, storeSpanOptions()...)
+}
+
+func (s *Store) RetrieveSchedule(ctx context.Context, id string) (bls.Schedule, error) {
+
+	var record bls.Schedule
+	var err error
+	callback := func() error {
+		record, err = s.store.RetrieveSchedule(ctx, id)
+		return err
+	}
+
+	_ = s.tracer.WithSpanFromContext(ctx, "GetSchedule", callback, storeSpanOptions()...)
+	return record, err
+}
+
+func (s *Store) RetrieveSchedules(ctx context.Context) ([]bls.Schedule, error) {
+
+	var records []bls.Schedule
+	var err error
+	callback := func() error {
+		records, err = s.store.RetrieveSchedules(ctx)
+		return err
+	}
+
+	_ = s.tracer.WithSpanFromContext(ctx, "ListSchedules", callback, storeSpanOptions()...)
+	return records, err
+}
+
+func (s *Store) RemoveSchedule(ctx context.Context, id string) error {
+
+	return s.tracer.WithSpanFromContext(
+		ctx,
+		"RemoveSchedule",
+		func() error { return s.store.RemoveSchedule(ctx, id) },
+		storeSpanOptions()...)
+}
+
+func (s *Store) SaveAPIClient(ctx context.Context, client bls.APIClient) error {
+
+	callback := func() error {
+		return s.store.SaveAPIClient(ctx, client)
+	}
+
+	return s.tracer.WithSpanFromContext(ctx, "SaveAPIClient", callback, storeSpanOptions()...)
+}
+
+func (s *Store) RetrieveAPIClient(ctx context.Context, id string) (bls.APIClient, error) {
+
+	var client bls.APIClient
+	var err error
+	callback := func() error {
+		client, err = s.store.RetrieveAPIClient(ctx, id)
+		return err
+	}
+
+	_ = s.tracer.WithSpanFromContext(ctx, "GetAPIClient", callback, storeSpanOptions()...)
+	return client, err
+}
+
+func (s *Store) RetrieveAPIClientByKey(ctx context.Context, keyHash string) (bls.APIClient, error) {
+
+	var client bls.APIClient
+	var err error
+	callback := func() error {
+		client, err = s.store.RetrieveAPIClientByKey(ctx, keyHash)
+		return err
+	}
+
+	_ = s.tracer.WithSpanFromContext(ctx, "GetAPIClientByKey", callback, storeSpanOptions()...)
+	return client, err
+}
+
+func (s *Store) RetrieveAPIClients(ctx context.Context) ([]bls.APIClient, error) {
+
+	var clients []bls.APIClient
+	var err error
+	callback := func() error {
+		clients, err = s.store.RetrieveAPIClients(ctx)
+		return err
+	}
+
+	_ = s.tracer.WithSpanFromContext(ctx, "ListAPIClients", callback, storeSpanOptions()...)
+	return clients, err
+}
+
+func (s *Store) RemoveAPIClient(ctx context.Context, id string) error {
+
+	return s.tracer.WithSpanFromContext(
+		ctx,
+		"RemoveAPIClient",
+		func() error { return s.store.RemoveAPIClient(ctx, id) },
+		storeSpanOptions()...)
+}
+
+func (s *Store) SaveUsageEntry(ctx context.Context, entry execute.UsageEntry) error {
+
+	callback := func() error {
+		return s.store.SaveUsageEntry(ctx, entry)
+	}
+
+	return s.tracer.WithSpanFromContext(ctx, "SaveUsageEntry", callback, storeSpanOptions()...)
+}
+
+func (s *Store) RetrieveUsageEntries(ctx context.Context, filter bls.UsageFilter) ([]execute.UsageEntry, error) {
+
+	var entries []execute.UsageEntry
+	var err error
+	callback := func() error {
+		entries, err = s.store.RetrieveUsageEntries(ctx, filter)
+		return err
+	}
+
+	_ = s.tracer.WithSpanFromContext(ctx, "ListUsageEntries", callback, storeSpanOptions()...)
+	return entries, err
+}
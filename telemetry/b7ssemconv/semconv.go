@@ -30,6 +30,10 @@ const (
 	ExecutionRequestID = attribute.Key("execution.request.id")
 )
 
+const (
+	ClientID = attribute.Key("client.id")
+)
+
 const (
 	PeerID         = attribute.Key("peer.id")
 	PeerMultiaddr  = attribute.Key("peer.multiaddr")
@@ -47,11 +47,18 @@ func SpanAttributes(attributes []attribute.KeyValue) []trace.SpanStartOption {
 }
 
 func ExecutionAttributes(requestID string, req execute.Request) []attribute.KeyValue {
-	return []attribute.KeyValue{
+
+	attrs := []attribute.KeyValue{
 		b7ssemconv.FunctionCID.String(req.FunctionID),
 		b7ssemconv.FunctionMethod.String(req.Method),
 		b7ssemconv.ExecutionNodeCount.Int(req.Config.NodeCount),
 		b7ssemconv.ExecutionConsensus.String(req.Config.ConsensusAlgorithm),
 		b7ssemconv.ExecutionRequestID.String(requestID),
 	}
+
+	if req.Client != "" {
+		attrs = append(attrs, b7ssemconv.ClientID.String(req.Client))
+	}
+
+	return attrs
 }
@@ -33,7 +33,7 @@ type NodeCore struct {
 	PublishToTopicFunc func(context.Context, string, bls.Message) error
 	TracerFunc         func() *tracing.Tracer
 	MetricsFunc        func() *metrics.Metrics
-	RunFunc            func(context.Context, func(context.Context, peer.ID, string, []byte) error) error
+	RunFunc            func(context.Context, func(context.Context, peer.ID, string, []byte) error, func(context.Context) bls.NodeStatus) error
 }
 
 func BaselineNodeCore(t *testing.T) *NodeCore {
@@ -88,7 +88,7 @@ func BaselineNodeCore(t *testing.T) *NodeCore {
 		MetricsFunc: func() *metrics.Metrics {
 			return mh
 		},
-		RunFunc: func(context.Context, func(context.Context, peer.ID, string, []byte) error) error {
+		RunFunc: func(context.Context, func(context.Context, peer.ID, string, []byte) error, func(context.Context) bls.NodeStatus) error {
 			return nil
 		},
 	}
@@ -144,6 +144,6 @@ func (c NodeCore) Metrics() *metrics.Metrics {
 	return c.MetricsFunc()
 }
 
-func (c NodeCore) Run(context.Context, func(context.Context, peer.ID, string, []byte) error) error {
+func (c NodeCore) Run(context.Context, func(context.Context, peer.ID, string, []byte) error, func(context.Context) bls.NodeStatus) error {
 	return nil
 }
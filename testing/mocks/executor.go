@@ -12,6 +12,7 @@ var _ (bls.Executor) = (*Executor)(nil)
 
 type Executor struct {
 	ExecFunctionFunc func(context.Context, string, execute.Request) (execute.Result, error)
+	CancelFunc       func(string) error
 }
 
 func BaselineExecutor(t *testing.T) *Executor {
@@ -21,6 +22,9 @@ func BaselineExecutor(t *testing.T) *Executor {
 		ExecFunctionFunc: func(context.Context, string, execute.Request) (execute.Result, error) {
 			return GenericExecutionResult, nil
 		},
+		CancelFunc: func(string) error {
+			return nil
+		},
 	}
 
 	return &executor
@@ -29,3 +33,7 @@ func BaselineExecutor(t *testing.T) *Executor {
 func (e *Executor) ExecuteFunction(ctx context.Context, requestID string, req execute.Request) (execute.Result, error) {
 	return e.ExecFunctionFunc(ctx, requestID, req)
 }
+
+func (e *Executor) Cancel(requestID string) error {
+	return e.CancelFunc(requestID)
+}
@@ -3,12 +3,17 @@ package mocks
 import (
 	"context"
 	"testing"
+
+	"github.com/blessnetwork/b7s/models/bls"
 )
 
 type FStore struct {
-	InstallFunc     func(context.Context, string, string) error
-	IsInstalledFunc func(string) (bool, error)
-	SyncFunc        func(context.Context, bool) error
+	InstallFunc            func(context.Context, string, string) error
+	IsInstalledFunc        func(string) (bool, error)
+	UninstallFunc          func(context.Context, string) error
+	IsRetiredFunc          func(string) (bool, error)
+	InstalledFunctionsFunc func(context.Context) ([]bls.FunctionRecord, error)
+	SyncFunc               func(context.Context, bool) error
 }
 
 func BaselineFStore(t *testing.T) *FStore {
@@ -21,6 +26,15 @@ func BaselineFStore(t *testing.T) *FStore {
 		IsInstalledFunc: func(string) (bool, error) {
 			return true, nil
 		},
+		UninstallFunc: func(context.Context, string) error {
+			return nil
+		},
+		IsRetiredFunc: func(string) (bool, error) {
+			return false, nil
+		},
+		InstalledFunctionsFunc: func(context.Context) ([]bls.FunctionRecord, error) {
+			return []bls.FunctionRecord{GenericFunctionRecord}, nil
+		},
 		SyncFunc: func(context.Context, bool) error {
 			return nil
 		},
@@ -37,6 +51,18 @@ func (f *FStore) IsInstalled(cid string) (bool, error) {
 	return f.IsInstalledFunc(cid)
 }
 
+func (f *FStore) Uninstall(ctx context.Context, cid string) error {
+	return f.UninstallFunc(ctx, cid)
+}
+
+func (f *FStore) IsRetired(cid string) (bool, error) {
+	return f.IsRetiredFunc(cid)
+}
+
+func (f *FStore) InstalledFunctions(ctx context.Context) ([]bls.FunctionRecord, error) {
+	return f.InstalledFunctionsFunc(ctx)
+}
+
 func (f *FStore) Sync(ctx context.Context, haltOnError bool) error {
 	return f.SyncFunc(ctx, haltOnError)
 }
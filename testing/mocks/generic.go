@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -13,6 +14,7 @@ import (
 	"github.com/blessnetwork/b7s/models/bls"
 	"github.com/blessnetwork/b7s/models/codes"
 	"github.com/blessnetwork/b7s/models/execute"
+	"github.com/blessnetwork/b7s/node/aggregate"
 )
 
 // Global variables that can be used for testing. They are valid non-nil values for commonly needed types.
@@ -46,6 +48,154 @@ var (
 		},
 	}
 
+	GenericExecutionRecord = bls.ExecutionRecord{
+		RequestID:  GenericUUID.String(),
+		FunctionID: "generic-function-id",
+		Method:     "wasm",
+		Code:       codes.OK,
+		Results:    GenericExecutionResultMap,
+		Cluster: execute.Cluster{
+			Peers: []peer.ID{GenericPeerID},
+		},
+		Aggregated: aggregate.Results{
+			{
+				Result:    GenericExecutionResult.Result,
+				Peers:     []peer.ID{GenericPeerID},
+				Frequency: 100,
+			},
+		},
+		CreatedAt: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	GenericPipelineRecord = bls.PipelineRecord{
+		PipelineID: GenericUUID.String(),
+		Code:       codes.OK,
+		Steps: map[string]bls.ExecutionRecord{
+			"generic-step": GenericExecutionRecord,
+		},
+		CreatedAt: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	GenericWebhookDelivery = bls.WebhookDelivery{
+		RequestID:  GenericUUID.String(),
+		URL:        "https://example.com/webhook",
+		Payload:    []byte(`{"code":"200"}`),
+		Status:     bls.WebhookDeliveryDelivered,
+		Attempts:   1,
+		StatusCode: 200,
+		CreatedAt:  time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:  time.Date(2024, time.January, 1, 0, 0, 1, 0, time.UTC),
+	}
+
+	GenericSchedule = bls.Schedule{
+		ID:        GenericUUID.String(),
+		Cron:      "*/5 * * * *",
+		Request:   GenericExecutionRequest,
+		NextRun:   time.Date(2024, time.January, 1, 0, 5, 0, 0, time.UTC),
+		CreatedAt: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	GenericPeerReputation = bls.PeerReputation{
+		Peer:      GenericPeerID,
+		RollCalls: 10,
+		Successes: 9,
+		Timeouts:  1,
+		Score:     10.0 / 11.0,
+		UpdatedAt: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	GenericPeerInfo = bls.PeerInfo{
+		ID: GenericPeerID,
+		NodeStatus: bls.NodeStatus{
+			Version:   "dummy-version",
+			Role:      bls.WorkerNodeLabel,
+			Topics:    []string{bls.DefaultTopic},
+			Load:      &bls.NodeLoad{ActiveExecutions: 1, Capacity: 10},
+			Functions: []string{GenericString},
+		},
+		LastSeen: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	GenericAPIKey    = "dummy-api-key"
+	GenericAPIClient = bls.APIClient{
+		ID:        "dummy-client",
+		KeyHash:   bls.HashAPIKey(GenericAPIKey),
+		CreatedAt: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	GenericUsageEntry = execute.UsageEntry{
+		RequestID:  GenericUUID.String(),
+		FunctionID: "generic-function-id",
+		Client:     GenericAPIClient.ID,
+		Worker:     GenericPeerID,
+		Usage: execute.Usage{
+			WallClockTime: time.Second,
+			CPUUserTime:   300 * time.Millisecond,
+			CPUSysTime:    100 * time.Millisecond,
+			MemoryMaxKB:   2048,
+		},
+		Timestamp: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	GenericFunctionInstallRecord = bls.FunctionInstallRecord{
+		ID:      GenericUUID.String(),
+		CID:     "dummy-cid",
+		Topic:   bls.DefaultTopic,
+		Started: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Workers: []bls.WorkerInstallStatus{
+			{
+				Peer:     GenericPeerID,
+				Status:   bls.FunctionInstalled,
+				Code:     codes.Accepted,
+				Message:  "installed",
+				Received: time.Date(2024, time.January, 1, 0, 0, 1, 0, time.UTC),
+			},
+		},
+	}
+
+	GenericFunctionUninstallResult = bls.FunctionUninstallResult{
+		Peer:    GenericPeerID,
+		Code:    codes.OK,
+		Message: "uninstalled",
+	}
+
+	GenericWorkerFunctions = []bls.WorkerFunctions{
+		{
+			Peer: GenericPeerIDs[0],
+			Functions: []bls.InstalledFunction{
+				{CID: "dummy-cid", Version: "1.0.0", LastRetrieved: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)},
+				{CID: "other-cid", Version: "1.0.0", LastRetrieved: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			Peer: GenericPeerIDs[1],
+			Functions: []bls.InstalledFunction{
+				{CID: "dummy-cid", Version: "1.1.0", LastRetrieved: time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+	}
+
+	GenericExecutionEvents = []bls.ExecutionEvent{
+		{
+			RequestID: GenericUUID.String(),
+			Type:      bls.ExecutionEventRollCallStarted,
+			Timestamp: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			RequestID: GenericUUID.String(),
+			Type:      bls.ExecutionEventPeerReported,
+			Timestamp: time.Date(2024, time.January, 1, 0, 0, 1, 0, time.UTC),
+			Peer:      GenericPeerID,
+		},
+		{
+			RequestID: GenericUUID.String(),
+			Type:      bls.ExecutionEventDone,
+			Timestamp: time.Date(2024, time.January, 1, 0, 0, 2, 0, time.UTC),
+			Code:      codes.OK,
+			Results:   GenericExecutionRecord.Aggregated,
+		},
+	}
+
 	GenericExecutionRequest = execute.Request{
 		FunctionID: "generic-function-id",
 		Method:     "wasm",
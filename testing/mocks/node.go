@@ -4,45 +4,215 @@ import (
 	"context"
 	"testing"
 
+	"github.com/blessnetwork/b7s/models/bls"
 	"github.com/blessnetwork/b7s/models/codes"
 	"github.com/blessnetwork/b7s/models/execute"
 )
 
 // APINode implements the `Node` interface expected by the API.
 type APINode struct {
-	ExecuteFunctionFunc        func(context.Context, execute.Request, string) (codes.Code, string, execute.ResultMap, execute.Cluster, error)
-	ExecutionResultFunc        func(id string) (execute.ResultMap, bool)
-	PublishFunctionInstallFunc func(ctx context.Context, uri string, cid string, subgroup string) error
+	ExecuteFunctionFunc          func(context.Context, execute.Request, string, bls.Webhook) (codes.Code, string, execute.ResultMap, execute.Cluster, error)
+	ExecuteFunctionAsyncFunc     func(context.Context, execute.Request, string, bls.Webhook) (string, error)
+	ExecuteFunctionBatchFunc     func(context.Context, []execute.Request, string, uint) []bls.ExecutionRecord
+	ExecutePipelineFunc          func(context.Context, execute.Pipeline, string) (bls.PipelineRecord, error)
+	PipelineResultFunc           func(ctx context.Context, id string) (bls.PipelineRecord, error)
+	ExecutionResultFunc          func(ctx context.Context, id string) (bls.ExecutionRecord, error)
+	ExecutionStatusFunc          func(ctx context.Context, id string) (bls.ExecutionStatus, error)
+	ExecutionEventsFunc          func(ctx context.Context, id string) (<-chan bls.ExecutionEvent, error)
+	CancelExecutionFunc          func(ctx context.Context, id string) error
+	PublishFunctionInstallFunc   func(ctx context.Context, uri string, cid string, subgroup string) (string, error)
+	FunctionInstallStatusFunc    func(ctx context.Context, id string) (bls.FunctionInstallRecord, error)
+	WaitFunctionInstallFunc      func(ctx context.Context, id string, workers uint) (bls.FunctionInstallRecord, error)
+	PublishFunctionUninstallFunc func(ctx context.Context, cid string, subgroup string) ([]bls.FunctionUninstallResult, error)
+	FunctionInventoryFunc        func(ctx context.Context, subgroup string) ([]bls.WorkerFunctions, error)
+	PeersFunc                    func(ctx context.Context) ([]bls.PeerInfo, error)
+	PeerReputationsFunc          func(ctx context.Context) ([]bls.PeerReputation, error)
+	CreateScheduleFunc           func(context.Context, string, execute.Request, string, bls.Webhook) (bls.Schedule, error)
+	SchedulesFunc                func(context.Context) ([]bls.Schedule, error)
+	PauseScheduleFunc            func(context.Context, string, bool) (bls.Schedule, error)
+	DeleteScheduleFunc           func(context.Context, string) error
+	UsageEntriesFunc             func(context.Context, bls.UsageFilter) ([]execute.UsageEntry, error)
 }
 
 func BaselineNode(t *testing.T) *APINode {
 	t.Helper()
 
 	node := APINode{
-		ExecuteFunctionFunc: func(context.Context, execute.Request, string) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+		ExecuteFunctionFunc: func(context.Context, execute.Request, string, bls.Webhook) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
 
 			// TODO: Add a generic cluster info
 			return GenericExecutionResult.Code, GenericUUID.String(), GenericExecutionResultMap, execute.Cluster{}, nil
 		},
-		ExecutionResultFunc: func(id string) (execute.ResultMap, bool) {
-			return GenericExecutionResultMap, true
+		ExecuteFunctionAsyncFunc: func(context.Context, execute.Request, string, bls.Webhook) (string, error) {
+			return GenericUUID.String(), nil
 		},
-		PublishFunctionInstallFunc: func(ctx context.Context, uri string, cid string, subgroup string) error {
+		ExecuteFunctionBatchFunc: func(_ context.Context, reqs []execute.Request, _ string, _ uint) []bls.ExecutionRecord {
+			records := make([]bls.ExecutionRecord, 0, len(reqs))
+			for range reqs {
+				records = append(records, GenericExecutionRecord)
+			}
+			return records
+		},
+		ExecutePipelineFunc: func(_ context.Context, pipeline execute.Pipeline, _ string) (bls.PipelineRecord, error) {
+			record := bls.PipelineRecord{
+				PipelineID: GenericUUID.String(),
+				Code:       codes.OK,
+				Steps:      make(map[string]bls.ExecutionRecord, len(pipeline.Steps)),
+			}
+			for _, step := range pipeline.Steps {
+				record.Steps[step.ID] = GenericExecutionRecord
+			}
+			return record, nil
+		},
+		PipelineResultFunc: func(ctx context.Context, id string) (bls.PipelineRecord, error) {
+			return GenericPipelineRecord, nil
+		},
+		ExecutionResultFunc: func(ctx context.Context, id string) (bls.ExecutionRecord, error) {
+			return GenericExecutionRecord, nil
+		},
+		ExecutionStatusFunc: func(ctx context.Context, id string) (bls.ExecutionStatus, error) {
+			status := bls.ExecutionStatus{
+				RequestID: GenericExecutionRecord.RequestID,
+				Phase:     bls.ExecutionPhaseDone,
+				Record:    &GenericExecutionRecord,
+			}
+			return status, nil
+		},
+		ExecutionEventsFunc: func(ctx context.Context, id string) (<-chan bls.ExecutionEvent, error) {
+			events := make(chan bls.ExecutionEvent, len(GenericExecutionEvents))
+			for _, event := range GenericExecutionEvents {
+				events <- event
+			}
+			close(events)
+
+			return events, nil
+		},
+		CancelExecutionFunc: func(ctx context.Context, id string) error {
+			return nil
+		},
+		PublishFunctionInstallFunc: func(ctx context.Context, uri string, cid string, subgroup string) (string, error) {
+			return GenericUUID.String(), nil
+		},
+		FunctionInstallStatusFunc: func(ctx context.Context, id string) (bls.FunctionInstallRecord, error) {
+			return GenericFunctionInstallRecord, nil
+		},
+		WaitFunctionInstallFunc: func(ctx context.Context, id string, workers uint) (bls.FunctionInstallRecord, error) {
+			return GenericFunctionInstallRecord, nil
+		},
+		PublishFunctionUninstallFunc: func(ctx context.Context, cid string, subgroup string) ([]bls.FunctionUninstallResult, error) {
+			return []bls.FunctionUninstallResult{GenericFunctionUninstallResult}, nil
+		},
+		FunctionInventoryFunc: func(ctx context.Context, subgroup string) ([]bls.WorkerFunctions, error) {
+			return GenericWorkerFunctions, nil
+		},
+		PeersFunc: func(ctx context.Context) ([]bls.PeerInfo, error) {
+			return []bls.PeerInfo{GenericPeerInfo}, nil
+		},
+		PeerReputationsFunc: func(ctx context.Context) ([]bls.PeerReputation, error) {
+			return []bls.PeerReputation{GenericPeerReputation}, nil
+		},
+		CreateScheduleFunc: func(context.Context, string, execute.Request, string, bls.Webhook) (bls.Schedule, error) {
+			return GenericSchedule, nil
+		},
+		SchedulesFunc: func(context.Context) ([]bls.Schedule, error) {
+			return []bls.Schedule{GenericSchedule}, nil
+		},
+		PauseScheduleFunc: func(_ context.Context, _ string, paused bool) (bls.Schedule, error) {
+			schedule := GenericSchedule
+			schedule.Paused = paused
+			return schedule, nil
+		},
+		DeleteScheduleFunc: func(context.Context, string) error {
 			return nil
 		},
+		UsageEntriesFunc: func(context.Context, bls.UsageFilter) ([]execute.UsageEntry, error) {
+			return []execute.UsageEntry{GenericUsageEntry}, nil
+		},
 	}
 
 	return &node
 }
 
-func (n *APINode) ExecuteFunction(ctx context.Context, req execute.Request, subgroup string) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
-	return n.ExecuteFunctionFunc(ctx, req, subgroup)
+func (n *APINode) ExecuteFunction(ctx context.Context, req execute.Request, subgroup string, webhook bls.Webhook) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+	return n.ExecuteFunctionFunc(ctx, req, subgroup, webhook)
+}
+
+func (n *APINode) ExecuteFunctionAsync(ctx context.Context, req execute.Request, subgroup string, webhook bls.Webhook) (string, error) {
+	return n.ExecuteFunctionAsyncFunc(ctx, req, subgroup, webhook)
+}
+
+func (n *APINode) ExecuteFunctionBatch(ctx context.Context, reqs []execute.Request, subgroup string, parallelism uint) []bls.ExecutionRecord {
+	return n.ExecuteFunctionBatchFunc(ctx, reqs, subgroup, parallelism)
+}
+
+func (n *APINode) ExecutePipeline(ctx context.Context, pipeline execute.Pipeline, subgroup string) (bls.PipelineRecord, error) {
+	return n.ExecutePipelineFunc(ctx, pipeline, subgroup)
+}
+
+func (n *APINode) PipelineResult(ctx context.Context, id string) (bls.PipelineRecord, error) {
+	return n.PipelineResultFunc(ctx, id)
 }
 
-func (n *APINode) ExecutionResult(id string) (execute.ResultMap, bool) {
-	return n.ExecutionResultFunc(id)
+func (n *APINode) ExecutionStatus(ctx context.Context, id string) (bls.ExecutionStatus, error) {
+	return n.ExecutionStatusFunc(ctx, id)
 }
 
-func (n *APINode) PublishFunctionInstall(ctx context.Context, uri string, cid string, subgroup string) error {
+func (n *APINode) ExecutionEvents(ctx context.Context, id string) (<-chan bls.ExecutionEvent, error) {
+	return n.ExecutionEventsFunc(ctx, id)
+}
+
+func (n *APINode) CancelExecution(ctx context.Context, id string) error {
+	return n.CancelExecutionFunc(ctx, id)
+}
+
+func (n *APINode) ExecutionResult(ctx context.Context, id string) (bls.ExecutionRecord, error) {
+	return n.ExecutionResultFunc(ctx, id)
+}
+
+func (n *APINode) PublishFunctionInstall(ctx context.Context, uri string, cid string, subgroup string) (string, error) {
 	return n.PublishFunctionInstallFunc(ctx, uri, cid, subgroup)
 }
+
+func (n *APINode) FunctionInstallStatus(ctx context.Context, id string) (bls.FunctionInstallRecord, error) {
+	return n.FunctionInstallStatusFunc(ctx, id)
+}
+
+func (n *APINode) WaitFunctionInstall(ctx context.Context, id string, workers uint) (bls.FunctionInstallRecord, error) {
+	return n.WaitFunctionInstallFunc(ctx, id, workers)
+}
+
+func (n *APINode) PublishFunctionUninstall(ctx context.Context, cid string, subgroup string) ([]bls.FunctionUninstallResult, error) {
+	return n.PublishFunctionUninstallFunc(ctx, cid, subgroup)
+}
+
+func (n *APINode) FunctionInventory(ctx context.Context, subgroup string) ([]bls.WorkerFunctions, error) {
+	return n.FunctionInventoryFunc(ctx, subgroup)
+}
+
+func (n *APINode) Peers(ctx context.Context) ([]bls.PeerInfo, error) {
+	return n.PeersFunc(ctx)
+}
+
+func (n *APINode) PeerReputations(ctx context.Context) ([]bls.PeerReputation, error) {
+	return n.PeerReputationsFunc(ctx)
+}
+
+func (n *APINode) CreateSchedule(ctx context.Context, cron string, req execute.Request, subgroup string, webhook bls.Webhook) (bls.Schedule, error) {
+	return n.CreateScheduleFunc(ctx, cron, req, subgroup, webhook)
+}
+
+func (n *APINode) Schedules(ctx context.Context) ([]bls.Schedule, error) {
+	return n.SchedulesFunc(ctx)
+}
+
+func (n *APINode) PauseSchedule(ctx context.Context, id string, paused bool) (bls.Schedule, error) {
+	return n.PauseScheduleFunc(ctx, id, paused)
+}
+
+func (n *APINode) DeleteSchedule(ctx context.Context, id string) error {
+	return n.DeleteScheduleFunc(ctx, id)
+}
+
+func (n *APINode) UsageEntries(ctx context.Context, filter bls.UsageFilter) ([]execute.UsageEntry, error) {
+	return n.UsageEntriesFunc(ctx, filter)
+}
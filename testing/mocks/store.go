@@ -3,10 +3,12 @@ package mocks
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/blessnetwork/b7s/models/bls"
+	"github.com/blessnetwork/b7s/models/execute"
 )
 
 type Store struct {
@@ -19,6 +21,45 @@ type Store struct {
 	RetrieveFunctionFunc  func(context.Context, string) (bls.FunctionRecord, error)
 	RetrieveFunctionsFunc func(context.Context) ([]bls.FunctionRecord, error)
 	RemoveFunctionFunc    func(context.Context, string) error
+
+	SaveFunctionTombstoneFunc     func(context.Context, bls.FunctionTombstone) error
+	RetrieveFunctionTombstoneFunc func(context.Context, string) (bls.FunctionTombstone, error)
+	RemoveFunctionTombstoneFunc   func(context.Context, string) error
+
+	SaveExecutionResultFunc           func(context.Context, bls.ExecutionRecord) error
+	RetrieveExecutionResultFunc       func(context.Context, string) (bls.ExecutionRecord, error)
+	RetrieveExecutionResultsFunc      func(context.Context) ([]bls.ExecutionRecord, error)
+	RetrieveStaleExecutionResultsFunc func(context.Context, time.Time, uint) ([]string, error)
+	RemoveExecutionResultFunc         func(context.Context, string) error
+
+	SavePipelineResultFunc           func(context.Context, bls.PipelineRecord) error
+	RetrievePipelineResultFunc       func(context.Context, string) (bls.PipelineRecord, error)
+	RetrieveStalePipelineResultsFunc func(context.Context, time.Time, uint) ([]string, error)
+	RemovePipelineResultFunc         func(context.Context, string) error
+
+	SaveWebhookDeliveryFunc       func(context.Context, bls.WebhookDelivery) error
+	RetrieveWebhookDeliveryFunc   func(context.Context, string) (bls.WebhookDelivery, error)
+	RetrieveWebhookDeliveriesFunc func(context.Context) ([]bls.WebhookDelivery, error)
+	RemoveWebhookDeliveryFunc     func(context.Context, string) error
+
+	SavePeerReputationFunc      func(context.Context, bls.PeerReputation) error
+	RetrievePeerReputationFunc  func(context.Context, peer.ID) (bls.PeerReputation, error)
+	RetrievePeerReputationsFunc func(context.Context) ([]bls.PeerReputation, error)
+	RemovePeerReputationFunc    func(context.Context, peer.ID) error
+
+	SaveScheduleFunc      func(context.Context, bls.Schedule) error
+	RetrieveScheduleFunc  func(context.Context, string) (bls.Schedule, error)
+	RetrieveSchedulesFunc func(context.Context) ([]bls.Schedule, error)
+	RemoveScheduleFunc    func(context.Context, string) error
+
+	SaveAPIClientFunc          func(context.Context, bls.APIClient) error
+	RetrieveAPIClientFunc      func(context.Context, string) (bls.APIClient, error)
+	RetrieveAPIClientByKeyFunc func(context.Context, string) (bls.APIClient, error)
+	RetrieveAPIClientsFunc     func(context.Context) ([]bls.APIClient, error)
+	RemoveAPIClientFunc        func(context.Context, string) error
+
+	SaveUsageEntryFunc       func(context.Context, execute.UsageEntry) error
+	RetrieveUsageEntriesFunc func(context.Context, bls.UsageFilter) ([]execute.UsageEntry, error)
 }
 
 func BaselineStore(t *testing.T) *Store {
@@ -50,6 +91,107 @@ func BaselineStore(t *testing.T) *Store {
 		RemoveFunctionFunc: func(context.Context, string) error {
 			return nil
 		},
+
+		SaveFunctionTombstoneFunc: func(context.Context, bls.FunctionTombstone) error {
+			return nil
+		},
+		RetrieveFunctionTombstoneFunc: func(context.Context, string) (bls.FunctionTombstone, error) {
+			return bls.FunctionTombstone{}, bls.ErrNotFound
+		},
+		RemoveFunctionTombstoneFunc: func(context.Context, string) error {
+			return nil
+		},
+
+		SaveExecutionResultFunc: func(context.Context, bls.ExecutionRecord) error {
+			return nil
+		},
+		RetrieveExecutionResultFunc: func(context.Context, string) (bls.ExecutionRecord, error) {
+			return GenericExecutionRecord, nil
+		},
+		RetrieveExecutionResultsFunc: func(context.Context) ([]bls.ExecutionRecord, error) {
+			return []bls.ExecutionRecord{GenericExecutionRecord}, nil
+		},
+		RetrieveStaleExecutionResultsFunc: func(context.Context, time.Time, uint) ([]string, error) {
+			return []string{}, nil
+		},
+		RemoveExecutionResultFunc: func(context.Context, string) error {
+			return nil
+		},
+
+		SavePipelineResultFunc: func(context.Context, bls.PipelineRecord) error {
+			return nil
+		},
+		RetrievePipelineResultFunc: func(context.Context, string) (bls.PipelineRecord, error) {
+			return GenericPipelineRecord, nil
+		},
+		RetrieveStalePipelineResultsFunc: func(context.Context, time.Time, uint) ([]string, error) {
+			return []string{}, nil
+		},
+		RemovePipelineResultFunc: func(context.Context, string) error {
+			return nil
+		},
+
+		SaveWebhookDeliveryFunc: func(context.Context, bls.WebhookDelivery) error {
+			return nil
+		},
+		RetrieveWebhookDeliveryFunc: func(context.Context, string) (bls.WebhookDelivery, error) {
+			return GenericWebhookDelivery, nil
+		},
+		RetrieveWebhookDeliveriesFunc: func(context.Context) ([]bls.WebhookDelivery, error) {
+			return []bls.WebhookDelivery{GenericWebhookDelivery}, nil
+		},
+		RemoveWebhookDeliveryFunc: func(context.Context, string) error {
+			return nil
+		},
+
+		SavePeerReputationFunc: func(context.Context, bls.PeerReputation) error {
+			return nil
+		},
+		RetrievePeerReputationFunc: func(context.Context, peer.ID) (bls.PeerReputation, error) {
+			return GenericPeerReputation, nil
+		},
+		RetrievePeerReputationsFunc: func(context.Context) ([]bls.PeerReputation, error) {
+			return []bls.PeerReputation{GenericPeerReputation}, nil
+		},
+		RemovePeerReputationFunc: func(context.Context, peer.ID) error {
+			return nil
+		},
+
+		SaveScheduleFunc: func(context.Context, bls.Schedule) error {
+			return nil
+		},
+		RetrieveScheduleFunc: func(context.Context, string) (bls.Schedule, error) {
+			return GenericSchedule, nil
+		},
+		RetrieveSchedulesFunc: func(context.Context) ([]bls.Schedule, error) {
+			return []bls.Schedule{GenericSchedule}, nil
+		},
+		RemoveScheduleFunc: func(context.Context, string) error {
+			return nil
+		},
+
+		SaveAPIClientFunc: func(context.Context, bls.APIClient) error {
+			return nil
+		},
+		RetrieveAPIClientFunc: func(context.Context, string) (bls.APIClient, error) {
+			return GenericAPIClient, nil
+		},
+		RetrieveAPIClientByKeyFunc: func(context.Context, string) (bls.APIClient, error) {
+			return GenericAPIClient, nil
+		},
+		RetrieveAPIClientsFunc: func(context.Context) ([]bls.APIClient, error) {
+			return []bls.APIClient{GenericAPIClient}, nil
+		},
+		RemoveAPIClientFunc: func(context.Context, string) error {
+			return nil
+		},
+
+		SaveUsageEntryFunc: func(context.Context, execute.UsageEntry) error {
+			return nil
+		},
+		RetrieveUsageEntriesFunc: func(context.Context, bls.UsageFilter) ([]execute.UsageEntry, error) {
+			return []execute.UsageEntry{GenericUsageEntry}, nil
+		},
 	}
 
 	return &store
@@ -79,3 +221,101 @@ func (s *Store) RemovePeer(ctx context.Context, id peer.ID) error {
 func (s *Store) RemoveFunction(ctx context.Context, id string) error {
 	return s.RemoveFunctionFunc(ctx, id)
 }
+func (s *Store) SaveFunctionTombstone(ctx context.Context, tombstone bls.FunctionTombstone) error {
+	return s.SaveFunctionTombstoneFunc(ctx, tombstone)
+}
+func (s *Store) RetrieveFunctionTombstone(ctx context.Context, cid string) (bls.FunctionTombstone, error) {
+	return s.RetrieveFunctionTombstoneFunc(ctx, cid)
+}
+func (s *Store) RemoveFunctionTombstone(ctx context.Context, cid string) error {
+	return s.RemoveFunctionTombstoneFunc(ctx, cid)
+}
+func (s *Store) SaveExecutionResult(ctx context.Context, record bls.ExecutionRecord) error {
+	return s.SaveExecutionResultFunc(ctx, record)
+}
+func (s *Store) RetrieveExecutionResult(ctx context.Context, id string) (bls.ExecutionRecord, error) {
+	return s.RetrieveExecutionResultFunc(ctx, id)
+}
+func (s *Store) RetrieveExecutionResults(ctx context.Context) ([]bls.ExecutionRecord, error) {
+	return s.RetrieveExecutionResultsFunc(ctx)
+}
+func (s *Store) RetrieveStaleExecutionResults(ctx context.Context, before time.Time, keep uint) ([]string, error) {
+	return s.RetrieveStaleExecutionResultsFunc(ctx, before, keep)
+}
+func (s *Store) RemoveExecutionResult(ctx context.Context, id string) error {
+	return s.RemoveExecutionResultFunc(ctx, id)
+}
+
+func (s *Store) SavePipelineResult(ctx context.Context, record bls.PipelineRecord) error {
+	return s.SavePipelineResultFunc(ctx, record)
+}
+func (s *Store) RetrievePipelineResult(ctx context.Context, id string) (bls.PipelineRecord, error) {
+	return s.RetrievePipelineResultFunc(ctx, id)
+}
+func (s *Store) RetrieveStalePipelineResults(ctx context.Context, before time.Time, keep uint) ([]string, error) {
+	return s.RetrieveStalePipelineResultsFunc(ctx, before, keep)
+}
+func (s *Store) RemovePipelineResult(ctx context.Context, id string) error {
+	return s.RemovePipelineResultFunc(ctx, id)
+}
+
+func (s *Store) SaveWebhookDelivery(ctx context.Context, record bls.WebhookDelivery) error {
+	return s.SaveWebhookDeliveryFunc(ctx, record)
+}
+func (s *Store) RetrieveWebhookDelivery(ctx context.Context, id string) (bls.WebhookDelivery, error) {
+	return s.RetrieveWebhookDeliveryFunc(ctx, id)
+}
+func (s *Store) RetrieveWebhookDeliveries(ctx context.Context) ([]bls.WebhookDelivery, error) {
+	return s.RetrieveWebhookDeliveriesFunc(ctx)
+}
+func (s *Store) RemoveWebhookDelivery(ctx context.Context, id string) error {
+	return s.RemoveWebhookDeliveryFunc(ctx, id)
+}
+func (s *Store) SavePeerReputation(ctx context.Context, record bls.PeerReputation) error {
+	return s.SavePeerReputationFunc(ctx, record)
+}
+func (s *Store) RetrievePeerReputation(ctx context.Context, id peer.ID) (bls.PeerReputation, error) {
+	return s.RetrievePeerReputationFunc(ctx, id)
+}
+func (s *Store) RetrievePeerReputations(ctx context.Context) ([]bls.PeerReputation, error) {
+	return s.RetrievePeerReputationsFunc(ctx)
+}
+func (s *Store) RemovePeerReputation(ctx context.Context, id peer.ID) error {
+	return s.RemovePeerReputationFunc(ctx, id)
+}
+
+func (s *Store) SaveSchedule(ctx context.Context, record bls.Schedule) error {
+	return s.SaveScheduleFunc(ctx, record)
+}
+func (s *Store) RetrieveSchedule(ctx context.Context, id string) (bls.Schedule, error) {
+	return s.RetrieveScheduleFunc(ctx, id)
+}
+func (s *Store) RetrieveSchedules(ctx context.Context) ([]bls.Schedule, error) {
+	return s.RetrieveSchedulesFunc(ctx)
+}
+func (s *Store) RemoveSchedule(ctx context.Context, id string) error {
+	return s.RemoveScheduleFunc(ctx, id)
+}
+
+func (s *Store) SaveAPIClient(ctx context.Context, client bls.APIClient) error {
+	return s.SaveAPIClientFunc(ctx, client)
+}
+func (s *Store) RetrieveAPIClient(ctx context.Context, id string) (bls.APIClient, error) {
+	return s.RetrieveAPIClientFunc(ctx, id)
+}
+func (s *Store) RetrieveAPIClientByKey(ctx context.Context, keyHash string) (bls.APIClient, error) {
+	return s.RetrieveAPIClientByKeyFunc(ctx, keyHash)
+}
+func (s *Store) RetrieveAPIClients(ctx context.Context) ([]bls.APIClient, error) {
+	return s.RetrieveAPIClientsFunc(ctx)
+}
+func (s *Store) RemoveAPIClient(ctx context.Context, id string) error {
+	return s.RemoveAPIClientFunc(ctx, id)
+}
+
+func (s *Store) SaveUsageEntry(ctx context.Context, entry execute.UsageEntry) error {
+	return s.SaveUsageEntryFunc(ctx, entry)
+}
+func (s *Store) RetrieveUsageEntries(ctx context.Context, filter bls.UsageFilter) ([]execute.UsageEntry, error) {
+	return s.RetrieveUsageEntriesFunc(ctx, filter)
+}